@@ -0,0 +1,168 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// apiVersion is the API version of the server, as returned by show-api-versions, and the version that the provider is
+// pinned to by api_version.
+type apiVersion struct {
+	current   string
+	supported []string
+	pinned    string
+}
+
+var apiVersions = struct {
+	sync.Mutex
+	byClient map[*checkpoint.ApiClient]*apiVersion
+}{byClient: make(map[*checkpoint.ApiClient]*apiVersion)}
+
+// registerApiVersion probes the API versions of the server with show-api-versions, and verifies that the server
+// supports the pinned version.
+func registerApiVersion(client *checkpoint.ApiClient, pinned string) error {
+	version := &apiVersion{pinned: pinned}
+
+	res, err := client.ApiCall("show-api-versions", map[string]interface{}{}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !res.Success {
+		// Without the versions of the server the arguments are checked against the pinned version only
+		if err == nil {
+			err = fmt.Errorf(res.ErrorMsg)
+		}
+		log.Printf("Failed to get the API versions of the server: %s", err.Error())
+		if pinned != "" {
+			apiVersions.Lock()
+			apiVersions.byClient[client] = version
+			apiVersions.Unlock()
+		}
+		return nil
+	}
+
+	version.current, _ = res.GetData()["current-version"].(string)
+	if supported, ok := res.GetData()["supported-versions"].([]interface{}); ok {
+		for _, v := range supported {
+			if s, ok := v.(string); ok {
+				version.supported = append(version.supported, s)
+			}
+		}
+	}
+	log.Printf("API version of the server is %s, supported versions are %s", version.current, strings.Join(version.supported, ", "))
+
+	if pinned != "" && !containsApiVersion(version.supported, pinned) {
+		return fmt.Errorf("api_version %s is not supported by the server. Supported versions are %s", pinned, strings.Join(version.supported, ", "))
+	}
+
+	apiVersions.Lock()
+	apiVersions.byClient[client] = version
+	apiVersions.Unlock()
+	return nil
+}
+
+func clientApiVersion(m interface{}) *apiVersion {
+	client, ok := m.(*checkpoint.ApiClient)
+	if !ok {
+		return nil
+	}
+	apiVersions.Lock()
+	defer apiVersions.Unlock()
+	return apiVersions.byClient[client]
+}
+
+// requireApiVersions declares the minimum API versions of a resource that was added after the first API versions, and
+// of its arguments that were added after the resource. Arguments that are not listed are supported by every API version
+// of the resource. A plan that creates the resource or sets an argument that the server does not support fails with
+// the version they require, instead of the error of the API on apply. Only the resources that call it are checked,
+// the others fail with the error of the API on apply.
+func requireApiVersions(r *schema.Resource, resourceVersion string, attributeVersions map[string]string) *schema.Resource {
+	r.CustomizeDiff = customizeDiffApiVersion(r.CustomizeDiff, resourceVersion, attributeVersions)
+	return r
+}
+
+func customizeDiffApiVersion(customizeDiff schema.CustomizeDiffFunc, resourceVersion string, attributeVersions map[string]string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, m); err != nil {
+				return err
+			}
+		}
+
+		version := clientApiVersion(m)
+		if version == nil {
+			return nil
+		}
+		effective, source := version.current, "server supports"
+		if version.pinned != "" {
+			effective, source = version.pinned, "provider api_version is"
+		}
+		if effective == "" {
+			return nil
+		}
+
+		if resourceVersion != "" && d.Id() == "" && compareApiVersions(effective, resourceVersion) < 0 {
+			return fmt.Errorf("resource requires API %s, %s %s", resourceVersion, source, effective)
+		}
+
+		attributes := make([]string, 0, len(attributeVersions))
+		for attribute := range attributeVersions {
+			attributes = append(attributes, attribute)
+		}
+		sort.Strings(attributes)
+
+		for _, attribute := range attributes {
+			if _, ok := d.GetOk(attribute); !ok {
+				continue
+			}
+			if required := attributeVersions[attribute]; compareApiVersions(effective, required) < 0 {
+				return fmt.Errorf("attribute %s requires API %s, %s %s", attribute, required, source, effective)
+			}
+		}
+		return nil
+	}
+}
+
+// shareApiVersion registers the API version of a client for another client of the same server, e.g. the client of a
+// domain.
+func shareApiVersion(from *checkpoint.ApiClient, to *checkpoint.ApiClient) {
+	apiVersions.Lock()
+	defer apiVersions.Unlock()
+	if version, ok := apiVersions.byClient[from]; ok {
+		apiVersions.byClient[to] = version
+	}
+}
+
+func containsApiVersion(versions []string, version string) bool {
+	for _, v := range versions {
+		if compareApiVersions(v, version) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// compareApiVersions compares API versions by their numbers, e.g. 1.10 is after 1.9 and 1 is 1.0.
+func compareApiVersions(a string, b string) int {
+	aParts := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bParts := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aNum, bNum int
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+		if aNum != bNum {
+			if aNum < bNum {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
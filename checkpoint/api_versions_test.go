@@ -0,0 +1,111 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestUnitProvider_apiVersion(t *testing.T) {
+	mock := newMockApiServer(t)
+	mock.apiVersion = "1.7"
+
+	providerConfig := func(apiVersion string) string {
+		return mock.providerConfig(hclArgument("api_version", apiVersion))
+	}
+	granularEncryptions := `
+resource "checkpoint_management_vpn_community_meshed" "test" {
+  name = "tfTestApiVersion"
+  granular_encryptions {
+    internal_gateway = "gw1"
+    external_gateway = "gw2"
+    encryption_method = "ikev2 only"
+  }
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      mock.providerConfig() + granularEncryptions,
+				ExpectError: regexp.MustCompile(`attribute granular_encryptions requires API 1.8, server supports 1.7`),
+			},
+			{
+				// Domain sessions have the API version of the server
+				Config:      mock.providerConfig() + strings.Replace(granularEncryptions, "{", "{\n  domain = \"tfTestDomain\"", 1),
+				ExpectError: regexp.MustCompile(`attribute granular_encryptions requires API 1.8, server supports 1.7`),
+			},
+			{
+				Config:      providerConfig("1.5") + testAccManagementHttpsLayerConfig("tfTestApiVersion"),
+				ExpectError: regexp.MustCompile(`resource requires API 1.6, provider api_version is 1.5`),
+			},
+			{
+				Config:      providerConfig("1.8") + testAccManagementVpnCommunityMeshedConfig("tfTestApiVersion", "ikev2 only", "custom"),
+				ExpectError: regexp.MustCompile(`Check that the server supports api_version 1.8`),
+			},
+			{
+				Config:      providerConfig("1.6") + granularEncryptions,
+				ExpectError: regexp.MustCompile(`attribute granular_encryptions requires API 1.8, provider api_version is 1.6`),
+			},
+			{
+				Config: providerConfig("v1.6") + testAccManagementVpnCommunityMeshedConfig("tfTestApiVersion", "ikev2 only", "custom"),
+				Check: func(s *terraform.State) error {
+					if mock.object("vpn-community-meshed", "tfTestApiVersion") == nil {
+						return fmt.Errorf("vpn community was not added")
+					}
+					mock.Lock()
+					defer mock.Unlock()
+					for i, command := range mock.calls {
+						if command == "add-vpn-community-meshed" && mock.requestVersions[i] != "1.6" {
+							return fmt.Errorf("add-vpn-community-meshed was sent to API version %q, expected 1.6", mock.requestVersions[i])
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestUnitProvider_apiVersionWithoutServerVersions(t *testing.T) {
+	mock := newMockApiServer(t)
+	// Every configuration of the provider fails to read the versions of the server
+	for i := 0; i < 10; i++ {
+		mock.failNext("show-api-versions", 404, "generic_err_command_not_found", "Unknown command \"show-api-versions\"")
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      mock.providerConfig(hclArgument("api_version", "1.5")) + testAccManagementHttpsLayerConfig("tfTestApiVersion"),
+				ExpectError: regexp.MustCompile(`resource requires API 1.6, provider api_version is 1.5`),
+			},
+		},
+	})
+}
+
+func TestUnitProvider_compareApiVersions(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.8", "1.8", 0},
+		{"1", "1.0", 0},
+		{"1.7", "1.8", -1},
+		{"1.10", "1.9", 1},
+		{"1.9.1", "1.9", 1},
+		{"2", "1.9.1", 1},
+		{"v1.8", "1.8", 0},
+	}
+	for _, test := range tests {
+		if result := compareApiVersions(test.a, test.b); result != test.expected {
+			t.Errorf("compareApiVersions(%q, %q) = %d, expected %d", test.a, test.b, result, test.expected)
+		}
+	}
+}
@@ -1,15 +1,190 @@
 package checkpoint
 
 import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
 	"math"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 )
 
 const (
+	// CmeApiVersion is the latest CME API version that the provider supports
 	CmeApiVersion = "v1.3.1"
-	CmeApiPath    = "cme-api/" + CmeApiVersion
+	// CmeApiVersionsPath returns the CME API versions of the server. Every CME version serves it under v1.
+	CmeApiVersionsPath = "cme-api/v1/api-versions"
 )
 
+// cmeApiVersion is the CME API version that the requests of a client are sent to. It is negotiated with CME on the
+// first CME request of the client.
+type cmeApiVersion struct {
+	sync.Mutex
+	configured string
+	version    string
+	err        error
+	negotiated bool
+}
+
+var cmeApiVersions = struct {
+	sync.Mutex
+	byClient map[*checkpoint.ApiClient]*cmeApiVersion
+}{byClient: make(map[*checkpoint.ApiClient]*cmeApiVersion)}
+
+func clientCmeApiVersion(client *checkpoint.ApiClient) *cmeApiVersion {
+	cmeApiVersions.Lock()
+	defer cmeApiVersions.Unlock()
+	version, ok := cmeApiVersions.byClient[client]
+	if !ok {
+		version = &cmeApiVersion{}
+		cmeApiVersions.byClient[client] = version
+	}
+	return version
+}
+
+// registerCmeApiVersion sets the CME API version of the requests of client, from the cme_api_version argument.
+func registerCmeApiVersion(client *checkpoint.ApiClient, configured string) {
+	if configured != "" {
+		configured = normalizeCmeApiVersion(configured)
+	}
+	clientCmeApiVersion(client).configured = configured
+}
+
+// normalizeCmeApiVersion returns a CME API version with the v prefix of the CME API paths.
+func normalizeCmeApiVersion(version string) string {
+	return "v" + strings.TrimPrefix(version, "v")
+}
+
+// cmeApiPath returns the path of the CME API, e.g. cme-api/v1.3.1, in the CME API version of client.
+func cmeApiPath(client *checkpoint.ApiClient) (string, error) {
+	version, err := clientCmeApiVersion(client).negotiate(client)
+	if err != nil {
+		return "", err
+	}
+	return "cme-api/" + version, nil
+}
+
+// negotiate returns the configured CME API version when CME supports it, and otherwise the latest version that both
+// CME and the provider support. When the versions of CME cannot be read, the configured version or the latest version
+// of the provider is used.
+func (v *cmeApiVersion) negotiate(client *checkpoint.ApiClient) (string, error) {
+	v.Lock()
+	defer v.Unlock()
+	if v.negotiated {
+		return v.version, v.err
+	}
+
+	fallback := v.configured
+	if fallback == "" {
+		fallback = CmeApiVersion
+	}
+
+	res, err := client.ApiCall(CmeApiVersionsPath, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	if err != nil {
+		// Not kept, so that the versions are read again by the next request
+		log.Printf("Failed to get the CME API versions: %s. Using CME API version %s", err.Error(), fallback)
+		return fallback, nil
+	}
+	v.negotiated = true
+
+	data := res.GetData()
+	result, ok := data["result"].(map[string]interface{})
+	if checkIfRequestFailed(data) || !ok {
+		log.Printf("Failed to get the CME API versions: %s. Using CME API version %s", buildErrorMessage(data), fallback)
+		v.version = fallback
+		return v.version, nil
+	}
+
+	var supported []string
+	if versions, ok := result["supported_versions"].([]interface{}); ok {
+		for _, version := range versions {
+			if s, ok := version.(string); ok {
+				supported = append(supported, normalizeCmeApiVersion(s))
+			}
+		}
+	}
+	sort.Slice(supported, func(i, j int) bool {
+		return compareApiVersions(supported[i], supported[j]) < 0
+	})
+	log.Printf("CME API version is %v, supported versions are %s", result["current_version"], strings.Join(supported, ", "))
+
+	if v.configured != "" {
+		if !containsApiVersion(supported, v.configured) {
+			v.err = fmt.Errorf("cme_api_version %s is not supported by CME. Supported versions are %s", v.configured, strings.Join(supported, ", "))
+			return "", v.err
+		}
+		v.version = v.configured
+		return v.version, nil
+	}
+
+	v.version = CmeApiVersion
+	for _, version := range supported {
+		if compareApiVersions(version, CmeApiVersion) <= 0 {
+			v.version = version
+		}
+	}
+	if len(supported) > 0 && compareApiVersions(supported[0], CmeApiVersion) > 0 {
+		// Every version of CME is newer than the provider
+		v.version = supported[0]
+	}
+	log.Printf("Using CME API version %s", v.version)
+	return v.version, nil
+}
+
+// requireCmeApiVersions declares the minimum CME API versions of the arguments of a CME resource that were added after
+// the first CME API version. A plan that sets an argument that the CME API version does not support fails with the
+// version the argument requires, instead of sending the argument to a CME that ignores or rejects it.
+func requireCmeApiVersions(r *schema.Resource, versions map[string]string) *schema.Resource {
+	r.CustomizeDiff = customizeDiffCmeApiVersion(r.CustomizeDiff, versions)
+	return r
+}
+
+func customizeDiffCmeApiVersion(customizeDiff schema.CustomizeDiffFunc, versions map[string]string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, m); err != nil {
+				return err
+			}
+		}
+
+		client, ok := m.(*checkpoint.ApiClient)
+		if !ok {
+			return nil
+		}
+
+		attributes := make([]string, 0, len(versions))
+		for attribute := range versions {
+			if _, ok := d.GetOk(attribute); ok {
+				attributes = append(attributes, attribute)
+			}
+		}
+		if len(attributes) == 0 {
+			return nil
+		}
+		sort.Strings(attributes)
+
+		cmeVersion := clientCmeApiVersion(client)
+		version, err := cmeVersion.negotiate(client)
+		if err != nil {
+			return err
+		}
+		source := "CME supports"
+		if cmeVersion.configured != "" {
+			source = "provider cme_api_version is"
+		}
+
+		for _, attribute := range attributes {
+			if required := versions[attribute]; compareApiVersions(version, required) < 0 {
+				return fmt.Errorf("attribute %s requires CME API %s, %s %s", attribute, required, source, version)
+			}
+		}
+		return nil
+	}
+}
+
 func checkIfRequestFailed(resJson map[string]interface{}) bool {
 
 	if resJson["status-code"] != nil {
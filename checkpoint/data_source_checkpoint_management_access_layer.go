@@ -103,7 +103,7 @@ func dataSourceManagementAccessLayerRead(d *schema.ResourceData, m interface{})
 
 	accessLayer := showAccessLayerRes.GetData()
 
-	log.Println("Read AccessLayer - Show JSON = ", accessLayer)
+	log.Println("Read AccessLayer - Show JSON = ", redactPayload(d, accessLayer))
 
 	if v := accessLayer["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -91,7 +91,7 @@ func dataSourceManagementAccessPointNameRead(d *schema.ResourceData, m interface
 
 	accessPointName := showAccessPointNameRes.GetData()
 
-	log.Println("Read AccessPointName - Show JSON = ", accessPointName)
+	log.Println("Read AccessPointName - Show JSON = ", redactPayload(d, accessPointName))
 
 	if v := accessPointName["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -140,7 +140,7 @@ func dataSourceManagementAccessRoleRead(d *schema.ResourceData, m interface{}) e
 
 	accessRole := showAccessRoleRes.GetData()
 
-	log.Println("Read AccessRole - Show JSON = ", accessRole)
+	log.Println("Read AccessRole - Show JSON = ", redactPayload(d, accessRole))
 
 	if v := accessRole["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -320,7 +320,7 @@ func dataSourceManagementAccessRuleRead(d *schema.ResourceData, m interface{}) e
 
 	accessRule := showAccessRuleRes.GetData()
 
-	log.Println("Read Access Rule - Show JSON = ", accessRule)
+	log.Println("Read Access Rule - Show JSON = ", redactPayload(d, accessRule))
 
 	if v := accessRule["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -770,7 +770,7 @@ func dataSourceManagementAccessRuleBaseRead(d *schema.ResourceData, m interface{
 	}
 	ruleBaseJson := showRuleBaseRes.GetData()
 
-	log.Println("Read ruleBaseJson - Show JSON = ", ruleBaseJson)
+	log.Println("Read ruleBaseJson - Show JSON = ", redactPayload(d, ruleBaseJson))
 	var outputRuleBase []interface{}
 	ruleBaseToReturn := make(map[string]interface{})
 	if v := ruleBaseJson["uid"]; v != nil {
@@ -57,7 +57,7 @@ func dataSourceManagementAccessSectionRead(d *schema.ResourceData, m interface{}
 
 	accessSection := showAccessSectionRes.GetData()
 
-	log.Println("Read AccessSection - Show JSON = ", accessSection)
+	log.Println("Read AccessSection - Show JSON = ", redactPayload(d, accessSection))
 
 	if v, ok := d.GetOk("layer"); ok {
 		_ = d.Set("layer", v)
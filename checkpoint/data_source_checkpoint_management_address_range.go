@@ -137,7 +137,7 @@ func dataSourceManagementAddressRangeRead(d *schema.ResourceData, m interface{})
 
 	addressRange := showAddressRangeRes.GetData()
 
-	log.Println("Read Address Range - Show JSON = ", addressRange)
+	log.Println("Read Address Range - Show JSON = ", redactPayload(d, addressRange))
 
 	if v := addressRange["uid"]; v != nil {
 		_ = d.Set("uid", v)
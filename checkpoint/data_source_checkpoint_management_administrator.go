@@ -141,7 +141,7 @@ func dataSourceManagementAdministratorRead(d *schema.ResourceData, m interface{}
 	}
 
 	administrator := showAdministratorRes.GetData()
-	log.Println("Read Administrator - Show JSON = ", administrator)
+	log.Println("Read Administrator - Show JSON = ", redactPayload(d, administrator))
 
 	if v := administrator["name"]; v != nil {
 		_ = d.Set("name", v)
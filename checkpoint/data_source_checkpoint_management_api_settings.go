@@ -74,7 +74,7 @@ func dataSourceManagementApiSettingsRead(d *schema.ResourceData, m interface{})
 
 	apiSettings := showApiSettingsRes.GetData()
 
-	log.Println("Read Api Settings - Show JSON = ", apiSettings)
+	log.Println("Read Api Settings - Show JSON = ", redactPayload(d, apiSettings))
 
 	d.SetId("show-api-settings-" + acctest.RandString(10))
 
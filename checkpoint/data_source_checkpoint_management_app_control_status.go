@@ -80,7 +80,7 @@ func dataSourceManagementAppControlStatusRead(d *schema.ResourceData, m interfac
 
 	appControlStatus := showAppControlStatusRes.GetData()
 
-	log.Println("Read App Control Status - Show JSON = ", appControlStatus)
+	log.Println("Read App Control Status - Show JSON = ", redactPayload(d, appControlStatus))
 
 	if v := appControlStatus["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -113,7 +113,7 @@ func dataSourceManagementApplicationSiteRead(d *schema.ResourceData, m interface
 
 	applicationSite := showApplicationSiteRes.GetData()
 
-	log.Println("Read ApplicationSite - Show JSON = ", applicationSite)
+	log.Println("Read ApplicationSite - Show JSON = ", redactPayload(d, applicationSite))
 
 	if v := applicationSite["uid"]; v != nil {
 		_ = d.Set("uid", v)
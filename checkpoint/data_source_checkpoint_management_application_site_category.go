@@ -81,7 +81,7 @@ func dataSourceManagementApplicationSiteCategoryRead(d *schema.ResourceData, m i
 
 	applicationSiteCategory := showApplicationSiteCategoryRes.GetData()
 
-	log.Println("Read ApplicationSiteCategory - Show JSON = ", applicationSiteCategory)
+	log.Println("Read ApplicationSiteCategory - Show JSON = ", redactPayload(d, applicationSiteCategory))
 
 	if v := applicationSiteCategory["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -84,7 +84,7 @@ func dataSourceManagementApplicationSiteGroupRead(d *schema.ResourceData, m inte
 
 	applicationSiteGroup := showApplicationSiteGroupRes.GetData()
 
-	log.Println("Read ApplicationSiteGroup - Show JSON = ", applicationSiteGroup)
+	log.Println("Read ApplicationSiteGroup - Show JSON = ", redactPayload(d, applicationSiteGroup))
 
 	if v := applicationSiteGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -91,7 +91,7 @@ func dataSourceManagementAutomaticPurgeRead(d *schema.ResourceData, m interface{
 
 	automaticPurge := showAutomaticPurgeRes.GetData()
 
-	log.Println("Read Automatic Purge - Show JSON = ", automaticPurge)
+	log.Println("Read Automatic Purge - Show JSON = ", redactPayload(d, automaticPurge))
 
 	d.SetId("show-automatic-purge-" + acctest.RandString(10))
 
@@ -84,7 +84,7 @@ func dataSourceManagementAzureAdRead(d *schema.ResourceData, m interface{}) erro
 
 	azureAd := showAzureAdRes.GetData()
 
-	log.Println("Read Azure Ad - Show JSON = ", azureAd)
+	log.Println("Read Azure Ad - Show JSON = ", redactPayload(d, azureAd))
 
 	if v := azureAd["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -225,7 +225,7 @@ func dataSourceAzureAdContentRead(d *schema.ResourceData, m interface{}) error {
 
 	azureAdContent := showAzureAdContentRes.GetData()
 
-	log.Println("Read Azure Ad Content - Show JSON = ", azureAdContent)
+	log.Println("Read Azure Ad Content - Show JSON = ", redactPayload(d, azureAdContent))
 
 	if v := azureAdContent["from"]; v != nil {
 		_ = d.Set("from", v)
@@ -0,0 +1,219 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func dataSourceManagementChanges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementChangesRead,
+		Schema: map[string]*schema.Schema{
+			"from_session": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The session UID to show changes from. Default is the changes of the session of the provider when none of from_session, to_session, from_date and to_date is set.",
+			},
+			"to_session": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The session UID to show changes to.",
+			},
+			"from_date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The date to show changes from, in ISO 8601 format, e.g. 2017-02-01T08:20:50.",
+			},
+			"to_date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The date to show changes to, in ISO 8601 format, e.g. 2017-02-01T08:20:50.",
+			},
+			"added_objects":    changedObjectsSchema("Objects that were added."),
+			"modified_objects": changedObjectsSchema("Objects that were modified."),
+			"deleted_objects":  changedObjectsSchema("Objects that were deleted."),
+			"response": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Response message in JSON format.",
+			},
+		},
+	}
+}
+
+func changedObjectsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Object name.",
+				},
+				"uid": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Object unique identifier.",
+				},
+				"type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Object type.",
+				},
+				"json": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "All the fields of the object in JSON format. For modified objects, the fields after the change.",
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagementChangesRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	payload := make(map[string]interface{})
+
+	if v, ok := d.GetOk("from_session"); ok {
+		payload["from-session"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("to_session"); ok {
+		payload["to-session"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("from_date"); ok {
+		payload["from-date"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("to_date"); ok {
+		payload["to-date"] = v.(string)
+	}
+
+	if len(payload) == 0 {
+		uid, err := currentSessionUid(client)
+		if err != nil {
+			return err
+		}
+		payload["from-session"] = uid
+		payload["to-session"] = uid
+	}
+
+	showChangesRes, err := client.ApiCall("show-changes", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showChangesRes.Success {
+		return fmt.Errorf(showChangesRes.ErrorMsg)
+	}
+
+	changes := showChangesRes.GetData()
+
+	log.Println("Read Changes - Show JSON = ", redactPayload(d, changes))
+
+	added, modified, deleted := changedObjects(changes)
+
+	for arg, objects := range map[string][]map[string]interface{}{
+		"added_objects":    added,
+		"modified_objects": modified,
+		"deleted_objects":  deleted,
+	} {
+		var objectsState []map[string]interface{}
+		for _, obj := range objects {
+			objectJson, err := json.Marshal(obj)
+			if err != nil {
+				return fmt.Errorf("failed to encode object %v: %s", obj["uid"], err.Error())
+			}
+			objectsState = append(objectsState, map[string]interface{}{
+				"name": obj["name"],
+				"uid":  obj["uid"],
+				"type": obj["type"],
+				"json": string(objectJson),
+			})
+		}
+		_ = d.Set(arg, objectsState)
+	}
+
+	jsonResponse, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	_ = d.Set("response", string(jsonResponse))
+
+	d.SetId("show-changes-" + acctest.RandString(10))
+
+	return nil
+}
+
+// currentSessionUid returns the UID of the session of the client.
+func currentSessionUid(client *checkpoint.ApiClient) (string, error) {
+	showSessionRes, err := client.ApiCall("show-session", map[string]interface{}{}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return "", fmt.Errorf(err.Error())
+	}
+	if !showSessionRes.Success {
+		return "", fmt.Errorf(showSessionRes.ErrorMsg)
+	}
+	uid, _ := showSessionRes.GetData()["uid"].(string)
+	return uid, nil
+}
+
+// ChangesOperations returns the operations of the reply of show-changes. The reply is of the show-changes task, the
+// operations are in the changes of the task details. Every operation holds the added-objects, modified-objects and
+// deleted-objects of a session.
+func ChangesOperations(changes map[string]interface{}) []map[string]interface{} {
+	var operations []map[string]interface{}
+	tasks, _ := changes["tasks"].([]interface{})
+	for _, task := range tasks {
+		taskMap, _ := task.(map[string]interface{})
+		details, _ := taskMap["task-details"].([]interface{})
+		for _, detail := range details {
+			detailMap, _ := detail.(map[string]interface{})
+			changesList, _ := detailMap["changes"].([]interface{})
+			for _, change := range changesList {
+				changeMap, _ := change.(map[string]interface{})
+				if v, ok := changeMap["operations"].(map[string]interface{}); ok {
+					operations = append(operations, v)
+				}
+			}
+		}
+	}
+	return operations
+}
+
+// changedObjects returns the objects that were added, modified and deleted in the reply of show-changes.
+func changedObjects(changes map[string]interface{}) (added, modified, deleted []map[string]interface{}) {
+	for _, operations := range ChangesOperations(changes) {
+		added = append(added, objectMaps(operations["added-objects"], "")...)
+		modified = append(modified, objectMaps(operations["modified-objects"], "new-object")...)
+		deleted = append(deleted, objectMaps(operations["deleted-objects"], "")...)
+	}
+	return added, modified, deleted
+}
+
+// objectMaps returns the objects of a list. If key is set and an item holds the object under key, the object is taken
+// from there.
+func objectMaps(v interface{}, key string) []map[string]interface{} {
+	list, _ := v.([]interface{})
+	var objects []map[string]interface{}
+	for _, item := range list {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if inner, ok := obj[key].(map[string]interface{}); ok && key != "" {
+			obj = inner
+		}
+		objects = append(objects, obj)
+	}
+	return objects
+}
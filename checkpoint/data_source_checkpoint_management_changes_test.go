@@ -0,0 +1,84 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementChanges_basic(t *testing.T) {
+
+	objName := "tfTestManagementDataChanges_" + acctest.RandString(6)
+	dataSourceName := "data.checkpoint_management_changes.data_test"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementChangesConfig(objName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "added_objects.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "added_objects.0.name", objName),
+					resource.TestCheckResourceAttr(dataSourceName, "added_objects.0.type", "host"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceManagementChangesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_host" "host" {
+    name = "%s"
+    ipv4_address = "192.0.2.1"
+}
+
+data "checkpoint_management_changes" "data_test" {
+    depends_on = [checkpoint_management_host.host]
+}
+`, name)
+}
+
+func TestUnitDataSourceCheckpointManagementChanges_session(t *testing.T) {
+
+	mock := newMockApiServer(t)
+	sid := mock.login()["sid"].(string)
+	mock.run("add-host", map[string]interface{}{"name": "tfModifiedHost", "ipv4-address": "192.0.2.1"}, sid)
+	mock.run("add-host", map[string]interface{}{"name": "tfDeletedHost", "ipv4-address": "192.0.2.2"}, sid)
+	mock.run("publish", map[string]interface{}{}, sid)
+	mock.run("set-host", map[string]interface{}{"name": "tfModifiedHost", "ipv4-address": "192.0.2.3"}, sid)
+	mock.run("delete-host", map[string]interface{}{"name": "tfDeletedHost"}, sid)
+	mock.run("add-host", map[string]interface{}{"name": "tfAddedHost", "ipv4-address": "192.0.2.4"}, sid)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + `
+data "checkpoint_management_changes" "test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "added_objects.#", "1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "added_objects.0.name", "tfAddedHost"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "modified_objects.#", "1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "modified_objects.0.name", "tfModifiedHost"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "modified_objects.0.json", `{"domain":{"domain-type":"domain","name":"SMC User","uid":"41e821a0-3720-11e3-aa6e-0800200c9fde"},"ipv4-address":"192.0.2.3","name":"tfModifiedHost","read-only":false,"type":"host","uid":"`+mock.object("host", "tfModifiedHost")["uid"].(string)+`"}`),
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "deleted_objects.#", "1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "deleted_objects.0.name", "tfDeletedHost"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_changes.test", "deleted_objects.0.type", "host"),
+				),
+			},
+		},
+	})
+}
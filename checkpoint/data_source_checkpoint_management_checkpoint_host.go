@@ -420,7 +420,7 @@ func dataSourceManagementCheckpointHostRead(d *schema.ResourceData, m interface{
 
 	checkpointHost := showCheckpointHostRes.GetData()
 
-	log.Println("Read CheckpointHost - Show JSON = ", checkpointHost)
+	log.Println("Read CheckpointHost - Show JSON = ", redactPayload(d, checkpointHost))
 
 	if v := checkpointHost["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -91,7 +91,7 @@ func dataSourceManagementCloudServicesRead(d *schema.ResourceData, m interface{}
 
 	showCloudServicesRes := showCloudServices.GetData()
 
-	log.Println("Show Cloud Services - JSON = ", showCloudServicesRes)
+	log.Println("Show Cloud Services - JSON = ", redactPayload(d, showCloudServicesRes))
 
 	if v := showCloudServicesRes["status"]; v != nil {
 		_ = d.Set("status", v)
@@ -184,7 +184,7 @@ func dataSourceManagementClusterMemberRead(d *schema.ResourceData, m interface{}
 
 	clusterMember := showClusterMemberRes.GetData()
 
-	log.Println("Read ClusterMember - Show JSON = ", clusterMember)
+	log.Println("Read ClusterMember - Show JSON = ", redactPayload(d, clusterMember))
 
 	if v := clusterMember["name"]; v != nil {
 		_ = d.Set("name", v)
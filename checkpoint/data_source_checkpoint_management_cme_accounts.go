@@ -58,7 +58,11 @@ func dataSourceManagementCMEAccountsRead(d *schema.ResourceData, m interface{})
 
 	log.Println("Read cme accounts")
 
-	url := CmeApiPath + "/accounts"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts"
 	AccountsRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
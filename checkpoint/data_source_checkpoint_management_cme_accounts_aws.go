@@ -159,7 +159,11 @@ func dataSourceManagementCMEAccountsAWSRead(d *schema.ResourceData, m interface{
 	}
 	log.Println("Read cme AWS account - name = ", name)
 
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	AWSAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -79,7 +79,11 @@ func dataSourceManagementCMEAccountsAzureRead(d *schema.ResourceData, m interfac
 	}
 
 	log.Println("Read cme Azure account - name = ", name)
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	AzureAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
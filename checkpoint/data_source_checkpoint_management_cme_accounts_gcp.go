@@ -69,7 +69,11 @@ func dataSourceManagementCMEAccountsGCPRead(d *schema.ResourceData, m interface{
 	}
 
 	log.Println("Read cme GCP account - name = ", name)
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	GCPAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -0,0 +1,136 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func dataSourceManagementCMEAccountsOCI() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementCMEAccountsOCIRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique account name for identification.",
+			},
+			"tenancy_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OCID of the tenancy.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OCID of the user that CME connects to OCI with.",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fingerprint of the API signing key of the user.",
+			},
+			"private_key_file": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private key file of the API signing key.",
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of OCI regions, in which the gateways are being deployed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"compartments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of OCIDs of the compartments, in which the gateways are being deployed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deletion_tolerance": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of CME cycles to wait when the cloud provider does not return a GW until its deletion.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account's domain name in MDS environment.",
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The platform of the account.",
+			},
+			"gw_configurations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of GW configurations attached to the account",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagementCMEAccountsOCIRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	var name string
+
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	log.Println("Read cme OCI account - name = ", name)
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
+
+	OCIAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	account := OCIAccountRes.GetData()
+	if checkIfRequestFailed(account) {
+		errMessage := buildErrorMessage(account)
+		return fmt.Errorf(errMessage)
+	}
+	d.SetId("cme-oci-account-" + name + "-" + acctest.RandString(10))
+
+	OCIAccount := account["result"].(map[string]interface{})
+
+	_ = d.Set("name", OCIAccount["name"])
+
+	_ = d.Set("tenancy_id", OCIAccount["tenancy_id"])
+
+	_ = d.Set("user_id", OCIAccount["user_id"])
+
+	_ = d.Set("fingerprint", OCIAccount["fingerprint"])
+
+	_ = d.Set("private_key_file", OCIAccount["private_key_file"])
+
+	_ = d.Set("regions", OCIAccount["regions"])
+
+	_ = d.Set("compartments", OCIAccount["compartments"])
+
+	_ = d.Set("deletion_tolerance", OCIAccount["deletion_tolerance"])
+
+	_ = d.Set("domain", OCIAccount["domain"])
+
+	_ = d.Set("platform", OCIAccount["platform"])
+
+	_ = d.Set("gw_configurations", OCIAccount["gw_configurations"])
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementCMEAccountsOCI_basic(t *testing.T) {
+	resourceName := "checkpoint_management_cme_accounts_oci.test"
+	dataSourceName := "data.checkpoint_management_cme_accounts_oci.data_test"
+	accountName := "test-account"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this test")
+	} else if context != "web_api" {
+		t.Skip("Skipping cme api test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementCMEAccountsOCIConfig(accountName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tenancy_id", resourceName, "tenancy_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "regions.#", resourceName, "regions.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceManagementCMEAccountsOCIConfig(accountName string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_cme_accounts_oci" "test" {
+  name             = "%s"
+  tenancy_id       = "ocid1.tenancy.oc1..aaaaaaaaexample"
+  user_id          = "ocid1.user.oc1..aaaaaaaaexample"
+  fingerprint      = "12:34:56:78:9a:bc:de:f0:12:34:56:78:9a:bc:de:f0"
+  private_key_file = "oci_api_key.pem"
+  regions          = ["us-ashburn-1"]
+}
+
+data "checkpoint_management_cme_accounts_oci" "data_test" {
+  name = "${checkpoint_management_cme_accounts_oci.test.name}"
+}
+`, accountName)
+}
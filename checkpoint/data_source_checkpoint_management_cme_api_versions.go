@@ -33,9 +33,7 @@ func dataSourceManagementCMEAPIVersionsRead(d *schema.ResourceData, m interface{
 	client := m.(*checkpoint.ApiClient)
 
 	log.Println("Read cme api versions")
-	url := CmeApiPath + "/api-versions"
-
-	cmeVersionRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+	cmeVersionRes, err := client.ApiCall(CmeApiVersionsPath, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
 	if err != nil {
 		return fmt.Errorf(err.Error())
@@ -25,7 +25,11 @@ func dataSourceManagementCMEDelayCycleRead(d *schema.ResourceData, m interface{}
 	client := m.(*checkpoint.ApiClient)
 
 	log.Println("Read cme delay cycle")
-	url := CmeApiPath + "/generalConfiguration/delayCycle"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/generalConfiguration/delayCycle"
 
 	cmeDelayCycleRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
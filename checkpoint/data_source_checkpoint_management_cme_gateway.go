@@ -0,0 +1,82 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func dataSourceManagementCMEGateway() *schema.Resource {
+	gatewaySchema := cmeGatewaySchema()
+	gatewaySchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The gateway name.",
+	}
+	gatewaySchema["wait_for_provisioning"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Wait until the gateway is provisioned. Fails when the provisioning or the policy installation of the gateway fails, or when wait_timeout passes.",
+	}
+	gatewaySchema["wait_timeout"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     30,
+		Description: "Minutes to wait for the provisioning of the gateway when wait_for_provisioning is true.",
+	}
+	return &schema.Resource{
+		Read:   dataSourceManagementCMEGatewayRead,
+		Schema: gatewaySchema,
+	}
+}
+
+func dataSourceManagementCMEGatewayRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	name := d.Get("name").(string)
+
+	log.Println("Read cme gateway - name = ", name)
+
+	gateways, err := waitForCMEGateways(d, func() ([]map[string]interface{}, error) {
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return nil, err
+		}
+		url := cmePath + "/gateways/" + name
+
+		cmeGatewayRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+
+		if err != nil {
+			return nil, fmt.Errorf(err.Error())
+		}
+
+		data := cmeGatewayRes.GetData()
+		if checkIfRequestFailed(data) {
+			if cmeObjectNotFound(data) && d.Get("wait_for_provisioning").(bool) {
+				// CME did not discover the gateway yet
+				return nil, nil
+			}
+			errMessage := buildErrorMessage(data)
+			return nil, fmt.Errorf(errMessage)
+		}
+		gateway, ok := data["result"].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("CME returned no gateway %s", name)
+		}
+		return []map[string]interface{}{gateway}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("cme-gateway-" + name + "-" + acctest.RandString(10))
+
+	gateway := cmeGatewayToReturn(gateways[0])
+	for field, value := range gateway {
+		_ = d.Set(field, value)
+	}
+
+	return nil
+}
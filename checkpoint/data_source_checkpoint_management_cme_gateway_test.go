@@ -0,0 +1,87 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestAccDataSourceCheckpointManagementCMEGateway_basic(t *testing.T) {
+	dataSourceName := "data.checkpoint_management_cme_gateway.data_test"
+	gatewayName := os.Getenv("CHECKPOINT_CME_GATEWAY")
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this test")
+	} else if context != "web_api" {
+		t.Skip("Skipping cme api test")
+	} else if gatewayName == "" {
+		t.Skip("Env CHECKPOINT_CME_GATEWAY must be specified to run this test")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementCMEGatewayConfig(gatewayName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", gatewayName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provisioning_state"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceCheckpointManagementCMEGateway_basic(t *testing.T) {
+	dataSourceName := "data.checkpoint_management_cme_gateway.data_test"
+	mock := newMockApiServer(t)
+	defer func(interval time.Duration) { cmeGatewaysPollInterval = interval }(cmeGatewaysPollInterval)
+	cmeGatewaysPollInterval = 10 * time.Millisecond
+
+	mock.setCmeObject("gateways", testUnitCmeGateway("gw1", "account1", "configuration1", "Complete", "Succeeded", ""))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      mock.providerConfig() + testAccDataSourceManagementCMEGatewayConfig("gw2", false),
+				ExpectError: regexp.MustCompile(`gateways gw2 does not exist`),
+			},
+			{
+				Config: mock.providerConfig() + testAccDataSourceManagementCMEGatewayConfig("gw1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account", "account1"),
+					resource.TestCheckResourceAttr(dataSourceName, "gw_configuration", "configuration1"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_state", "complete"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioned", "true"),
+				),
+			},
+			{
+				// The gateway is waited for until CME discovers and provisions it
+				PreConfig: func() {
+					testUnitSetCmeObjectAfterCalls(mock, "cme-api/"+CmeApiVersion+"/gateways/gw2", 2, "gateways",
+						testUnitCmeGateway("gw2", "account1", "configuration1", "in_progress", "", ""))
+					testUnitSetCmeObjectAfterCalls(mock, "cme-api/"+CmeApiVersion+"/gateways/gw2", 4, "gateways",
+						testUnitCmeGateway("gw2", "account1", "configuration1", "complete", "succeeded", ""))
+				},
+				Config: mock.providerConfig() + testAccDataSourceManagementCMEGatewayConfig("gw2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_state", "complete"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioned", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceManagementCMEGatewayConfig(name string, waitForProvisioning bool) string {
+	return `
+data "checkpoint_management_cme_gateway" "data_test" {
+  name = "` + name + `"
+  wait_for_provisioning = ` + map[bool]string{true: "true", false: "false"}[waitForProvisioning] + `
+}
+`
+}
@@ -0,0 +1,263 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Provisioning states and policy installation statuses of CME gateways. A gateway is provisioned when CME completed its
+// provisioning and the installation of its policy succeeded.
+const (
+	cmeGatewayProvisioningComplete = "complete"
+	cmeGatewayProvisioningFailed   = "failed"
+	cmeGatewayPolicyInstalled      = "succeeded"
+	cmeGatewayPolicyFailed         = "failed"
+)
+
+// cmeGatewaysPollInterval is the interval between the reads of the gateways while waiting for their provisioning.
+var cmeGatewaysPollInterval = 30 * time.Second
+
+func dataSourceManagementCMEGateways() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementCMEGatewaysRead,
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the gateways of this CME account.",
+			},
+			"gw_configuration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Return only the gateways of this GW configuration.",
+			},
+			"wait_for_provisioning": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Wait until every gateway is provisioned. Fails when the provisioning or the policy installation of a gateway fails, or when wait_timeout passes.",
+			},
+			"wait_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Minutes to wait for the provisioning of the gateways when wait_for_provisioning is true.",
+			},
+			"all_provisioned": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if there are gateways and every gateway is provisioned and its policy is installed.",
+			},
+			"result": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Response data - contains the CME gateways",
+				Elem: &schema.Resource{
+					Schema: cmeGatewaySchema(),
+				},
+			},
+		},
+	}
+}
+
+// cmeGatewaySchema returns the computed fields of a CME gateway.
+func cmeGatewaySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The gateway name.",
+		},
+		"account": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CME account of the gateway.",
+		},
+		"gw_configuration": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The GW configuration of the gateway.",
+		},
+		"platform": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The platform of the gateway.",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The region of the gateway.",
+		},
+		"ip_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address that the management server connects to the gateway with.",
+		},
+		"provisioning_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The provisioning state of the gateway, e.g. in_progress, complete or failed.",
+		},
+		"last_error": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The last provisioning error of the gateway.",
+		},
+		"policy": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The policy that is installed on the gateway.",
+		},
+		"policy_installation_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the last policy installation on the gateway, e.g. in_progress, succeeded or failed.",
+		},
+		"provisioned": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the provisioning of the gateway is complete and its policy is installed.",
+		},
+	}
+}
+
+func dataSourceManagementCMEGatewaysRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	account := d.Get("account").(string)
+	gwConfiguration := d.Get("gw_configuration").(string)
+
+	log.Println("Read cme gateways - account = ", account, ", gw configuration = ", gwConfiguration)
+
+	gateways, err := waitForCMEGateways(d, func() ([]map[string]interface{}, error) {
+		return readCMEGateways(client, account, gwConfiguration)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("cme-gateways-" + acctest.RandString(10))
+
+	allProvisioned := len(gateways) > 0
+	var gatewaysListToReturn []map[string]interface{}
+	for _, gateway := range gateways {
+		gatewayToReturn := cmeGatewayToReturn(gateway)
+		allProvisioned = allProvisioned && gatewayToReturn["provisioned"].(bool)
+		gatewaysListToReturn = append(gatewaysListToReturn, gatewayToReturn)
+	}
+	if len(gatewaysListToReturn) > 0 {
+		_ = d.Set("result", gatewaysListToReturn)
+	} else {
+		_ = d.Set("result", []interface{}{})
+	}
+	_ = d.Set("all_provisioned", allProvisioned)
+
+	return nil
+}
+
+// readCMEGateways returns the CME gateways of account and gwConfiguration, or all of them when they are empty.
+func readCMEGateways(client *checkpoint.ApiClient, account string, gwConfiguration string) ([]map[string]interface{}, error) {
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return nil, err
+	}
+	url := cmePath + "/gateways"
+
+	cmeGatewaysRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+
+	if err != nil {
+		return nil, fmt.Errorf(err.Error())
+	}
+
+	data := cmeGatewaysRes.GetData()
+	if checkIfRequestFailed(data) {
+		errMessage := buildErrorMessage(data)
+		return nil, fmt.Errorf(errMessage)
+	}
+
+	var gateways []map[string]interface{}
+	gatewaysList, _ := data["result"].([]interface{})
+	for i := range gatewaysList {
+		gateway, ok := gatewaysList[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if account != "" && gateway["account"] != account {
+			continue
+		}
+		if gwConfiguration != "" && gateway["gw_configuration"] != gwConfiguration {
+			continue
+		}
+		gateways = append(gateways, gateway)
+	}
+	sort.Slice(gateways, func(i, j int) bool {
+		return fmt.Sprint(gateways[i]["name"]) < fmt.Sprint(gateways[j]["name"])
+	})
+	return gateways, nil
+}
+
+// waitForCMEGateways reads the gateways by read, again every cmeGatewaysPollInterval until every gateway is provisioned
+// when wait_for_provisioning is true.
+func waitForCMEGateways(d *schema.ResourceData, read func() ([]map[string]interface{}, error)) ([]map[string]interface{}, error) {
+	gateways, err := read()
+	if err != nil || !d.Get("wait_for_provisioning").(bool) {
+		return gateways, err
+	}
+
+	deadline := time.Now().Add(time.Duration(d.Get("wait_timeout").(int)) * time.Minute)
+	for {
+		var pending []string
+		for _, gateway := range gateways {
+			gatewayToReturn := cmeGatewayToReturn(gateway)
+			name := fmt.Sprint(gatewayToReturn["name"])
+			if gatewayToReturn["provisioning_state"] == cmeGatewayProvisioningFailed {
+				return nil, fmt.Errorf("provisioning of CME gateway %s failed: %v", name, gatewayToReturn["last_error"])
+			}
+			if gatewayToReturn["policy_installation_status"] == cmeGatewayPolicyFailed {
+				return nil, fmt.Errorf("policy installation on CME gateway %s failed: %v", name, gatewayToReturn["last_error"])
+			}
+			if !gatewayToReturn["provisioned"].(bool) {
+				pending = append(pending, name)
+			}
+		}
+		if len(gateways) > 0 && len(pending) == 0 {
+			return gateways, nil
+		}
+
+		if time.Now().After(deadline) {
+			if len(gateways) == 0 {
+				return nil, fmt.Errorf("timed out waiting for CME to provision gateways")
+			}
+			return nil, fmt.Errorf("timed out waiting for the provisioning of CME gateways %s", strings.Join(pending, ", "))
+		}
+		log.Printf("Wait for the provisioning of CME gateways %s... sleeping for %s", strings.Join(pending, ", "), cmeGatewaysPollInterval)
+		time.Sleep(cmeGatewaysPollInterval)
+
+		gateways, err = read()
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// cmeGatewayToReturn returns the fields of a CME gateway as returned by the data sources.
+func cmeGatewayToReturn(gateway map[string]interface{}) map[string]interface{} {
+	gatewayToReturn := make(map[string]interface{})
+	for _, field := range []string{"name", "account", "gw_configuration", "platform", "region", "ip_address", "provisioning_state", "last_error", "policy", "policy_installation_status"} {
+		if v, ok := gateway[field].(string); ok {
+			gatewayToReturn[field] = v
+		} else {
+			gatewayToReturn[field] = ""
+		}
+	}
+	gatewayToReturn["provisioning_state"] = strings.ToLower(gatewayToReturn["provisioning_state"].(string))
+	gatewayToReturn["policy_installation_status"] = strings.ToLower(gatewayToReturn["policy_installation_status"].(string))
+	gatewayToReturn["provisioned"] = gatewayToReturn["provisioning_state"] == cmeGatewayProvisioningComplete &&
+		gatewayToReturn["policy_installation_status"] == cmeGatewayPolicyInstalled
+	return gatewayToReturn
+}
@@ -0,0 +1,135 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestAccDataSourceCheckpointManagementCMEGateways_basic(t *testing.T) {
+	dataSourceName := "data.checkpoint_management_cme_gateways.data_test"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this test")
+	} else if context != "web_api" {
+		t.Skip("Skipping cme api test")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementCMEGatewaysConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "result.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "all_provisioned"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceCheckpointManagementCMEGateways_basic(t *testing.T) {
+	mock := newMockApiServer(t)
+	defer func(interval time.Duration) { cmeGatewaysPollInterval = interval }(cmeGatewaysPollInterval)
+	cmeGatewaysPollInterval = 10 * time.Millisecond
+
+	mock.setCmeObject("gateways", testUnitCmeGateway("gw1", "account1", "configuration1", "complete", "succeeded", ""))
+	mock.setCmeObject("gateways", testUnitCmeGateway("gw2", "account1", "configuration2", "in_progress", "", ""))
+	mock.setCmeObject("gateways", testUnitCmeGateway("gw3", "account2", "configuration3", "failed", "", "SIC failed"))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + `
+data "checkpoint_management_cme_gateways" "account" {
+  account = "account1"
+}
+
+data "checkpoint_management_cme_gateways" "gw_configuration" {
+  gw_configuration = "configuration1"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.account", "result.#", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.account", "result.1.name", "gw2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.account", "result.1.provisioning_state", "in_progress"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.account", "result.1.provisioned", "false"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.account", "all_provisioned", "false"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.gw_configuration", "result.#", "1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.gw_configuration", "result.0.policy_installation_status", "succeeded"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.gw_configuration", "all_provisioned", "true"),
+				),
+			},
+			{
+				Config:      mock.providerConfig() + testUnitDataSourceManagementCMEGatewaysWaitConfig("account1", 0),
+				ExpectError: regexp.MustCompile(`timed out waiting for the provisioning of CME gateways gw2`),
+			},
+			{
+				Config:      mock.providerConfig() + testUnitDataSourceManagementCMEGatewaysWaitConfig("account2", 1),
+				ExpectError: regexp.MustCompile(`provisioning of CME gateway gw3 failed: SIC failed`),
+			},
+			{
+				PreConfig: func() {
+					testUnitSetCmeObjectAfterCalls(mock, "cme-api/"+CmeApiVersion+"/gateways", 3, "gateways",
+						testUnitCmeGateway("gw2", "account1", "configuration2", "complete", "succeeded", ""))
+				},
+				Config: mock.providerConfig() + testUnitDataSourceManagementCMEGatewaysWaitConfig("account1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.wait", "result.#", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.wait", "result.1.provisioned", "true"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gateways.wait", "all_provisioned", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitCmeGateway(name string, account string, gwConfiguration string, state string, policyStatus string, lastError string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                       name,
+		"account":                    account,
+		"gw_configuration":           gwConfiguration,
+		"platform":                   "aws",
+		"region":                     "us-east-1",
+		"ip_address":                 "192.0.2.1",
+		"provisioning_state":         state,
+		"last_error":                 lastError,
+		"policy":                     "Standard",
+		"policy_installation_status": policyStatus,
+	}
+}
+
+// testUnitSetCmeObjectAfterCalls sets a CME object of the mock server once command was called n more times, e.g. a
+// gateway that CME provisions while the provider waits for it.
+func testUnitSetCmeObjectAfterCalls(mock *mockApiServer, command string, n int, collection string, obj map[string]interface{}) {
+	calls := mock.callCount(command) + n
+	go func() {
+		for mock.callCount(command) < calls {
+			time.Sleep(time.Millisecond)
+		}
+		mock.setCmeObject(collection, obj)
+	}()
+}
+
+func testUnitDataSourceManagementCMEGatewaysWaitConfig(account string, waitTimeout int) string {
+	return fmt.Sprintf(`
+data "checkpoint_management_cme_gateways" "wait" {
+  account = "%s"
+  wait_for_provisioning = true
+  wait_timeout = %d
+}
+`, account, waitTimeout)
+}
+
+func testAccDataSourceManagementCMEGatewaysConfig() string {
+	return fmt.Sprintf(`
+data "checkpoint_management_cme_gateways" "data_test" {
+}
+`)
+}
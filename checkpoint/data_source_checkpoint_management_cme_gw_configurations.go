@@ -62,7 +62,7 @@ func dataSourceManagementCMEGWConfigurations() *schema.Resource {
 						"related_account": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "Related account name (aws/azure/gcp accounts)",
+							Description: "Related account name (aws/azure/gcp/oci accounts)",
 						},
 						"blades": {
 							Type:        schema.TypeList,
@@ -221,7 +221,11 @@ func dataSourceManagementCMEGWConfigurationsRead(d *schema.ResourceData, m inter
 
 	log.Println("Read cme GW configurations")
 
-	url := CmeApiPath + "/gwConfigurations"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations"
 
 	cmeGWConfigurationsRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
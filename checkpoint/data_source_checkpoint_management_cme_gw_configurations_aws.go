@@ -36,7 +36,7 @@ func dataSourceManagementCMEGWConfigurationsAWS() *schema.Resource {
 			"related_account": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Related account name (aws/azure/gcp accounts)",
+				Description: "Related account name (aws/azure/gcp/oci accounts)",
 			},
 			"section_name": {
 				Type:        schema.TypeString,
@@ -253,7 +253,11 @@ func dataSourceManagementCMEGWConfigurationsAWSRead(d *schema.ResourceData, m in
 	}
 	log.Println("Read cme AWS GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	AWSGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
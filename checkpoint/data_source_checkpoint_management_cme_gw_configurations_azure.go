@@ -35,7 +35,7 @@ func dataSourceManagementCMEGWConfigurationsAzure() *schema.Resource {
 			"related_account": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Related account name (aws/azure/gcp accounts)",
+				Description: "Related account name (aws/azure/gcp/oci accounts)",
 			},
 			"blades": {
 				Type:        schema.TypeList,
@@ -222,7 +222,11 @@ func dataSourceManagementCMEGWConfigurationsAzureRead(d *schema.ResourceData, m
 	}
 	log.Println("Read cme Azure GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	AzureGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -35,7 +35,7 @@ func dataSourceManagementCMEGWConfigurationsGCP() *schema.Resource {
 			"related_account": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Related account name (aws/azure/gcp accounts)",
+				Description: "Related account name (aws/azure/gcp/oci accounts)",
 			},
 			"section_name": {
 				Type:        schema.TypeString,
@@ -217,7 +217,11 @@ func dataSourceManagementCMEGWConfigurationsGCPRead(d *schema.ResourceData, m in
 	}
 	log.Println("Read cme GCP GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	GCPGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -0,0 +1,86 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementCMEGWConfigurationsOCI_basic(t *testing.T) {
+	resourceName := "checkpoint_management_cme_gw_configurations_oci.test"
+	dataSourceName := "data.checkpoint_management_cme_gw_configurations_oci.data_test"
+	gwConfigurationName := "test-gw-configuration"
+	accountName := "test-account"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this test")
+	} else if context != "web_api" {
+		t.Skip("Skipping cme api test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementCMEGWConfigurationsOCIConfig(accountName, gwConfigurationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "related_account", resourceName, "related_account"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "color", resourceName, "color"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "x_forwarded_for", resourceName, "x_forwarded_for"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "communication_with_servers_behind_nat", resourceName, "communication_with_servers_behind_nat"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_awareness_settings", resourceName, "identity_awareness_settings"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceManagementCMEGWConfigurationsOCIConfig(accountName string, gwConfigurationName string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_cme_accounts_oci" "oci_account" {
+  name             = "%s"
+  tenancy_id       = "ocid1.tenancy.oc1..aaaaaaaaexample"
+  user_id          = "ocid1.user.oc1..aaaaaaaaexample"
+  fingerprint      = "12:34:56:78:9a:bc:de:f0:12:34:56:78:9a:bc:de:f0"
+  private_key_file = "oci_api_key.pem"
+  regions          = ["us-ashburn-1"]
+}
+
+resource "checkpoint_management_cme_gw_configurations_oci" "test" {
+  name            = "%s"
+  related_account = "${checkpoint_management_cme_accounts_oci.oci_account.name}"
+  version         = "R82"
+  base64_sic_key  = "MTIzNDU2Nzg="
+  policy          = "Standard"
+  x_forwarded_for = true
+  color           = "black"
+  communication_with_servers_behind_nat = "translated-ip-only"
+  blades {
+	ips                          = false
+	anti_bot                     = false
+	anti_virus                   = false
+	https_inspection             = false
+	application_control          = false
+	autonomous_threat_prevention = false
+	content_awareness            = false
+	identity_awareness           = true
+	ipsec_vpn                    = false
+	threat_emulation             = false
+	url_filtering                = false
+	vpn                          = false
+  }
+  identity_awareness_settings {
+    enable_cloudguard_controller = true
+  }
+}
+
+data "checkpoint_management_cme_gw_configurations_oci" "data_test" {
+  name = "${checkpoint_management_cme_gw_configurations_oci.test.name}"
+}
+`, accountName, gwConfigurationName)
+}
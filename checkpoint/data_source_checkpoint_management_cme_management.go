@@ -35,7 +35,11 @@ func dataSourceManagementCMEManagementRead(d *schema.ResourceData, m interface{}
 	client := m.(*checkpoint.ApiClient)
 
 	log.Println("Read cme management")
-	url := CmeApiPath + "/management"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/management"
 
 	cmeManagementRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -25,7 +25,11 @@ func dataSourceManagementCMEVersionRead(d *schema.ResourceData, m interface{}) e
 	client := m.(*checkpoint.ApiClient)
 
 	log.Println("Read cme version")
-	url := CmeApiPath + "/generalConfiguration/cmeVersion"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/generalConfiguration/cmeVersion"
 
 	cmeVersionRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
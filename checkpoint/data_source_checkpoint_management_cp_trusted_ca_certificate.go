@@ -137,7 +137,7 @@ func dataSourceManagementCpTrustedCaCertificateRead(d *schema.ResourceData, m in
 
 	cpTrustedCaCertificateObj := cpTrustedCaCertificateObjRes.GetData()
 
-	log.Println("Read CP Trusted CA Certificate Object - Show JSON = ", cpTrustedCaCertificateObj)
+	log.Println("Read CP Trusted CA Certificate Object - Show JSON = ", redactPayload(d, cpTrustedCaCertificateObj))
 
 	if v := cpTrustedCaCertificateObj["uid"]; v != nil {
 		_ = d.Set("uid", v)
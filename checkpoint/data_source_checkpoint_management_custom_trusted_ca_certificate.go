@@ -126,7 +126,7 @@ func dataSourceManagementCustomTrustedCaCertificateRead(d *schema.ResourceData,
 
 	customTrustedCaCertificateObj := CustomTrustedCaCertificateObjRes.GetData()
 
-	log.Println("Read CP Trusted CA Certificate Object - Show JSON = ", customTrustedCaCertificateObj)
+	log.Println("Read CP Trusted CA Certificate Object - Show JSON = ", redactPayload(d, customTrustedCaCertificateObj))
 
 	if v := customTrustedCaCertificateObj["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -250,7 +250,7 @@ func dataSourceManagementDataCenterContentRead(d *schema.ResourceData, m interfa
 	}
 	DataCenterContent := showDataCenterContentRes.GetData()
 
-	log.Println("Read DataCenterContent - Show JSON = ", DataCenterContent)
+	log.Println("Read DataCenterContent - Show JSON = ", redactPayload(d, DataCenterContent))
 
 	d.SetId("show-data-center-content-" + acctest.RandString(10))
 
@@ -202,7 +202,7 @@ func dataSourceManagementDataCenterObjectRead(d *schema.ResourceData, m interfac
 
 	dataCenterObj := showDataCenterObjRes.GetData()
 
-	log.Println("Read Data Center Object - Show JSON = ", dataCenterObj)
+	log.Println("Read Data Center Object - Show JSON = ", redactPayload(d, dataCenterObj))
 	if v := dataCenterObj["name"]; v != nil {
 		_ = d.Set("name", v)
 	}
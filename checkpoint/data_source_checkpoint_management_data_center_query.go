@@ -108,7 +108,7 @@ func dataSourceDataCenterQueryRead(d *schema.ResourceData, m interface{}) error
 
 	KeysToFixedKeys := getKeysToFixedKeys()
 
-	log.Println("Read DataCenterQuery - Show JSON = ", dataCenterQuery)
+	log.Println("Read DataCenterQuery - Show JSON = ", redactPayload(d, dataCenterQuery))
 
 	if v := dataCenterQuery["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -94,7 +94,7 @@ func dataSourceManagementDataTypeCompoundGroupRead(d *schema.ResourceData, m int
 
 	dataTypeCompoundGroup := showDataTypeCompoundGroupRes.GetData()
 
-	log.Println("Read DataTypeCompoundGroup - Show JSON = ", dataTypeCompoundGroup)
+	log.Println("Read DataTypeCompoundGroup - Show JSON = ", redactPayload(d, dataTypeCompoundGroup))
 
 	if v := dataTypeCompoundGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
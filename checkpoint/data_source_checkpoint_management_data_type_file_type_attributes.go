@@ -112,7 +112,7 @@ func dataSourceManagementDataTypeFileAttributesRead(d *schema.ResourceData, m in
 
 	dataTypeFileAttributes := showDataTypeFileAttributesRes.GetData()
 
-	log.Println("Read DataTypeFileAttributes - Show JSON = ", dataTypeFileAttributes)
+	log.Println("Read DataTypeFileAttributes - Show JSON = ", redactPayload(d, dataTypeFileAttributes))
 
 	if v := dataTypeFileAttributes["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -95,7 +95,7 @@ func dataSourceManagementDataTypeGroupRead(d *schema.ResourceData, m interface{}
 
 	dataTypeGroup := showDataTypeGroupRes.GetData()
 
-	log.Println("Read DataTypeGroup - Show JSON = ", dataTypeGroup)
+	log.Println("Read DataTypeGroup - Show JSON = ", redactPayload(d, dataTypeGroup))
 
 	if v := dataTypeGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
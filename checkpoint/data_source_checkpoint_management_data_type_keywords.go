@@ -94,7 +94,7 @@ func dataSourceManagementDataTypeKeywordsRead(d *schema.ResourceData, m interfac
 
 	dataTypeKeywords := showDataTypeKeywordsRes.GetData()
 
-	log.Println("Read DataTypeKeywords - Show JSON = ", dataTypeKeywords)
+	log.Println("Read DataTypeKeywords - Show JSON = ", redactPayload(d, dataTypeKeywords))
 
 	if v := dataTypeKeywords["uid"]; v != nil {
 		_ = d.Set("uid", v)
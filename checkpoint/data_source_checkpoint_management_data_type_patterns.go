@@ -91,7 +91,7 @@ func dataSourceManagementDataTypePatternsRead(d *schema.ResourceData, m interfac
 
 	dataTypePatterns := showDataTypePatternsRes.GetData()
 
-	log.Println("Read DataTypePatterns - Show JSON = ", dataTypePatterns)
+	log.Println("Read DataTypePatterns - Show JSON = ", redactPayload(d, dataTypePatterns))
 
 	if v := dataTypePatterns["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -84,7 +84,7 @@ func dataSourceManagementDataTypeTraditionalGroupRead(d *schema.ResourceData, m
 
 	dataTypeTraditionalGroup := showDataTypeTraditionalGroupRes.GetData()
 
-	log.Println("Read DataTypeTraditionalGroup - Show JSON = ", dataTypeTraditionalGroup)
+	log.Println("Read DataTypeTraditionalGroup - Show JSON = ", redactPayload(d, dataTypeTraditionalGroup))
 
 	if v := dataTypeTraditionalGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
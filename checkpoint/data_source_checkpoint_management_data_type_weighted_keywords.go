@@ -112,7 +112,7 @@ func dataSourceReadManagementDataTypeWeightedKeywords(d *schema.ResourceData, m
 
 	dataTypeWeightedKeywords := showDataTypeWeightedKeywordsRes.GetData()
 
-	log.Println("Read DataTypeWeightedKeywords - Show JSON = ", dataTypeWeightedKeywords)
+	log.Println("Read DataTypeWeightedKeywords - Show JSON = ", redactPayload(d, dataTypeWeightedKeywords))
 
 	if v := dataTypeWeightedKeywords["uid"]; v != nil {
 		_ = d.Set("uid", v)
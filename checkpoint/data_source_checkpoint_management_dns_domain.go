@@ -72,7 +72,7 @@ func dataSourceManagementDnsDomainRead(d *schema.ResourceData, m interface{}) er
 
 	dnsDomain := showDnsDomainRes.GetData()
 
-	log.Println("Read DnsDomain - Show JSON = ", dnsDomain)
+	log.Println("Read DnsDomain - Show JSON = ", redactPayload(d, dnsDomain))
 
 	if v := dnsDomain["uid"]; v != nil {
 		_ = d.Set("uid", v)
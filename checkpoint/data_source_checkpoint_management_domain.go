@@ -104,7 +104,7 @@ func dataSourceManagementDomainRead(d *schema.ResourceData, m interface{}) error
 
 	domain := showDomainRes.GetData()
 
-	log.Println("Read Domain - Show JSON = ", domain)
+	log.Println("Read Domain - Show JSON = ", redactPayload(d, domain))
 
 	if v := domain["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -516,7 +516,7 @@ func dataSourceManagementDomainPermissionsProfileRead(d *schema.ResourceData, m
 
 	domainPermissionsProfile := showDomainPermissionsProfileRes.GetData()
 
-	log.Println("Read DomainPermissionsProfile - Show JSON = ", domainPermissionsProfile)
+	log.Println("Read DomainPermissionsProfile - Show JSON = ", redactPayload(d, domainPermissionsProfile))
 
 	if v := domainPermissionsProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -67,7 +67,7 @@ func dataSourceManagementDynamicGlobalNetworkObjectRead(d *schema.ResourceData,
 
 	dynamicGlobalNetworkObject := showDynamicGlobalNetworkObjectRes.GetData()
 
-	log.Println("Read Dynamic Global Network Object - Show JSON = ", dynamicGlobalNetworkObject)
+	log.Println("Read Dynamic Global Network Object - Show JSON = ", redactPayload(d, dynamicGlobalNetworkObject))
 
 	if v := dynamicGlobalNetworkObject["uid"]; v != nil {
 		_ = d.Set("uid", v)
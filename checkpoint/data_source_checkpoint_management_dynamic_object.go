@@ -68,7 +68,7 @@ func dataSourceManagementDynamicObjectRead(d *schema.ResourceData, m interface{}
 
 	dynamicObject := showDynamicObjectRes.GetData()
 
-	log.Println("Read DynamicObject - Show JSON = ", dynamicObject)
+	log.Println("Read DynamicObject - Show JSON = ", redactPayload(d, dynamicObject))
 
 	if v := dynamicObject["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -78,7 +78,7 @@ func dataSourceManagementExceptionGroupRead(d *schema.ResourceData, m interface{
 
 	exceptionGroup := showExceptionGroupRes.GetData()
 
-	log.Println("Read ExceptionGroup - Show JSON = ", exceptionGroup)
+	log.Println("Read ExceptionGroup - Show JSON = ", redactPayload(d, exceptionGroup))
 
 	if v := exceptionGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -107,7 +107,7 @@ func dataSourceManagementExternalTrustedCaRead(d *schema.ResourceData, m interfa
 
 	externalTrustedCa := showExternalTrustedCaRes.GetData()
 
-	log.Println("Read ExternalTrustedCa - Show JSON = ", externalTrustedCa)
+	log.Println("Read ExternalTrustedCa - Show JSON = ", redactPayload(d, externalTrustedCa))
 
 	if v := externalTrustedCa["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -145,7 +145,7 @@ func dataSourceManagementGaiaBestPracticeRead(d *schema.ResourceData, m interfac
 
 	gaiaBestPractice := showGaiaBestPractice.GetData()
 
-	log.Println("Read Gaia Best Practice - Show JSON = ", gaiaBestPractice)
+	log.Println("Read Gaia Best Practice - Show JSON = ", redactPayload(d, gaiaBestPractice))
 
 	if v := gaiaBestPractice["uid"]; v != nil {
 		_ = d.Set("uid", v)
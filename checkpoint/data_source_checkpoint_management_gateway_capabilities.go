@@ -289,7 +289,7 @@ func dataSourceManagementSetGatewayCapabilitiesRead(d *schema.ResourceData, m in
 
 	gatewayCapabilities := showGatewayCapabilitiesRes.GetData()
 
-	log.Println("Read Gateway Capabilities - Show JSON = ", gatewayCapabilities)
+	log.Println("Read Gateway Capabilities - Show JSON = ", redactPayload(d, gatewayCapabilities))
 
 	d.SetId("show-global-capabilities-" + acctest.RandString(10))
 
@@ -115,7 +115,7 @@ func dataSourceManagementGlobalAssignmentRead(d *schema.ResourceData, m interfac
 
 	globalAssignment := showGlobalAssignmentRes.GetData()
 
-	log.Println("Read Global Assignment - Show JSON = ", globalAssignment)
+	log.Println("Read Global Assignment - Show JSON = ", redactPayload(d, globalAssignment))
 
 	if v := globalAssignment["uid"]; v != nil {
 		_ = d.Set("uid", v)
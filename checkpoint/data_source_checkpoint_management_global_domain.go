@@ -274,7 +274,7 @@ func dataSourceManagementGlobalDomainRead(d *schema.ResourceData, m interface{})
 
 	globalDomain := showGlobalDomainRes.GetData()
 
-	log.Println("Read Global Domain - Show JSON = ", globalDomain)
+	log.Println("Read Global Domain - Show JSON = ", redactPayload(d, globalDomain))
 
 	d.SetId("show-global-domain-" + acctest.RandString(10))
 
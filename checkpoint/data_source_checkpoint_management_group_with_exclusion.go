@@ -86,7 +86,7 @@ func dataSourceManagementGroupWithExclusionRead(d *schema.ResourceData, m interf
 
 	groupWithExclusion := showGroupWithExclusionRes.GetData()
 
-	log.Println("Read GroupWithExclusion - Show JSON = ", groupWithExclusion)
+	log.Println("Read GroupWithExclusion - Show JSON = ", redactPayload(d, groupWithExclusion))
 
 	if v := groupWithExclusion["uid"]; v != nil {
 		_ = d.Set("uid", v)
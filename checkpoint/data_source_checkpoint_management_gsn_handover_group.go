@@ -86,7 +86,7 @@ func dataSourceManagementGsnHandoverGroupRead(d *schema.ResourceData, m interfac
 
 	gsnHandoverGroup := showGsnHandoverGroupRes.GetData()
 
-	log.Println("Read GsnHandoverGroup - Show JSON = ", gsnHandoverGroup)
+	log.Println("Read GsnHandoverGroup - Show JSON = ", redactPayload(d, gsnHandoverGroup))
 
 	if v := gsnHandoverGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
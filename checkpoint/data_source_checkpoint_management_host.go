@@ -233,7 +233,7 @@ func dataSourceManagementHostRead(d *schema.ResourceData, m interface{}) error {
 
 	host := showHostRes.GetData()
 
-	log.Println("Read Host - Show JSON = ", host)
+	log.Println("Read Host - Show JSON = ", redactPayload(d, host))
 
 	if v := host["uid"]; v != nil {
 		_ = d.Set("uid", v)
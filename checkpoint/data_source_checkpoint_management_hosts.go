@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
-	"strconv"
 )
 
 func dataSourceManagementHosts() *schema.Resource {
@@ -50,9 +49,15 @@ func dataSourceManagementHosts() *schema.Resource {
 			"fetch_all": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "If true, fetches all results.",
+				Description: "If true, fetches all results page by page. The limit is then the size of a page.",
 				Default:     false,
 			},
+			"details_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The level of detail for some of the fields in the response can vary from showing only the UID value of the object to a fully detailed representation of the object.",
+				Default:     "full",
+			},
 			"from": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -311,66 +316,12 @@ func dataSourceManagementHostsRead(d *schema.ResourceData, m interface{}) error
 
 	client := m.(*checkpoint.ApiClient)
 
-	showHostsRes := checkpoint.APIResponse{}
-	var err error
-	fetchAll, _ := d.GetOkExists("fetch_all")
-
-	if fetchAll.(bool) {
-		showHostsRes, err = client.ApiQuery("show-hosts", "full", "objects", true, map[string]interface{}{})
-	} else {
-		payload := make(map[string]interface{})
-
-		if val, ok := d.GetOk("filter"); ok {
-			payload["filter"] = val.(string)
-		}
-
-		if val, ok := d.GetOk("limit"); ok {
-			payload["limit"] = val.(int)
-		}
-
-		if v, ok := d.GetOk("order"); ok {
-
-			orderList := v.([]interface{})
-			if len(orderList) > 0 {
-
-				var orderPayload []map[string]interface{}
-
-				for i := range orderList {
-
-					payload := make(map[string]interface{})
-
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
-						payload["ASC"] = v.(string)
-					}
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
-						payload["DESC"] = v.(string)
-					}
-
-					orderPayload = append(orderPayload, payload)
-				}
-
-				payload["order"] = orderPayload
-			}
-		}
-
-		if val, ok := d.GetOk("offset"); ok {
-			payload["offset"] = val.(int)
-		}
-
-		payload["details-level"] = "full"
-		showHostsRes, err = client.ApiCallSimple("show-hosts", payload)
-	}
-
+	hosts, err := showObjectsList(client, "show-hosts", "objects", d)
 	if err != nil {
-		return fmt.Errorf(err.Error())
-	}
-	if !showHostsRes.Success {
-		return fmt.Errorf(showHostsRes.ErrorMsg)
+		return err
 	}
 
-	hosts := showHostsRes.GetData()
-
-	log.Println("Read Hosts - Show JSON = ", hosts)
+	log.Println("Read Hosts - Show JSON = ", redactPayload(d, hosts))
 
 	d.SetId("show-hosts-" + acctest.RandString(10))
 
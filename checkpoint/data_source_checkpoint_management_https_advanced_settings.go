@@ -142,7 +142,7 @@ func dataSourceManagementSetHttpsAdvancedSettingsRead(d *schema.ResourceData, m
 
 	httpsAdvancedSettings := showHttpsAdvancedSettingsRes.GetData()
 
-	log.Println("Read Https Advanced Settings - Show JSON = ", httpsAdvancedSettings)
+	log.Println("Read Https Advanced Settings - Show JSON = ", redactPayload(d, httpsAdvancedSettings))
 
 	if v := httpsAdvancedSettings["uid"]; v != nil {
 		_ = d.Set("uid", v)
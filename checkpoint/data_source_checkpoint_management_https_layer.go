@@ -74,7 +74,7 @@ func dataSourceManagementHttpsLayerRead(d *schema.ResourceData, m interface{}) e
 
 	httpsLayer := showHttpsLayerRes.GetData()
 
-	log.Println("Read HttpsLayer - Show JSON = ", httpsLayer)
+	log.Println("Read HttpsLayer - Show JSON = ", redactPayload(d, httpsLayer))
 
 	if v := httpsLayer["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -155,7 +155,7 @@ func dataSourceManagementHttpsRuleRead(d *schema.ResourceData, m interface{}) er
 
 	httpsRule := showHttpsRuleRes.GetData()
 
-	log.Println("Read HttpsRule - Show JSON = ", httpsRule)
+	log.Println("Read HttpsRule - Show JSON = ", redactPayload(d, httpsRule))
 
 	if v := httpsRule["uid"]; v != nil {
 		_ = d.Set("uid", v)
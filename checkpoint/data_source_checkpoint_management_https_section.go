@@ -57,7 +57,7 @@ func dataSourceManagementHttpsSectionRead(d *schema.ResourceData, m interface{})
 
 	httpsSection := showHttpsSectionRes.GetData()
 
-	log.Println("Read HttpsSection - Show JSON = ", httpsSection)
+	log.Println("Read HttpsSection - Show JSON = ", redactPayload(d, httpsSection))
 
 	if v := httpsSection["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -121,7 +121,7 @@ func dataSourceManagementIdentityProviderRead(d *schema.ResourceData, m interfac
 
 	identityProvider := showIdentityProviderRes.GetData()
 
-	log.Println("Read IdentityProvider - Show JSON = ", identityProvider)
+	log.Println("Read IdentityProvider - Show JSON = ", redactPayload(d, identityProvider))
 
 	if v := identityProvider["uid"]; v != nil {
 		_ = d.Set("uid", v)
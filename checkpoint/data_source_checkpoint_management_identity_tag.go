@@ -72,7 +72,7 @@ func dataSourceManagementIdentityTagRead(d *schema.ResourceData, m interface{})
 
 	identityTag := showIdentityTagRes.GetData()
 
-	log.Println("Read IdentityTag - Show JSON = ", identityTag)
+	log.Println("Read IdentityTag - Show JSON = ", redactPayload(d, identityTag))
 
 	if v := identityTag["uid"]; v != nil {
 		_ = d.Set("uid", v)
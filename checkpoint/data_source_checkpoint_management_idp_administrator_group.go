@@ -101,7 +101,7 @@ func dataSourceManagementIdpAdministratorGroupRead(d *schema.ResourceData, m int
 
 	idpAdministratorGroup := showIdpAdministratorGroupRes.GetData()
 
-	log.Println("Read IdpAdministratorGroup - Show JSON = ", idpAdministratorGroup)
+	log.Println("Read IdpAdministratorGroup - Show JSON = ", redactPayload(d, idpAdministratorGroup))
 
 	if v := idpAdministratorGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
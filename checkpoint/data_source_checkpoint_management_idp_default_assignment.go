@@ -58,7 +58,7 @@ func dataSourceManagementIdpDefaultAssignmentRead(d *schema.ResourceData, m inte
 
 	idpDefaultAssignment := showIdpDefaultAssignmentRes.GetData()
 
-	log.Println("Read IdpDefaultAssignment - Show JSON = ", idpDefaultAssignment)
+	log.Println("Read IdpDefaultAssignment - Show JSON = ", redactPayload(d, idpDefaultAssignment))
 
 	if v := idpDefaultAssignment["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -77,7 +77,7 @@ func dataSourceManagementIdpToDomainAssignmentRead(d *schema.ResourceData, m int
 
 	idpToDomainAssignment := showIdpToDomainAssignmentRes.GetData()
 
-	log.Println("Read IdpToDomainAssignment - Show JSON = ", idpToDomainAssignment)
+	log.Println("Read IdpToDomainAssignment - Show JSON = ", redactPayload(d, idpToDomainAssignment))
 
 	if v := idpToDomainAssignment["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -137,7 +137,7 @@ func dataSourceManagementIfMapServerRead(d *schema.ResourceData, m interface{})
 
 	ifMapServer := showIfMapServerRes.GetData()
 
-	log.Println("Read IfMapServer - Show JSON = ", ifMapServer)
+	log.Println("Read IfMapServer - Show JSON = ", redactPayload(d, ifMapServer))
 
 	if v := ifMapServer["uid"]; v != nil {
 		_ = d.Set("uid", v)
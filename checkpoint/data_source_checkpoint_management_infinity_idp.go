@@ -81,7 +81,7 @@ func dataSourceManagementDeleteInfinityIdpRead(d *schema.ResourceData, m interfa
 
 	infinityIdp := showInfinityIdpRes.GetData()
 
-	log.Println("Read Infinity-Idp - Show JSON = ", infinityIdp)
+	log.Println("Read Infinity-Idp - Show JSON = ", redactPayload(d, infinityIdp))
 
 	if v := infinityIdp["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -94,7 +94,7 @@ func dataSourceManagementDeleteInfinityIdpObjectRead(d *schema.ResourceData, m i
 
 	infinityIdp := showInfinityIdpRes.GetData()
 
-	log.Println("Read Infinity-Idp-Object - Show JSON = ", infinityIdp)
+	log.Println("Read Infinity-Idp-Object - Show JSON = ", redactPayload(d, infinityIdp))
 
 	if v := infinityIdp["uid"]; v != nil {
 		_ = d.Set("uid", v)
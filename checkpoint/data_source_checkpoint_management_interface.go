@@ -375,7 +375,7 @@ func dataSourceManagementInterfaceRead(d *schema.ResourceData, m interface{}) er
 
 	interfaceMap := showInterfaceRes.GetData()
 
-	log.Println("Read Interface - Show JSON = ", interfaceMap)
+	log.Println("Read Interface - Show JSON = ", redactPayload(d, interfaceMap))
 
 	if v := interfaceMap["uid"]; v != nil {
 		_ = d.Set("uid", v)
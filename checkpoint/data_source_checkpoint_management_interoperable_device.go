@@ -255,7 +255,7 @@ func dataSourceManagementInteroperableDeviceRead(d *schema.ResourceData, m inter
 
 	interoperableDevice := showInteroperableDeviceRes.GetData()
 
-	log.Println("Read InteroperableDevice - Show JSON = ", interoperableDevice)
+	log.Println("Read InteroperableDevice - Show JSON = ", redactPayload(d, interoperableDevice))
 
 	if v := interoperableDevice["uid"]; v != nil {
 		_ = d.Set("uid", v)
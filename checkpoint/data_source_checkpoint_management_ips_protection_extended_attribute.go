@@ -68,7 +68,7 @@ func dataSourceManagementIpsProtectionExtendedAttributeRead(d *schema.ResourceDa
 
 	ipsProtectionExtendedAttribute := showIpsProtectionExtendedAttributeRes.GetData()
 
-	log.Println("Read Ips Protection Extended Attribute - Show JSON = ", ipsProtectionExtendedAttribute)
+	log.Println("Read Ips Protection Extended Attribute - Show JSON = ", redactPayload(d, ipsProtectionExtendedAttribute))
 
 	if ipsProtectionExtendedAttribute["object"] != nil {
 		objectMap := ipsProtectionExtendedAttribute["object"].(map[string]interface{})
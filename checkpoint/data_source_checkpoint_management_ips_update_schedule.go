@@ -77,7 +77,7 @@ func dataSourceManagementIpsUpdateScheduleRead(d *schema.ResourceData, m interfa
 
 	ipsUpdateSchedule := showIpsUpdateScheduleRes.GetData()
 
-	log.Println("Read Ips Update Schedule - Show JSON = ", ipsUpdateSchedule)
+	log.Println("Read Ips Update Schedule - Show JSON = ", redactPayload(d, ipsUpdateSchedule))
 
 	d.SetId("show-ips-update-schedule-" + acctest.RandString(10))
 
@@ -102,7 +102,7 @@ func dataSourceManagementLdapGroupRead(d *schema.ResourceData, m interface{}) er
 
 	ldapGroup := showLdapGroupRes.GetData()
 
-	log.Println("Read LdapGroup - Show JSON = ", ldapGroup)
+	log.Println("Read LdapGroup - Show JSON = ", redactPayload(d, ldapGroup))
 
 	if v := ldapGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -103,7 +103,7 @@ func dataSourceManagementLimitRead(d *schema.ResourceData, m interface{}) error
 
 	limit := showLimitRes.GetData()
 
-	log.Println("Read Limit - Show JSON = ", limit)
+	log.Println("Read Limit - Show JSON = ", redactPayload(d, limit))
 
 	if v := limit["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -133,7 +133,7 @@ func dataSourceManagementLogExporterRead(d *schema.ResourceData, m interface{})
 
 	logExporter := showLogExporterRes.GetData()
 
-	log.Println("Read LogExporter - Show JSON = ", logExporter)
+	log.Println("Read LogExporter - Show JSON = ", redactPayload(d, logExporter))
 
 	if v := logExporter["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -51,7 +51,7 @@ func dataSourceManagementLoginMessageRead(d *schema.ResourceData, m interface{})
 
 	loginMessage := showLoginMessageRes.GetData()
 
-	log.Println("Read Login Message - Show JSON = ", loginMessage)
+	log.Println("Read Login Message - Show JSON = ", redactPayload(d, loginMessage))
 
 	d.SetId("login-message-" + acctest.RandString(10))
 
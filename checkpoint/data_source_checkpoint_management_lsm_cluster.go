@@ -312,7 +312,7 @@ func dataSourceManagementLsmClusterRead(d *schema.ResourceData, m interface{}) e
 
 	LsmCluster := showLsmClusterRes.GetData()
 
-	log.Println("Read Lsm Cluster - Show JSON = ", LsmCluster)
+	log.Println("Read Lsm Cluster - Show JSON = ", redactPayload(d, LsmCluster))
 
 	if v := LsmCluster["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -689,7 +689,7 @@ func dataSourceManagementLsmClusterProfileRead(d *schema.ResourceData, m interfa
 
 	lsmClusterProfile := showLsmClusterProfileRes.GetData()
 
-	log.Println("Read LsmClusterProfile - Show JSON = ", lsmClusterProfile)
+	log.Println("Read LsmClusterProfile - Show JSON = ", redactPayload(d, lsmClusterProfile))
 
 	if v := lsmClusterProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -253,7 +253,7 @@ func dataSourceManagementLsmGatewayRead(d *schema.ResourceData, m interface{}) e
 
 	lsmGateway := showLsmGatewayRes.GetData()
 
-	log.Println("Read LsmGateway - Show JSON = ", lsmGateway)
+	log.Println("Read LsmGateway - Show JSON = ", redactPayload(d, lsmGateway))
 
 	if v := lsmGateway["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -573,7 +573,7 @@ func dataSourceManagementLsmGatewayProfileRead(d *schema.ResourceData, m interfa
 
 	lsmGatewayProfile := showLsmGatewayProfileRes.GetData()
 
-	log.Println("Read LsmGatewayProfile - Show JSON = ", lsmGatewayProfile)
+	log.Println("Read LsmGatewayProfile - Show JSON = ", redactPayload(d, lsmGatewayProfile))
 
 	if v := lsmGatewayProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -104,7 +104,7 @@ func dataSourceManagementLsvProfileRead(d *schema.ResourceData, m interface{}) e
 
 	lsvProfile := showLsvProfileRes.GetData()
 
-	log.Println("Read Lsv Profile - Show JSON = ", lsvProfile)
+	log.Println("Read Lsv Profile - Show JSON = ", redactPayload(d, lsvProfile))
 
 	if v := lsvProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -145,7 +145,7 @@ func dataSourceManagementMdPermissionsProfileRead(d *schema.ResourceData, m inte
 
 	mdPermissionsProfile := showMdPermissionsProfileRes.GetData()
 
-	log.Println("Read MdPermissionsProfile - Show JSON = ", mdPermissionsProfile)
+	log.Println("Read MdPermissionsProfile - Show JSON = ", redactPayload(d, mdPermissionsProfile))
 
 	if v := mdPermissionsProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
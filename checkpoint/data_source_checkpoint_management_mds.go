@@ -133,7 +133,7 @@ func dataSourceManagementMdsRead(d *schema.ResourceData, m interface{}) error {
 
 	mds := showMdsRes.GetData()
 
-	log.Println("Read Mds - Show JSON = ", mds)
+	log.Println("Read Mds - Show JSON = ", redactPayload(d, mds))
 
 	if v := mds["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -84,7 +84,7 @@ func dataSourceManagementMobileAccessProfileRuleRead(d *schema.ResourceData, m i
 
 	mobileAccessProfileRule := showMobileAccessProfileRuleRes.GetData()
 
-	log.Println("Read MobileAccessProfileRule - Show JSON = ", mobileAccessProfileRule)
+	log.Println("Read MobileAccessProfileRule - Show JSON = ", redactPayload(d, mobileAccessProfileRule))
 
 	if v := mobileAccessProfileRule["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -63,7 +63,7 @@ func dataSourceManagementMobileAccessProfileSectionRead(d *schema.ResourceData,
 
 	mobileAccessProfileSection := showMobileAccessProfileSectionRes.GetData()
 
-	log.Println("Read MobileAccessProfileSection - Show JSON = ", mobileAccessProfileSection)
+	log.Println("Read MobileAccessProfileSection - Show JSON = ", redactPayload(d, mobileAccessProfileSection))
 
 	if v := mobileAccessProfileSection["uid"]; v != nil {
 		_ = d.Set("uid", v)
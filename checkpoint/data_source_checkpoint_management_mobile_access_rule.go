@@ -97,7 +97,7 @@ func dataManagementMobileAccessRuleRead(d *schema.ResourceData, m interface{}) e
 
 	mobileAccessRule := showMobileAccessRuleRes.GetData()
 
-	log.Println("Read MobileAccessRule - Show JSON = ", mobileAccessRule)
+	log.Println("Read MobileAccessRule - Show JSON = ", redactPayload(d, mobileAccessRule))
 
 	if v := mobileAccessRule["uid"]; v != nil {
 		_ = d.Set("uid", v)
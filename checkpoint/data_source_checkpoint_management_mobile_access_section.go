@@ -64,7 +64,7 @@ func dataSourceManagementMobileAccessSectionRead(d *schema.ResourceData, m inter
 
 	mobileAccessSection := showMobileAccessSectionRes.GetData()
 
-	log.Println("Read MobileAccessSection - Show JSON = ", mobileAccessSection)
+	log.Println("Read MobileAccessSection - Show JSON = ", redactPayload(d, mobileAccessSection))
 
 	if v := mobileAccessSection["uid"]; v != nil {
 		_ = d.Set("uid", v)
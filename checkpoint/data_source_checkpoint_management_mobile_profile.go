@@ -448,7 +448,7 @@ func dataSourceManagementMobileProfileRead(d *schema.ResourceData, m interface{}
 
 	mobileProfile := showMobileProfileRes.GetData()
 
-	log.Println("Read MobileProfile - Show JSON = ", mobileProfile)
+	log.Println("Read MobileProfile - Show JSON = ", redactPayload(d, mobileProfile))
 
 	if v := mobileProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -106,7 +106,7 @@ func dataSourceManagementMulticastAddressRangeRead(d *schema.ResourceData, m int
 
 	multicastAddressRange := showMulticastAddressRangeRes.GetData()
 
-	log.Println("Read MulticastAddressRange - Show JSON = ", multicastAddressRange)
+	log.Println("Read MulticastAddressRange - Show JSON = ", redactPayload(d, multicastAddressRange))
 
 	if v := multicastAddressRange["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -136,7 +136,7 @@ func dataSourceManagementMultipleKeyExchangesRead(d *schema.ResourceData, m inte
 
 	multipleKeyExchanges := showMultipleKeyExchangesRes.GetData()
 
-	log.Println("Read MultipleKeyExchanges - Show JSON = ", multipleKeyExchanges)
+	log.Println("Read MultipleKeyExchanges - Show JSON = ", redactPayload(d, multipleKeyExchanges))
 
 	if v := multipleKeyExchanges["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -115,7 +115,7 @@ func dataSourceManagementNatRuleRead(d *schema.ResourceData, m interface{}) erro
 
 	natRule := showNatRuleRes.GetData()
 
-	log.Println("Read NAT Rule - Show JSON = ", natRule)
+	log.Println("Read NAT Rule - Show JSON = ", redactPayload(d, natRule))
 
 	if v := natRule["uid"]; v != nil {
 		_ = d.Set("uid", v)
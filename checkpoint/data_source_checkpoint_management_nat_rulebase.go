@@ -444,7 +444,7 @@ func dataSourceManagementNatRuleBaseRead(d *schema.ResourceData, m interface{})
 	}
 	ruleBaseJson := showRuleBaseRes.GetData()
 
-	log.Println("Read ruleBaseJson - Show JSON = ", ruleBaseJson)
+	log.Println("Read ruleBaseJson - Show JSON = ", redactPayload(d, ruleBaseJson))
 	var outputRuleBase []interface{}
 	ruleBaseToReturn := make(map[string]interface{})
 	if v := ruleBaseJson["uid"]; v != nil {
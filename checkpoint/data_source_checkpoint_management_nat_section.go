@@ -57,7 +57,7 @@ func dataSourceManagementNatSectionRead(d *schema.ResourceData, m interface{}) e
 
 	natSection := showNatSectionRes.GetData()
 
-	log.Println("Read NatSection - Show JSON = ", natSection)
+	log.Println("Read NatSection - Show JSON = ", redactPayload(d, natSection))
 
 	if v := natSection["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -155,7 +155,7 @@ func dataSourceManagementNetworkFeedRead(d *schema.ResourceData, m interface{})
 
 	networkFeed := showNetworkFeedRes.GetData()
 
-	log.Println("Read NetworkFeed - Show JSON = ", networkFeed)
+	log.Println("Read NetworkFeed - Show JSON = ", redactPayload(d, networkFeed))
 
 	if v := networkFeed["uid"]; v != nil {
 		_ = d.Set("uid", v)
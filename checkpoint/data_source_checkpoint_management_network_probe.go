@@ -129,7 +129,7 @@ func dataSourceManagementNetworkProbeRead(d *schema.ResourceData, m interface{})
 
 	networkProbe := showNetworkProbeRes.GetData()
 
-	log.Println("Read NetworkProbe - Show JSON = ", networkProbe)
+	log.Println("Read NetworkProbe - Show JSON = ", redactPayload(d, networkProbe))
 
 	if v := networkProbe["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -1,7 +1,6 @@
 package checkpoint
 
 import (
-	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -50,9 +49,15 @@ func dataSourceManagementNetworks() *schema.Resource {
 			"fetch_all": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "If true, fetches all results.",
+				Description: "If true, fetches all results page by page. The limit is then the size of a page.",
 				Default:     false,
 			},
+			"details_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The level of detail for some of the fields in the response can vary from showing only the UID value of the object to a fully detailed representation of the object.",
+				Default:     "full",
+			},
 			"from": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -219,66 +224,12 @@ func dataSourceManagementNetworksRead(d *schema.ResourceData, m interface{}) err
 
 	client := m.(*checkpoint.ApiClient)
 
-	showNetworksRes := checkpoint.APIResponse{}
-	var err error
-	fetchAll, _ := d.GetOkExists("fetch_all")
-
-	if fetchAll.(bool) {
-		showNetworksRes, err = client.ApiQuery("show-networks", "full", "objects", true, map[string]interface{}{})
-	} else {
-		payload := make(map[string]interface{})
-
-		if val, ok := d.GetOk("filter"); ok {
-			payload["filter"] = val.(string)
-		}
-
-		if val, ok := d.GetOk("limit"); ok {
-			payload["limit"] = val.(int)
-		}
-
-		if v, ok := d.GetOk("order"); ok {
-
-			orderList := v.([]interface{})
-			if len(orderList) > 0 {
-
-				var orderPayload []map[string]interface{}
-
-				for i := range orderList {
-
-					payload := make(map[string]interface{})
-
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
-						payload["ASC"] = v.(string)
-					}
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
-						payload["DESC"] = v.(string)
-					}
-
-					orderPayload = append(orderPayload, payload)
-				}
-
-				payload["order"] = orderPayload
-			}
-		}
-
-		if val, ok := d.GetOk("offset"); ok {
-			payload["offset"] = val.(int)
-		}
-
-		payload["details-level"] = "full"
-		showNetworksRes, err = client.ApiCallSimple("show-networks", payload)
-	}
-
+	networks, err := showObjectsList(client, "show-networks", "objects", d)
 	if err != nil {
-		return fmt.Errorf(err.Error())
-	}
-	if !showNetworksRes.Success {
-		return fmt.Errorf(showNetworksRes.ErrorMsg)
+		return err
 	}
 
-	networks := showNetworksRes.GetData()
-
-	log.Println("Read Networks - Show JSON = ", networks)
+	log.Println("Read Networks - Show JSON = ", redactPayload(d, networks))
 
 	d.SetId("show-networks-" + acctest.RandString(10))
 
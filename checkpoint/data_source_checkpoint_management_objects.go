@@ -211,7 +211,7 @@ func dataSourceManagementObjectsRead(d *schema.ResourceData, m interface{}) erro
 
 	objects := showObjectsRes.GetData()
 
-	log.Println("Read Objects - Show JSON = ", objects)
+	log.Println("Read Objects - Show JSON = ", redactPayload(d, objects))
 
 	d.SetId("show-objects-" + acctest.RandString(10))
 
@@ -0,0 +1,210 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+// dataSourceManagementObjectsList returns a data source that lists the objects of a show command, e.g. show-groups,
+// that replies with the objects under listKey. Every object holds its common fields, and all of its fields as JSON.
+func dataSourceManagementObjectsList(command string, listKey string) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dataSourceManagementObjectsListRead(command, listKey, make(map[string]interface{}), d, m)
+		},
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Search expression to filter objects by.",
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximal number of returned results.",
+			},
+			"offset": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of the results to initially skip.",
+			},
+			"order": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Sorts the results by search criteria. Automatically sorts the results by Name, in the ascending order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Sorts results by the given field in ascending order.",
+						},
+						"desc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Sorts results by the given field in descending order.",
+						},
+					},
+				},
+			},
+			"fetch_all": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, fetches all results page by page. The limit is then the size of a page.",
+				Default:     false,
+			},
+			"details_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The level of detail for some of the fields in the response can vary from showing only the UID value of the object to a fully detailed representation of the object.",
+				Default:     "full",
+			},
+			"from": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "From which element number the query was done.",
+			},
+			"to": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "To which element number the query was done.",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of elements returned by the query.",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Objects list.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Object name.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Object unique identifier.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Object type.",
+						},
+						"color": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Color of the object.",
+						},
+						"comments": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Comments string.",
+						},
+						"tags": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "Collection of tag identifiers.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "All the fields of the object in JSON format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagementObjectsListRead(command string, listKey string, payload map[string]interface{}, d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	objectsData, err := showObjectsListWithPayload(client, command, listKey, payload, d)
+	if err != nil {
+		return err
+	}
+
+	log.Println(command+" JSON = ", redactPayload(d, objectsData))
+
+	d.SetId(command + "-" + acctest.RandString(10))
+
+	if v := objectsData["from"]; v != nil {
+		_ = d.Set("from", v)
+	}
+
+	if v := objectsData["to"]; v != nil {
+		_ = d.Set("to", v)
+	}
+
+	if v := objectsData["total"]; v != nil {
+		_ = d.Set("total", v)
+	}
+
+	var objectsListState []map[string]interface{}
+	if v, ok := objectsData["objects"].([]interface{}); ok {
+		for _, obj := range v {
+			objectMap, ok := obj.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			objectMapToAdd := make(map[string]interface{})
+
+			if v := objectMap["name"]; v != nil {
+				objectMapToAdd["name"] = v
+			}
+
+			if v := objectMap["uid"]; v != nil {
+				objectMapToAdd["uid"] = v
+			}
+
+			if v := objectMap["type"]; v != nil {
+				objectMapToAdd["type"] = v
+			}
+
+			if v := objectMap["color"]; v != nil {
+				objectMapToAdd["color"] = v
+			}
+
+			if v := objectMap["comments"]; v != nil {
+				objectMapToAdd["comments"] = v
+			}
+
+			if v, ok := objectMap["tags"].([]interface{}); ok {
+				var tags []interface{}
+				for _, tag := range v {
+					if tagMap, ok := tag.(map[string]interface{}); ok {
+						tags = append(tags, tagMap["name"])
+					} else {
+						tags = append(tags, tag)
+					}
+				}
+				objectMapToAdd["tags"] = tags
+			}
+
+			objectJson, err := json.Marshal(objectMap)
+			if err != nil {
+				return fmt.Errorf("failed to encode object %v: %s", objectMap["uid"], err.Error())
+			}
+			objectMapToAdd["json"] = string(objectJson)
+
+			objectsListState = append(objectsListState, objectMapToAdd)
+		}
+	}
+	_ = d.Set("objects", objectsListState)
+
+	return nil
+}
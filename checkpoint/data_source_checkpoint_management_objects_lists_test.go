@@ -0,0 +1,115 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementGroups_basic(t *testing.T) {
+
+	objName := "tfTestManagementDataGroups_" + acctest.RandString(6)
+	dataSourceName := "data.checkpoint_management_groups.data_test"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementGroupsConfig(objName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.name", objName+"_1"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.1.name", objName+"_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceManagementGroupsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_group" "group1" {
+    name = "%[1]s_1"
+}
+
+resource "checkpoint_management_group" "group2" {
+    name = "%[1]s_2"
+}
+
+data "checkpoint_management_groups" "data_test" {
+    filter = "%[1]s"
+    fetch_all = true
+    limit = 1
+    depends_on = [checkpoint_management_group.group1, checkpoint_management_group.group2]
+}
+`, name)
+}
+
+func TestUnitDataSourceCheckpointManagementGroups_fetchAll(t *testing.T) {
+
+	mock := newMockApiServer(t)
+	sid := mock.login()["sid"].(string)
+	for i := 1; i <= 5; i++ {
+		mock.run("add-group", map[string]interface{}{"name": "tfGroup" + strconv.Itoa(i), "tags": []interface{}{"tfTag"}}, sid)
+	}
+	mock.run("add-group", map[string]interface{}{"name": "otherGroup"}, sid)
+	mock.run("add-host", map[string]interface{}{"name": "tfHost", "ipv4-address": "192.0.2.1"}, sid)
+	mock.run("add-host", map[string]interface{}{"name": "otherHost", "ipv4-address": "192.0.2.2"}, sid)
+	mock.run("add-package", map[string]interface{}{"name": "tfPackage"}, sid)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + `
+data "checkpoint_management_groups" "test" {
+    filter = "tf"
+    fetch_all = true
+    limit = 2
+}
+
+data "checkpoint_management_groups" "page" {
+    filter = "tf"
+    limit = 2
+    offset = 2
+}
+
+data "checkpoint_management_hosts" "test" {
+    filter = "tf"
+    fetch_all = true
+}
+
+data "checkpoint_management_packages" "test" {
+    filter = "tf"
+    fetch_all = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.checkpoint_management_groups.test", "total", "5"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_groups.test", "objects.#", "5"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_groups.test", "objects.0.name", "tfGroup1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_groups.test", "objects.4.name", "tfGroup5"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_groups.test", "objects.4.tags.#", "1"),
+					resource.TestCheckResourceAttrSet("data.checkpoint_management_groups.test", "objects.4.json"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_groups.page", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_groups.page", "objects.0.name", "tfGroup3"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_hosts.test", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_hosts.test", "objects.0.name", "tfHost"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_packages.test", "total", "1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_packages.test", "objects.0.name", "tfPackage"),
+				),
+			},
+		},
+	})
+}
@@ -118,7 +118,7 @@ func dataSourceManagementOpsecApplicationRead(d *schema.ResourceData, m interfac
 
 	opsecApplication := showOpsecApplicationRes.GetData()
 
-	log.Println("Read OpsecApplication - Show JSON = ", opsecApplication)
+	log.Println("Read OpsecApplication - Show JSON = ", redactPayload(d, opsecApplication))
 
 	if v := opsecApplication["uid"]; v != nil {
 		_ = d.Set("uid", v)
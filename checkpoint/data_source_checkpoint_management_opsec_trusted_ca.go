@@ -236,7 +236,7 @@ func dataSourceManagementOpsecTrustedCaRead(d *schema.ResourceData, m interface{
 
 	opsecTrustedCa := showOpsecTrustedCaRes.GetData()
 
-	log.Println("Read OpsecTrustedCa - Show JSON = ", opsecTrustedCa)
+	log.Println("Read OpsecTrustedCa - Show JSON = ", redactPayload(d, opsecTrustedCa))
 
 	if v := opsecTrustedCa["uid"]; v != nil {
 		_ = d.Set("uid", v)
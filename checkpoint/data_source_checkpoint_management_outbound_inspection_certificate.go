@@ -102,7 +102,7 @@ func dataSourceManagementOutboundInspectionCertificateRead(d *schema.ResourceDat
 
 	outboundInspectionCertificate := showOutboundInspectionCertificateRes.GetData()
 
-	log.Println("Read OutboundInspectionCertificate - Show JSON = ", outboundInspectionCertificate)
+	log.Println("Read OutboundInspectionCertificate - Show JSON = ", redactPayload(d, outboundInspectionCertificate))
 
 	if v := outboundInspectionCertificate["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -93,7 +93,7 @@ func dataSourceManagementOverrideCategorizationRead(d *schema.ResourceData, m in
 
 	overrideCategorization := showOverrideCategorizationRes.GetData()
 
-	log.Println("Read OverrideCategorization - Show JSON = ", overrideCategorization)
+	log.Println("Read OverrideCategorization - Show JSON = ", redactPayload(d, overrideCategorization))
 
 	if v := overrideCategorization["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -106,7 +106,7 @@ func dataSourceManagementPackageRead(d *schema.ResourceData, m interface{}) erro
 
 	_package := showPackageRes.GetData()
 
-	log.Println("Read Package - Show JSON = ", _package)
+	log.Println("Read Package - Show JSON = ", redactPayload(d, _package))
 
 	if v := _package["uid"]; v != nil {
 		_ = d.Set("uid", v)
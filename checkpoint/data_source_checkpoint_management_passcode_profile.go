@@ -132,7 +132,7 @@ func dataSourceManagementPasscodeProfileRead(d *schema.ResourceData, m interface
 
 	passcodeProfile := showPasscodeProfileRes.GetData()
 
-	log.Println("Read PasscodeProfile - Show JSON = ", passcodeProfile)
+	log.Println("Read PasscodeProfile - Show JSON = ", redactPayload(d, passcodeProfile))
 
 	if v := passcodeProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
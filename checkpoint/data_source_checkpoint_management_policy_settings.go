@@ -65,7 +65,7 @@ func dataSourceManagementPolicySettingsRead(d *schema.ResourceData, m interface{
 
 	policySettings := showPolicySettingsRes.GetData()
 
-	log.Println("Read Policy Settings - Show JSON = ", policySettings)
+	log.Println("Read Policy Settings - Show JSON = ", redactPayload(d, policySettings))
 
 	d.SetId("show-policy-settings-" + acctest.RandString(10))
 
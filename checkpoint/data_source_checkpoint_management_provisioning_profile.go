@@ -343,7 +343,7 @@ func dataSourceManagementProvisioningProfileRead(d *schema.ResourceData, m inter
 
 	provisioningProfile := showProvisioningProfileRes.GetData()
 
-	log.Println("Read ProvisioningProfile - Show JSON = ", provisioningProfile)
+	log.Println("Read ProvisioningProfile - Show JSON = ", redactPayload(d, provisioningProfile))
 
 	if v := provisioningProfile["uid"]; v != nil {
 		_ = d.Set("uid", v)
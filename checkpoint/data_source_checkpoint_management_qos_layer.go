@@ -0,0 +1,107 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func dataSourceManagementQosLayer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementQosLayerRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object name.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object unique identifier.",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Collection of tag identifiers.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Color of the object. Should be one of existing colors.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comments string.",
+			},
+		},
+	}
+}
+
+func dataSourceManagementQosLayerRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	name := d.Get("name").(string)
+	uid := d.Get("uid").(string)
+
+	payload := make(map[string]interface{})
+
+	if name != "" {
+		payload["name"] = name
+	} else if uid != "" {
+		payload["uid"] = uid
+	}
+
+	showQosLayerRes, err := client.ApiCall("show-qos-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showQosLayerRes.Success {
+		return fmt.Errorf(showQosLayerRes.ErrorMsg)
+	}
+
+	qosLayer := showQosLayerRes.GetData()
+
+	log.Println("Read QosLayer - Show JSON = ", redactPayload(d, qosLayer))
+
+	if v := qosLayer["uid"]; v != nil {
+		_ = d.Set("uid", v)
+		d.SetId(v.(string))
+	}
+
+	if v := qosLayer["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
+
+	if qosLayer["tags"] != nil {
+		tagsJson, ok := qosLayer["tags"].([]interface{})
+		if ok {
+			tagsIds := make([]string, 0)
+			if len(tagsJson) > 0 {
+				for _, tags := range tagsJson {
+					tags := tags.(map[string]interface{})
+					tagsIds = append(tagsIds, tags["name"].(string))
+				}
+			}
+			_ = d.Set("tags", tagsIds)
+		}
+	} else {
+		_ = d.Set("tags", nil)
+	}
+
+	if v := qosLayer["color"]; v != nil {
+		_ = d.Set("color", v)
+	}
+
+	if v := qosLayer["comments"]; v != nil {
+		_ = d.Set("comments", v)
+	}
+
+	return nil
+}
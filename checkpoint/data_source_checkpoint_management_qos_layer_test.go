@@ -0,0 +1,47 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementQosLayer_basic(t *testing.T) {
+
+	objName := "tfTestManagementDataQosLayer_" + acctest.RandString(6)
+	resourceName := "checkpoint_management_qos_layer.qos_layer"
+	dataSourceName := "data.checkpoint_management_qos_layer.data_qos_layer"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementQosLayerConfig(objName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccDataSourceManagementQosLayerConfig(name string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_qos_layer" "qos_layer" {
+        name = "%s"
+}
+
+data "checkpoint_management_qos_layer" "data_qos_layer" {
+    name = "${checkpoint_management_qos_layer.qos_layer.name}"
+}
+`, name)
+}
@@ -0,0 +1,244 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func dataSourceManagementQosRule() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementQosRuleRead,
+		Schema: map[string]*schema.Schema{
+			"layer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Layer that the rule belongs to identified by the name or UID.",
+			},
+			"rule_number": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Rule number.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object unique identifier.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object name.",
+			},
+			"action": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Bandwidth settings of the traffic that matches the rule.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"weight": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Relative share of the available bandwidth.",
+						},
+						"limit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximal bandwidth of the rule in Kbps.",
+						},
+						"guarantee": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bandwidth that is reserved for the rule in Kbps.",
+						},
+						"per_connection_limit": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximal bandwidth of every connection that matches the rule in Kbps.",
+						},
+						"per_connection_guarantee": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Bandwidth that is reserved for every connection that matches the rule in Kbps.",
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Collection of Network objects identified by the name or UID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"destination_negate": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if negate is set for destination.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Enable/Disable the rule.",
+			},
+			"install_on": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Which Gateways identified by the name or UID to install the policy on.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Collection of Network objects identified by the name or UID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_negate": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if negate is set for service.",
+			},
+			"source": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Collection of Network objects identified by the name or UID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_negate": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if negate is set for source.",
+			},
+			"time": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "List of time objects.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"track": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Track type.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comments string.",
+			},
+		},
+	}
+}
+
+func dataSourceManagementQosRuleRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	name := d.Get("name").(string)
+	uid := d.Get("uid").(string)
+	ruleNumber := d.Get("rule_number").(int)
+
+	payload := map[string]interface{}{
+		"layer": d.Get("layer"),
+	}
+
+	if name != "" {
+		payload["name"] = name
+	} else if uid != "" {
+		payload["uid"] = uid
+	} else if ruleNumber > 0 {
+		payload["rule-number"] = ruleNumber
+	}
+
+	showQosRuleRes, err := client.ApiCall("show-qos-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showQosRuleRes.Success {
+		return fmt.Errorf(showQosRuleRes.ErrorMsg)
+	}
+
+	qosRule := showQosRuleRes.GetData()
+
+	log.Println("Read QosRule - Show JSON = ", redactPayload(d, qosRule))
+
+	if v := qosRule["uid"]; v != nil {
+		_ = d.Set("uid", v)
+		d.SetId(v.(string))
+	}
+
+	if v := qosRule["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
+
+	if actionMap, ok := qosRule["action"].(map[string]interface{}); ok {
+		actionMapToReturn := make(map[string]interface{})
+		for field, apiField := range qosRuleActionFields {
+			if v, ok := actionMap[apiField].(float64); ok {
+				actionMapToReturn[field] = int(v)
+			}
+		}
+		_ = d.Set("action", []interface{}{actionMapToReturn})
+	} else {
+		_ = d.Set("action", nil)
+	}
+
+	if qosRule["destination"] != nil {
+		_ = d.Set("destination", resolveListOfIdentifiers("destination", qosRule["destination"], d))
+	}
+
+	if v := qosRule["destination-negate"]; v != nil {
+		_ = d.Set("destination_negate", v)
+	}
+
+	if v := qosRule["enabled"]; v != nil {
+		_ = d.Set("enabled", v)
+	}
+
+	if qosRule["install-on"] != nil {
+		_ = d.Set("install_on", resolveListOfIdentifiers("install-on", qosRule["install-on"], d))
+	}
+
+	if qosRule["service"] != nil {
+		_ = d.Set("service", resolveListOfIdentifiers("service", qosRule["service"], d))
+	}
+
+	if v := qosRule["service-negate"]; v != nil {
+		_ = d.Set("service_negate", v)
+	}
+
+	if qosRule["source"] != nil {
+		_ = d.Set("source", resolveListOfIdentifiers("source", qosRule["source"], d))
+	}
+
+	if v := qosRule["source-negate"]; v != nil {
+		_ = d.Set("source_negate", v)
+	}
+
+	if qosRule["time"] != nil {
+		_ = d.Set("time", resolveListOfIdentifiers("time", qosRule["time"], d))
+	}
+
+	if v := qosRule["track"]; v != nil {
+		if trackMap, ok := v.(map[string]interface{}); ok {
+			_ = d.Set("track", trackMap["name"])
+		} else {
+			_ = d.Set("track", v)
+		}
+	}
+
+	if v := qosRule["comments"]; v != nil {
+		_ = d.Set("comments", v)
+	}
+
+	return nil
+}
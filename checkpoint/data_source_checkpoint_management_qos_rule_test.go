@@ -0,0 +1,58 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementQosRule_basic(t *testing.T) {
+
+	objName := "tfTestManagementDataQosRule_" + acctest.RandString(6)
+	resourceName := "checkpoint_management_qos_rule.qos_rule"
+	dataSourceName := "data.checkpoint_management_qos_rule.data_qos_rule"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementQosRuleConfig(objName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "action.0.weight", resourceName, "action.0.weight"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccDataSourceManagementQosRuleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_qos_layer" "qos_layer" {
+        name = "%[1]s_layer"
+}
+
+resource "checkpoint_management_qos_rule" "qos_rule" {
+        name = "%[1]s"
+        layer = "${checkpoint_management_qos_layer.qos_layer.name}"
+        position = {top = "top"}
+        action {
+          weight = 30
+        }
+}
+
+data "checkpoint_management_qos_rule" "data_qos_rule" {
+    uid = "${checkpoint_management_qos_rule.qos_rule.id}"
+    layer = "${checkpoint_management_qos_rule.qos_rule.layer}"
+}
+`, name)
+}
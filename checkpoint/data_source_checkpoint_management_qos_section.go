@@ -0,0 +1,72 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func dataSourceManagementQosSection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementQosSectionRead,
+		Schema: map[string]*schema.Schema{
+			"layer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Layer that holds the Object. Identified by the Name or UID.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object unique identifier.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Object name.",
+			},
+		},
+	}
+}
+
+func dataSourceManagementQosSectionRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	name := d.Get("name").(string)
+	uid := d.Get("uid").(string)
+
+	payload := map[string]interface{}{
+		"layer": d.Get("layer"),
+	}
+
+	if name != "" {
+		payload["name"] = name
+	} else if uid != "" {
+		payload["uid"] = uid
+	}
+
+	showQosSectionRes, err := client.ApiCall("show-qos-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showQosSectionRes.Success {
+		return fmt.Errorf(showQosSectionRes.ErrorMsg)
+	}
+
+	qosSection := showQosSectionRes.GetData()
+
+	log.Println("Read QosSection - Show JSON = ", redactPayload(d, qosSection))
+
+	if v := qosSection["uid"]; v != nil {
+		_ = d.Set("uid", v)
+		d.SetId(v.(string))
+	}
+
+	if v := qosSection["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementQosSection_basic(t *testing.T) {
+
+	objName := "tfTestManagementDataQosSection_" + acctest.RandString(6)
+	resourceName := "checkpoint_management_qos_section.qos_section"
+	dataSourceName := "data.checkpoint_management_qos_section.data_qos_section"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagementQosSectionConfig(objName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+
+}
+
+func testAccDataSourceManagementQosSectionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_qos_layer" "qos_layer" {
+        name = "%[1]s_layer"
+}
+
+resource "checkpoint_management_qos_section" "qos_section" {
+        name = "%[1]s"
+        layer = "${checkpoint_management_qos_layer.qos_layer.name}"
+        position = {top = "top"}
+}
+
+data "checkpoint_management_qos_section" "data_qos_section" {
+    name = "${checkpoint_management_qos_section.qos_section.name}"
+    layer = "${checkpoint_management_qos_section.qos_section.layer}"
+}
+`, name)
+}
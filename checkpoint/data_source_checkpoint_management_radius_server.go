@@ -118,7 +118,7 @@ func dataSourceManagementRadiusServerRead(d *schema.ResourceData, m interface{})
 	}
 
 	radiusServer := showRadiusServerRes.GetData()
-	log.Println("Read Radius Server - Show JSON = ", radiusServer)
+	log.Println("Read Radius Server - Show JSON = ", redactPayload(d, radiusServer))
 
 	if v := radiusServer["uid"]; v != nil {
 		_ = d.Set("uid", v)
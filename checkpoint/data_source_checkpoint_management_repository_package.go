@@ -44,7 +44,7 @@ func dataSourceManagementRepositoryPackageRead(d *schema.ResourceData, m interfa
 
 	RepositoryPackage := showRepositoryPackageRes.GetData()
 
-	log.Println("Read RepositoryPackage - Show JSON = ", RepositoryPackage)
+	log.Println("Read RepositoryPackage - Show JSON = ", redactPayload(d, RepositoryPackage))
 
 	if v := RepositoryPackage["uid"]; v != nil {
 		_ = d.Set("uid", v)
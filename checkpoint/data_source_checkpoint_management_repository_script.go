@@ -82,7 +82,7 @@ func dataSourceManagementRepositoryScriptRead(d *schema.ResourceData, m interfac
 
 	repositoryScript := showRepositoryScriptRes.GetData()
 
-	log.Println("Read RepositoryScript - Show JSON = ", repositoryScript)
+	log.Println("Read RepositoryScript - Show JSON = ", redactPayload(d, repositoryScript))
 
 	if v := repositoryScript["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -105,7 +105,7 @@ func dataSourceManagementResourceCifsRead(d *schema.ResourceData, m interface{})
 
 	resourceCifs := showResourceCifsRes.GetData()
 
-	log.Println("Read ResourceCifs - Show JSON = ", resourceCifs)
+	log.Println("Read ResourceCifs - Show JSON = ", redactPayload(d, resourceCifs))
 
 	if v := resourceCifs["uid"]; v != nil {
 		_ = d.Set("uid", v)
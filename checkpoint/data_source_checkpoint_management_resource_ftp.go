@@ -118,7 +118,7 @@ func dataSourceManagementResourceFtpRead(d *schema.ResourceData, m interface{})
 
 	resourceFtp := showResourceFtpRes.GetData()
 
-	log.Println("Read ResourceFtp - Show JSON = ", resourceFtp)
+	log.Println("Read ResourceFtp - Show JSON = ", redactPayload(d, resourceFtp))
 
 	if v := resourceFtp["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -78,7 +78,7 @@ func dataSourceManagementResourceMmsRead(d *schema.ResourceData, m interface{})
 
 	resourceMms := showResourceMmsRes.GetData()
 
-	log.Println("Read ResourceMms - Show JSON = ", resourceMms)
+	log.Println("Read ResourceMms - Show JSON = ", redactPayload(d, resourceMms))
 
 	if v := resourceMms["uid"]; v != nil {
 		_ = d.Set("uid", v)
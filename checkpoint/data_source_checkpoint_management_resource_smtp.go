@@ -290,7 +290,7 @@ func dataSourceManagementResourceSmtpRead(d *schema.ResourceData, m interface{})
 
 	resourceSmtp := showResourceSmtpRes.GetData()
 
-	log.Println("Read ResourceSmtp - Show JSON = ", resourceSmtp)
+	log.Println("Read ResourceSmtp - Show JSON = ", redactPayload(d, resourceSmtp))
 
 	if v := resourceSmtp["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -138,7 +138,7 @@ func dataSourceManagementResourceTcpRead(d *schema.ResourceData, m interface{})
 
 	resourceTcp := showResourceTcpRes.GetData()
 
-	log.Println("Read ResourceTcp - Show JSON = ", resourceTcp)
+	log.Println("Read ResourceTcp - Show JSON = ", redactPayload(d, resourceTcp))
 
 	if v := resourceTcp["uid"]; v != nil {
 		_ = d.Set("uid", v)
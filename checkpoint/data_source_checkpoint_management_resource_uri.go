@@ -362,7 +362,7 @@ func dataSourceManagementResourceUriRead(d *schema.ResourceData, m interface{})
 
 	resourceUri := showResourceUriRes.GetData()
 
-	log.Println("Read ResourceUri - Show JSON = ", resourceUri)
+	log.Println("Read ResourceUri - Show JSON = ", redactPayload(d, resourceUri))
 
 	if v := resourceUri["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -73,7 +73,7 @@ func dataSourceManagementResourceUriForQosRead(d *schema.ResourceData, m interfa
 
 	resourceUriForQos := showResourceUriForQosRes.GetData()
 
-	log.Println("Read ResourceUriForQos - Show JSON = ", resourceUriForQos)
+	log.Println("Read ResourceUriForQos - Show JSON = ", redactPayload(d, resourceUriForQos))
 
 	if v := resourceUriForQos["uid"]; v != nil {
 		_ = d.Set("uid", v)
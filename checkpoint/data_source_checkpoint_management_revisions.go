@@ -0,0 +1,194 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+// dataSourceManagementRevisions lists the revisions of the server, that are the published sessions. The UID of a
+// revision is the to_session of checkpoint_management_command_revert_to_revision.
+func dataSourceManagementRevisions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementRevisionsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Search expression to filter revisions by.",
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximal number of returned results.",
+			},
+			"offset": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of the results to initially skip.",
+			},
+			"order": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Sorts the results by search criteria.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Sorts results by the given field in ascending order.",
+						},
+						"desc": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Sorts results by the given field in descending order.",
+						},
+					},
+				},
+			},
+			"fetch_all": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, fetches all results page by page. The limit is then the size of a page.",
+				Default:     false,
+			},
+			"last_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UID of the latest revision of the server.",
+			},
+			"from": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "From which element number the query was done.",
+			},
+			"to": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "To which element number the query was done.",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of elements returned by the query.",
+			},
+			"revisions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Revisions list.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Revision unique identifier, that is the UID of the published session.",
+						},
+						"session_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the published session.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the published session.",
+						},
+						"publisher": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the administrator that published the revision.",
+						},
+						"publish_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Publish time of the revision in ISO 8601 format.",
+						},
+						"changes": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of changes in the revision.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagementRevisionsRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"view-published-sessions": true,
+	}
+
+	revisionsData, err := showObjectsListWithPayload(client, "show-sessions", "objects", payload, d)
+	if err != nil {
+		return err
+	}
+
+	log.Println("Read Revisions - Show JSON = ", redactPayload(d, revisionsData))
+
+	lastRevision, err := lastPublishedSession(client)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("show-revisions-" + acctest.RandString(10))
+
+	_ = d.Set("last_revision", lastRevision["uid"])
+
+	if v := revisionsData["from"]; v != nil {
+		_ = d.Set("from", v)
+	}
+
+	if v := revisionsData["to"]; v != nil {
+		_ = d.Set("to", v)
+	}
+
+	if v := revisionsData["total"]; v != nil {
+		_ = d.Set("total", v)
+	}
+
+	var revisionsState []map[string]interface{}
+	if v, ok := revisionsData["objects"].([]interface{}); ok {
+		for _, obj := range v {
+			revisionMap, ok := obj.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			revisionMapToAdd := make(map[string]interface{})
+
+			if v := revisionMap["uid"]; v != nil {
+				revisionMapToAdd["uid"] = v
+			}
+
+			if v := revisionMap["name"]; v != nil {
+				revisionMapToAdd["session_name"] = v
+			}
+
+			if v := revisionMap["description"]; v != nil {
+				revisionMapToAdd["description"] = v
+			}
+
+			if v := revisionMap["user-name"]; v != nil {
+				revisionMapToAdd["publisher"] = v
+			}
+
+			if v, ok := revisionMap["publish-time"].(map[string]interface{}); ok {
+				revisionMapToAdd["publish_time"] = v["iso-8601"]
+			}
+
+			if v := revisionMap["changes"]; v != nil {
+				revisionMapToAdd["changes"] = v
+			}
+
+			revisionsState = append(revisionsState, revisionMapToAdd)
+		}
+	}
+	_ = d.Set("revisions", revisionsState)
+
+	return nil
+}
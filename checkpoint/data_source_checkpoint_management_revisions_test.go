@@ -0,0 +1,68 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementRevisions_basic(t *testing.T) {
+
+	dataSourceName := "data.checkpoint_management_revisions.data_test"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "checkpoint_management_revisions" "data_test" {
+    limit = 5
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_revision"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "revisions.0.uid"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "revisions.0.publisher"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceCheckpointManagementRevisions_basic(t *testing.T) {
+
+	mock := newMockApiServer(t)
+	login := mock.login()
+	mock.run("add-host", map[string]interface{}{"name": "tfHost", "ipv4-address": "192.0.2.1"}, login["sid"].(string))
+	mock.run("publish", map[string]interface{}{}, login["sid"].(string))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + `
+data "checkpoint_management_revisions" "test" {
+    fetch_all = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.checkpoint_management_revisions.test", "total", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_revisions.test", "revisions.#", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_revisions.test", "last_revision", login["uid"].(string)),
+					resource.TestCheckResourceAttr("data.checkpoint_management_revisions.test", "revisions.1.uid", login["uid"].(string)),
+					resource.TestCheckResourceAttr("data.checkpoint_management_revisions.test", "revisions.1.publisher", "admin"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_revisions.test", "revisions.1.changes", "1"),
+					resource.TestCheckResourceAttrSet("data.checkpoint_management_revisions.test", "revisions.1.publish_time"),
+				),
+			},
+		},
+	})
+}
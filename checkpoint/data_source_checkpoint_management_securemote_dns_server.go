@@ -92,7 +92,7 @@ func dataSourceManagementSecuremoteDnsServerRead(d *schema.ResourceData, m inter
 
 	securemoteDnsServer := showSecuremoteDnsServerRes.GetData()
 
-	log.Println("Read SecuremoteDnsServer - Show JSON = ", securemoteDnsServer)
+	log.Println("Read SecuremoteDnsServer - Show JSON = ", redactPayload(d, securemoteDnsServer))
 
 	if v := securemoteDnsServer["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -78,7 +78,7 @@ func dataSourceManagementSecuridServerRead(d *schema.ResourceData, m interface{}
 
 	securidServer := showSecuridServerRes.GetData()
 
-	log.Println("Read SecuridServer - Show JSON = ", securidServer)
+	log.Println("Read SecuridServer - Show JSON = ", redactPayload(d, securidServer))
 
 	if v := securidServer["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -68,7 +68,7 @@ func dataSourceManagementSecurityZoneRead(d *schema.ResourceData, m interface{})
 
 	securityZone := showSecurityZoneRes.GetData()
 
-	log.Println("Read SecurityZone - Show JSON = ", securityZone)
+	log.Println("Read SecurityZone - Show JSON = ", redactPayload(d, securityZone))
 
 	if v := securityZone["uid"]; v != nil {
 		_ = d.Set("uid", v)
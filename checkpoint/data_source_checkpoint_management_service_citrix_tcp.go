@@ -73,7 +73,7 @@ func dataSourceManagementServiceCitrixTcpRead(d *schema.ResourceData, m interfac
 
 	serviceCitrixTcp := showServiceCitrixTcpRes.GetData()
 
-	log.Println("Read ServiceCitrixTcp - Show JSON = ", serviceCitrixTcp)
+	log.Println("Read ServiceCitrixTcp - Show JSON = ", redactPayload(d, serviceCitrixTcp))
 
 	if v := serviceCitrixTcp["uid"]; v != nil {
 		_ = d.Set("uid", v)
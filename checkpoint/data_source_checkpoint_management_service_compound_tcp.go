@@ -78,7 +78,7 @@ func dataSourceManagementServiceCompoundTcpRead(d *schema.ResourceData, m interf
 
 	serviceCompoundTcp := showServiceCompoundTcpRes.GetData()
 
-	log.Println("Read ServiceCompoundTcp - Show JSON = ", serviceCompoundTcp)
+	log.Println("Read ServiceCompoundTcp - Show JSON = ", redactPayload(d, serviceCompoundTcp))
 
 	if v := serviceCompoundTcp["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -86,7 +86,7 @@ func dataSourceManagementServiceDceRpcRead(d *schema.ResourceData, m interface{}
 
 	serviceDceRpc := showServiceDceRpcRes.GetData()
 
-	log.Println("Read ServiceDceRpc - Show JSON = ", serviceDceRpc)
+	log.Println("Read ServiceDceRpc - Show JSON = ", redactPayload(d, serviceDceRpc))
 
 	if v := serviceDceRpc["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -327,7 +327,7 @@ func dataSourceManagementServiceGtpRead(d *schema.ResourceData, m interface{}) e
 
 	serviceGtp := showServiceGtpRes.GetData()
 
-	log.Println("Read Service Gtp - Show JSON = ", serviceGtp)
+	log.Println("Read Service Gtp - Show JSON = ", redactPayload(d, serviceGtp))
 
 	if v := serviceGtp["uid"]; v != nil {
 		_ = d.Set("uid", v)
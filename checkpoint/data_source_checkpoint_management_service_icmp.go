@@ -90,7 +90,7 @@ func dataSourceManagementServiceIcmpRead(d *schema.ResourceData, m interface{})
 
 	serviceIcmp := showServiceIcmpRes.GetData()
 
-	log.Println("Read ServiceIcmp - Show JSON = ", serviceIcmp)
+	log.Println("Read ServiceIcmp - Show JSON = ", redactPayload(d, serviceIcmp))
 
 	if v := serviceIcmp["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -91,7 +91,7 @@ func dataSourceManagementServiceIcmp6Read(d *schema.ResourceData, m interface{})
 
 	serviceIcmp6 := showServiceIcmp6Res.GetData()
 
-	log.Println("Read ServiceIcmp6 - Show JSON = ", serviceIcmp6)
+	log.Println("Read ServiceIcmp6 - Show JSON = ", redactPayload(d, serviceIcmp6))
 
 	if v := serviceIcmp6["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -157,7 +157,7 @@ func dataSourceManagementServiceOtherRead(d *schema.ResourceData, m interface{})
 
 	serviceOther := showServiceOtherRes.GetData()
 
-	log.Println("Read ServiceOther - Show JSON = ", serviceOther)
+	log.Println("Read ServiceOther - Show JSON = ", redactPayload(d, serviceOther))
 
 	if v := serviceOther["uid"]; v != nil {
 		_ = d.Set("uid", v)
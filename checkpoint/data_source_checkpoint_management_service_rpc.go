@@ -89,7 +89,7 @@ func dataSourceManagementServiceRpcRead(d *schema.ResourceData, m interface{}) e
 
 	serviceRpc := showServiceRpcRes.GetData()
 
-	log.Println("Read ServiceRpc - Show JSON = ", serviceRpc)
+	log.Println("Read ServiceRpc - Show JSON = ", redactPayload(d, serviceRpc))
 
 	if v := serviceRpc["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -141,7 +141,7 @@ func dataSourceManagementServiceSctpRead(d *schema.ResourceData, m interface{})
 
 	serviceSctp := showServiceSctpRes.GetData()
 
-	log.Println("Read ServiceSctp - Show JSON = ", serviceSctp)
+	log.Println("Read ServiceSctp - Show JSON = ", redactPayload(d, serviceSctp))
 
 	if v := serviceSctp["uid"]; v != nil {
 		_ = d.Set("uid", v)
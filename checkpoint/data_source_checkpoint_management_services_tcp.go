@@ -1,12 +1,10 @@
 package checkpoint
 
 import (
-	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
-	"strconv"
 )
 
 func dataSourceManagementServicesTcp() *schema.Resource {
@@ -50,9 +48,15 @@ func dataSourceManagementServicesTcp() *schema.Resource {
 			"fetch_all": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "If true, fetches all results.",
+				Description: "If true, fetches all results page by page. The limit is then the size of a page.",
 				Default:     false,
 			},
+			"details_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The level of detail for some of the fields in the response can vary from showing only the UID value of the object to a fully detailed representation of the object.",
+				Default:     "full",
+			},
 			"from": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -240,66 +244,12 @@ func dataSourceManagementServicesTcpRead(d *schema.ResourceData, m interface{})
 
 	client := m.(*checkpoint.ApiClient)
 
-	showServicesTcpRes := checkpoint.APIResponse{}
-	var err error
-	fetchAll, _ := d.GetOkExists("fetch_all")
-
-	if fetchAll.(bool) {
-		showServicesTcpRes, err = client.ApiQuery("show-services-tcp", "full", "objects", true, map[string]interface{}{})
-	} else {
-		payload := make(map[string]interface{})
-
-		if val, ok := d.GetOk("filter"); ok {
-			payload["filter"] = val.(string)
-		}
-
-		if val, ok := d.GetOk("limit"); ok {
-			payload["limit"] = val.(int)
-		}
-
-		if v, ok := d.GetOk("order"); ok {
-
-			orderList := v.([]interface{})
-			if len(orderList) > 0 {
-
-				var orderPayload []map[string]interface{}
-
-				for i := range orderList {
-
-					payload := make(map[string]interface{})
-
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
-						payload["ASC"] = v.(string)
-					}
-					if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
-						payload["DESC"] = v.(string)
-					}
-
-					orderPayload = append(orderPayload, payload)
-				}
-
-				payload["order"] = orderPayload
-			}
-		}
-
-		if val, ok := d.GetOk("offset"); ok {
-			payload["offset"] = val.(int)
-		}
-
-		payload["details-level"] = "full"
-		showServicesTcpRes, err = client.ApiCallSimple("show-services-tcp", payload)
-	}
-
+	servicesTcp, err := showObjectsList(client, "show-services-tcp", "objects", d)
 	if err != nil {
-		return fmt.Errorf(err.Error())
-	}
-	if !showServicesTcpRes.Success {
-		return fmt.Errorf(showServicesTcpRes.ErrorMsg)
+		return err
 	}
 
-	servicesTcp := showServicesTcpRes.GetData()
-
-	log.Println("Read ServicesTcp - Show JSON = ", servicesTcp)
+	log.Println("Read ServicesTcp - Show JSON = ", redactPayload(d, servicesTcp))
 
 	d.SetId("show-services-tcp-" + acctest.RandString(10))
 
@@ -0,0 +1,178 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func dataSourceManagementSession() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagementSessionRead,
+		Schema: map[string]*schema.Schema{
+			"uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Session unique identifier. Default is the session of the provider.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Session name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Session description.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Session state, e.g. open or published.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the administrator of the session.",
+			},
+			"application": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application that opened the session.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address the session was opened from.",
+			},
+			"changes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of changes in the session.",
+			},
+			"locks": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects locked by the session.",
+			},
+			"in_work": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the session is in work.",
+			},
+			"expired_session": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the session expired.",
+			},
+			"last_login_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last login time of the session in ISO 8601 format.",
+			},
+			"last_logout_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last logout time of the session in ISO 8601 format.",
+			},
+			"publish_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Publish time of the session in ISO 8601 format.",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Comments string.",
+			},
+		},
+	}
+}
+
+func dataSourceManagementSessionRead(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	payload := make(map[string]interface{})
+
+	if v, ok := d.GetOk("uid"); ok {
+		payload["uid"] = v.(string)
+	}
+
+	showSessionRes, err := client.ApiCall("show-session", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showSessionRes.Success {
+		return fmt.Errorf(showSessionRes.ErrorMsg)
+	}
+
+	session := showSessionRes.GetData()
+
+	log.Println("Read Session - Show JSON = ", redactPayload(d, session))
+
+	if v := session["uid"]; v != nil {
+		_ = d.Set("uid", v)
+		d.SetId(v.(string))
+	}
+
+	if v := session["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
+
+	if v := session["description"]; v != nil {
+		_ = d.Set("description", v)
+	}
+
+	if v := session["state"]; v != nil {
+		_ = d.Set("state", v)
+	}
+
+	if v := session["user-name"]; v != nil {
+		_ = d.Set("user_name", v)
+	}
+
+	if v := session["application"]; v != nil {
+		_ = d.Set("application", v)
+	}
+
+	if v := session["ip-address"]; v != nil {
+		_ = d.Set("ip_address", v)
+	}
+
+	if v := session["changes"]; v != nil {
+		_ = d.Set("changes", v)
+	}
+
+	if v := session["locks"]; v != nil {
+		_ = d.Set("locks", v)
+	}
+
+	if v := session["in-work"]; v != nil {
+		_ = d.Set("in_work", v)
+	}
+
+	if v := session["expired-session"]; v != nil {
+		_ = d.Set("expired_session", v)
+	}
+
+	if v, ok := session["last-login-time"].(map[string]interface{}); ok {
+		_ = d.Set("last_login_time", v["iso-8601"])
+	}
+
+	if v, ok := session["last-logout-time"].(map[string]interface{}); ok {
+		_ = d.Set("last_logout_time", v["iso-8601"])
+	}
+
+	if v, ok := session["publish-time"].(map[string]interface{}); ok {
+		_ = d.Set("publish_time", v["iso-8601"])
+	}
+
+	if v := session["comments"]; v != nil {
+		_ = d.Set("comments", v)
+	}
+
+	return nil
+}
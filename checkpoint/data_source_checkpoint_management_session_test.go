@@ -0,0 +1,65 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementSession_basic(t *testing.T) {
+
+	dataSourceName := "data.checkpoint_management_session.data_test"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "checkpoint_management_session" "data_test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "open"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceCheckpointManagementSession_basic(t *testing.T) {
+
+	mock := newMockApiServer(t)
+	login := mock.login()
+	mock.run("add-host", map[string]interface{}{"name": "tfHost", "ipv4-address": "192.0.2.1"}, login["sid"].(string))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + `
+data "checkpoint_management_session" "current" {
+}
+
+data "checkpoint_management_session" "other" {
+    uid = "` + login["uid"].(string) + `"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.checkpoint_management_session.current", "uid"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_session.current", "state", "open"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_session.current", "changes", "1"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_session.other", "uid", login["uid"].(string)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,26 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dataSourceManagementSessions lists sessions with show-sessions. Every session holds its common fields, and all of
+// its fields, e.g. state and changes, as JSON.
+func dataSourceManagementSessions() *schema.Resource {
+	r := dataSourceManagementObjectsList("show-sessions", "objects")
+	r.Read = dataSourceManagementSessionsRead
+	r.Schema["view_published_sessions"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Show a list of published sessions.",
+	}
+	return r
+}
+
+func dataSourceManagementSessionsRead(d *schema.ResourceData, m interface{}) error {
+	payload := map[string]interface{}{
+		"view-published-sessions": d.Get("view_published_sessions").(bool),
+	}
+	return dataSourceManagementObjectsListRead("show-sessions", "objects", payload, d, m)
+}
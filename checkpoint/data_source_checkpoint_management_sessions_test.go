@@ -0,0 +1,61 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceCheckpointManagementSessions_basic(t *testing.T) {
+
+	dataSourceName := "data.checkpoint_management_sessions.data_test"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "checkpoint_management_sessions" "data_test" {
+    fetch_all = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "total"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.uid"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceCheckpointManagementSessions_basic(t *testing.T) {
+
+	mock := newMockApiServer(t)
+	mock.login()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + `
+data "checkpoint_management_sessions" "test" {
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.checkpoint_management_sessions.test", "total", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_sessions.test", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_sessions.test", "objects.0.type", "session"),
+					resource.TestCheckResourceAttrSet("data.checkpoint_management_sessions.test", "objects.0.json"),
+				),
+			},
+		},
+	})
+}
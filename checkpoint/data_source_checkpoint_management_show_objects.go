@@ -235,7 +235,7 @@ func dataSourceManagementShowObjectsRead(d *schema.ResourceData, m interface{})
 
 	objectsData := showObjectsRes.GetData()
 
-	log.Println("show-objects JSON = ", objectsData)
+	log.Println("show-objects JSON = ", redactPayload(d, objectsData))
 
 	d.SetId("show-objects-" + acctest.RandString(10))
 
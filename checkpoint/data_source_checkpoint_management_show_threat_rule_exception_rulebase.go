@@ -426,7 +426,7 @@ func dataSourceManagementShowThreatRuleExceptionRuleBaseRead(d *schema.ResourceD
 	}
 	threatRuleExceptionRuleBase := showThreatRuleExceptionRuleBaseRes.GetData()
 
-	log.Println("Read ruleBaseJson - Show JSON = ", threatRuleExceptionRuleBase)
+	log.Println("Read ruleBaseJson - Show JSON = ", redactPayload(d, threatRuleExceptionRuleBase))
 
 	if v := threatRuleExceptionRuleBase["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -234,7 +234,7 @@ func dataSourceManagementShowUpdatableObjectsRepositoryContentRead(d *schema.Res
 
 	updatableObjectsRepositoryContentResData := showUpdatableObjectsRepositoryContentRes.GetData()
 
-	log.Println("show-updatable-objects-repository-content JSON = ", updatableObjectsRepositoryContentResData)
+	log.Println("show-updatable-objects-repository-content JSON = ", redactPayload(d, updatableObjectsRepositoryContentResData))
 
 	d.SetId("show-updatable-objects-repository-content-" + acctest.RandString(10))
 
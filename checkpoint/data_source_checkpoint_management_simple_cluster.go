@@ -1904,7 +1904,7 @@ func dataSourceManagementSimpleClusterRead(d *schema.ResourceData, m interface{}
 		}
 	}
 
-	log.Println("Read Simple Cluster - Show JSON = ", cluster)
+	log.Println("Read Simple Cluster - Show JSON = ", redactPayload(d, cluster))
 
 	if v := cluster["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -1857,7 +1857,7 @@ func dataSourceManagementSimpleGatewayRead(d *schema.ResourceData, m interface{}
 
 	gateway := showGatewayRes.GetData()
 
-	log.Println("Read Simple Gateway - Show JSON = ", gateway)
+	log.Println("Read Simple Gateway - Show JSON = ", redactPayload(d, gateway))
 
 	if v := gateway["uid"]; v != nil {
 		_ = d.Set("uid", v)
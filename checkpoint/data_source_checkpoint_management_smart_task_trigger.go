@@ -60,7 +60,7 @@ func dataSourceManagementSmartTaskTriggerRead(d *schema.ResourceData, m interfac
 
 	smartTaskTrigger := showSmartTaskTriggerRes.GetData()
 
-	log.Println("Read Smart Task Trigger - Show JSON = ", smartTaskTrigger)
+	log.Println("Read Smart Task Trigger - Show JSON = ", redactPayload(d, smartTaskTrigger))
 
 	d.SetId("show-smart-task-trigger-" + acctest.RandString(10))
 
@@ -110,7 +110,7 @@ func dataSourceManagementSmtpServerRead(d *schema.ResourceData, m interface{}) e
 
 	smtpServer := showSmtpServerRes.GetData()
 
-	log.Println("Read SmtpServer - Show JSON = ", smtpServer)
+	log.Println("Read SmtpServer - Show JSON = ", redactPayload(d, smtpServer))
 
 	if v := smtpServer["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -88,7 +88,7 @@ func dataSourceManagementSyslogServerRead(d *schema.ResourceData, m interface{})
 
 	syslogServer := showSyslogServerRes.GetData()
 
-	log.Println("Read SyslogServer - Show JSON = ", syslogServer)
+	log.Println("Read SyslogServer - Show JSON = ", redactPayload(d, syslogServer))
 
 	if v := syslogServer["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -75,7 +75,7 @@ func dataSourceManagementTacacsGroupRead(d *schema.ResourceData, m interface{})
 
 	tacacsGroup := showTacacsGroupRes.GetData()
 
-	log.Println("Read TacacsGroup - Show JSON = ", tacacsGroup)
+	log.Println("Read TacacsGroup - Show JSON = ", redactPayload(d, tacacsGroup))
 
 	if v := tacacsGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -110,7 +110,7 @@ func dataSourceManagementTacacsServerRead(d *schema.ResourceData, m interface{})
 
 	tacacsServer := showTacacsServerRes.GetData()
 
-	log.Println("Read Tacacs Server - Show JSON = ", tacacsServer)
+	log.Println("Read Tacacs Server - Show JSON = ", redactPayload(d, tacacsServer))
 
 	if v := tacacsServer["uid"]; v != nil {
 		_ = d.Set("uid", v)
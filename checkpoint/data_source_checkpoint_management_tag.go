@@ -67,7 +67,7 @@ func dataSourceManagementTagRead(d *schema.ResourceData, m interface{}) error {
 
 	tag := showTag.GetData()
 
-	log.Println("Read Tag - Show JSON = ", tag)
+	log.Println("Read Tag - Show JSON = ", redactPayload(d, tag))
 
 	if v := tag["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -90,7 +90,7 @@ func dataSourceManagementTaskRead(d *schema.ResourceData, m interface{}) error {
 
 	task := showTaskRes.GetData()
 
-	log.Println("Read Task - Show JSON = ", task)
+	log.Println("Read Task - Show JSON = ", redactPayload(d, task))
 
 	if task["tasks"] != nil {
 		tasksList := task["tasks"].([]interface{})
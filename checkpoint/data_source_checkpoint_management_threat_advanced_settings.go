@@ -104,7 +104,7 @@ func dataSourceManagementThreatAdvancedSettingsRead(d *schema.ResourceData, m in
 
 	threatAdvancedSettings := showThreatAdvancedSettingsRes.GetData()
 
-	log.Println("Read Threat Advanced Settings - Show JSON = ", threatAdvancedSettings)
+	log.Println("Read Threat Advanced Settings - Show JSON = ", redactPayload(d, threatAdvancedSettings))
 
 	d.SetId("show-threat-advanced-settings-" + acctest.RandString(10))
 
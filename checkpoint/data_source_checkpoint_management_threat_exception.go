@@ -186,7 +186,7 @@ func dataSourceManagementThreatExceptionRead(d *schema.ResourceData, m interface
 
 	exceptionRule := showThreatRuleRes.GetData()
 
-	log.Println("Read Threat Exception - Show JSON = ", exceptionRule)
+	log.Println("Read Threat Exception - Show JSON = ", redactPayload(d, exceptionRule))
 
 	if v := exceptionRule["uid"]; v != nil {
 		_ = d.Set("uid", v)
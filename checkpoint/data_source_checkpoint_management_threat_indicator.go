@@ -92,7 +92,7 @@ func dataSourceManagementThreatIndicatorRead(d *schema.ResourceData, m interface
 
 	threatIndicator := showThreatIndicatorRes.GetData()
 
-	log.Println("Read Threat Indicator - Show JSON = ", threatIndicator)
+	log.Println("Read Threat Indicator - Show JSON = ", redactPayload(d, threatIndicator))
 
 	if v := threatIndicator["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -176,7 +176,7 @@ func dataSourceManagementThreatIocFeedRead(d *schema.ResourceData, m interface{}
 
 	threatIocFeed := showThreatIocFeedRes.GetData()
 
-	log.Println("Read ThreatIocFeed - Show JSON = ", threatIocFeed)
+	log.Println("Read ThreatIocFeed - Show JSON = ", redactPayload(d, threatIocFeed))
 
 	if v := threatIocFeed["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -77,7 +77,7 @@ func dataSourceManagementThreatLayerRead(d *schema.ResourceData, m interface{})
 
 	threatLayer := showThreatLayerRes.GetData()
 
-	log.Println("Read Threat Layer - Show JSON = ", threatLayer)
+	log.Println("Read Threat Layer - Show JSON = ", redactPayload(d, threatLayer))
 
 	if v := threatLayer["uid"]; v != nil {
 		_ = d.Set("uid", v)
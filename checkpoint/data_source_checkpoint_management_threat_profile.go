@@ -454,7 +454,7 @@ func dataSourceManagementThreatProfileRead(d *schema.ResourceData, m interface{}
 
 	threatProfile := showThreatProfileRes.GetData()
 
-	log.Println("Read Threat Profile - Show JSON = ", threatProfile)
+	log.Println("Read Threat Profile - Show JSON = ", redactPayload(d, threatProfile))
 
 	if v := threatProfile["name"]; v != nil {
 		_ = d.Set("name", v)
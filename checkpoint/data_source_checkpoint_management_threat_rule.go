@@ -160,7 +160,7 @@ func dataSourceManagementThreatRuleRead(d *schema.ResourceData, m interface{}) e
 
 	threatRule := showThreatRuleRes.GetData()
 
-	log.Println("Read Threat Rule - Show JSON = ", threatRule)
+	log.Println("Read Threat Rule - Show JSON = ", redactPayload(d, threatRule))
 
 	if v := threatRule["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -204,7 +204,7 @@ func dataSourceManagementTimeRead(d *schema.ResourceData, m interface{}) error {
 
 	time := showTimeRes.GetData()
 
-	log.Println("Read Time - Show JSON = ", time)
+	log.Println("Read Time - Show JSON = ", redactPayload(d, time))
 
 	if v := time["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -83,7 +83,7 @@ func dataSourceManagementTimeGroupRead(d *schema.ResourceData, m interface{}) er
 
 	timeGroup := showTimeGroupRes.GetData()
 
-	log.Println("Read TimeGroup - Show JSON = ", timeGroup)
+	log.Println("Read TimeGroup - Show JSON = ", redactPayload(d, timeGroup))
 
 	if v := timeGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
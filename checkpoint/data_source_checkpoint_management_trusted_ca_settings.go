@@ -36,7 +36,7 @@ func dataSourceManagementSetTrustedCaSettingsRead(d *schema.ResourceData, m inte
 
 	trustedCaSettings := showTrustedCaSettingsRes.GetData()
 
-	log.Println("Read Trusted CA Settings - Show JSON = ", trustedCaSettings)
+	log.Println("Read Trusted CA Settings - Show JSON = ", redactPayload(d, trustedCaSettings))
 
 	d.SetId("set-trusted-ca-settings" + acctest.RandString(10))
 
@@ -131,7 +131,7 @@ func dataSourceManagementTrustedClientRead(d *schema.ResourceData, m interface{}
 
 	trustedClient := showTrustedClientRes.GetData()
 
-	log.Println("Read TrustedClient - Show JSON = ", trustedClient)
+	log.Println("Read TrustedClient - Show JSON = ", redactPayload(d, trustedClient))
 
 	if v := trustedClient["uid"]; v != nil {
 		_ = d.Set("uid", v)
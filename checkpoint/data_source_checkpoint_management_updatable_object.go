@@ -140,7 +140,7 @@ func dataSourceManagementShowUpdatableObjectRead(d *schema.ResourceData, m inter
 
 	updatableObjectJson := showUpdatableObjectRes.GetData()
 
-	log.Println("Read updatable-object - Show JSON = ", updatableObjectJson)
+	log.Println("Read updatable-object - Show JSON = ", redactPayload(d, updatableObjectJson))
 
 	if v := updatableObjectJson["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -173,7 +173,7 @@ func dataSourceManagementUserRead(d *schema.ResourceData, m interface{}) error {
 
 	user := showUserRes.GetData()
 
-	log.Println("Read User - Show JSON = ", user)
+	log.Println("Read User - Show JSON = ", redactPayload(d, user))
 
 	if v := user["uid"]; v != nil {
 		_ = d.Set("uid", v)
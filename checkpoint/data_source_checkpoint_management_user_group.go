@@ -80,7 +80,7 @@ func dataSourceManagementUserGroupRead(d *schema.ResourceData, m interface{}) er
 
 	userGroup := showUserGroupRes.GetData()
 
-	log.Println("Read UserGroup - Show JSON = ", userGroup)
+	log.Println("Read UserGroup - Show JSON = ", redactPayload(d, userGroup))
 
 	if v := userGroup["uid"]; v != nil {
 		_ = d.Set("uid", v)
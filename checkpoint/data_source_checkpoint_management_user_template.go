@@ -167,7 +167,7 @@ func dataSourceManagementUserTemplateRead(d *schema.ResourceData, m interface{})
 
 	userTemplate := showUserTemplateRes.GetData()
 
-	log.Println("Read UserTemplate - Show JSON = ", userTemplate)
+	log.Println("Read UserTemplate - Show JSON = ", redactPayload(d, userTemplate))
 
 	if v := userTemplate["uid"]; v != nil {
 		_ = d.Set("uid", v)
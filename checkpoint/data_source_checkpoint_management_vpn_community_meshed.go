@@ -293,7 +293,7 @@ func dataSourceManagementVpnCommunityMeshedRead(d *schema.ResourceData, m interf
 
 	vpnCommunityMeshed := showVpnCommunityMeshedRes.GetData()
 
-	log.Println("Read VpnCommunityMeshed - Show JSON = ", vpnCommunityMeshed)
+	log.Println("Read VpnCommunityMeshed - Show JSON = ", redactPayload(d, vpnCommunityMeshed))
 
 	if v := vpnCommunityMeshed["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -84,7 +84,7 @@ func dataSourceManagementVpnCommunityRemoteAccessRead(d *schema.ResourceData, m
 
 	vpnCommunityRemoteAccess := showVpnCommunityRemoteAccessRes.GetData()
 
-	log.Println("Read VpnCommunityRemoteAccess - Show JSON = ", vpnCommunityRemoteAccess)
+	log.Println("Read VpnCommunityRemoteAccess - Show JSON = ", redactPayload(d, vpnCommunityRemoteAccess))
 
 	if v := vpnCommunityRemoteAccess["uid"]; v != nil {
 		_ = d.Set("uid", v)
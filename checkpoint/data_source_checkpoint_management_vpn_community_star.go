@@ -304,7 +304,7 @@ func dataSourceManagementVpnCommunityStarRead(d *schema.ResourceData, m interfac
 
 	vpnCommunityStar := showVpnCommunityStarRes.GetData()
 
-	log.Println("Read VpnCommunityStar - Show JSON = ", vpnCommunityStar)
+	log.Println("Read VpnCommunityStar - Show JSON = ", redactPayload(d, vpnCommunityStar))
 
 	if v := vpnCommunityStar["uid"]; v != nil {
 		_ = d.Set("uid", v)
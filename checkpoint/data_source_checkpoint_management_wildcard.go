@@ -96,7 +96,7 @@ func dataSourceManagementWildcardRead(d *schema.ResourceData, m interface{}) err
 
 	wildcard := showWildcardRes.GetData()
 
-	log.Println("Read Wildcard - Show JSON = ", wildcard)
+	log.Println("Read Wildcard - Show JSON = ", redactPayload(d, wildcard))
 
 	if v := wildcard["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -0,0 +1,231 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// domainSessions opens a session per domain for the objects that set the domain argument, so that a provider that is
+// logged in to the Multi-Domain Server manages objects of several domains. A session is opened with login-to-domain
+// the first time the domain is used, and is kept in the session file like the session of the provider.
+type domainSessions struct {
+	sync.Mutex
+	client           *checkpoint.ApiClient
+	args             checkpoint.ApiClientArgs
+	domain           string
+	sessionFileName  string
+	sessionKey       func(domain string) string
+	login            func(client *checkpoint.ApiClient, domain string) (Session, error)
+	sessionLifecycle map[string]interface{}
+	maxRetries       int
+	retryBackoff     time.Duration
+	byDomain         map[string]*checkpoint.ApiClient
+}
+
+var domainSessionsByClient = struct {
+	sync.Mutex
+	byClient map[*checkpoint.ApiClient]*domainSessions
+}{byClient: make(map[*checkpoint.ApiClient]*domainSessions)}
+
+func registerDomainSessions(ds *domainSessions) {
+	ds.byDomain = make(map[string]*checkpoint.ApiClient)
+
+	domainSessionsByClient.Lock()
+	domainSessionsByClient.byClient[ds.client] = ds
+	domainSessionsByClient.Unlock()
+}
+
+// addDomainArgument adds the domain argument to every management resource or data source that does not have one, and
+// runs their operations with the session of that domain.
+func addDomainArgument(resources map[string]*schema.Resource, forceNew bool) {
+	for name, r := range resources {
+		if !strings.HasPrefix(name, "checkpoint_management_") || strings.HasPrefix(name, "checkpoint_management_cme_") {
+			continue
+		}
+		if _, ok := r.Schema["domain"]; ok {
+			continue
+		}
+
+		r.Schema["domain"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: "Name of the domain to use when the provider is logged in to the Multi-Domain Server. Default is the domain of the provider.",
+		}
+		r.Create = runInDomain(r.Create)
+		r.Read = runInDomain(r.Read)
+		r.Update = runInDomain(r.Update)
+		r.Delete = runInDomain(r.Delete)
+		if r.CustomizeDiff != nil {
+			r.CustomizeDiff = customizeDiffInDomain(r.CustomizeDiff)
+		}
+		if r.Importer != nil && r.Importer.State != nil {
+			r.Importer.State = importInDomain(r.Importer.State)
+		}
+	}
+}
+
+func runInDomain(op func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		domain, _ := d.Get("domain").(string)
+		if domain == "" {
+			return op(d, m)
+		}
+		client, err := domainClient(m, domain)
+		if err != nil {
+			return err
+		}
+		return op(d, client)
+	}
+}
+
+func customizeDiffInDomain(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		domain, _ := d.Get("domain").(string)
+		if domain == "" || !d.NewValueKnown("domain") {
+			return customizeDiff(d, m)
+		}
+		client, err := domainClient(m, domain)
+		if err != nil {
+			return err
+		}
+		return customizeDiff(d, client)
+	}
+}
+
+// importInDomain imports objects of other domains by domain=<DOMAIN>;<IMPORT_ID>.
+func importInDomain(state schema.StateFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		domain, id, err := splitScopedId(d.Id(), "domain")
+		if err != nil {
+			return nil, err
+		}
+		if domain == "" {
+			return state(d, m)
+		}
+		client, err := domainClient(m, domain)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+		_ = d.Set("domain", domain)
+		return state(d, client)
+	}
+}
+
+// splitScopedId splits an ID of the form <SCOPE>=<NAME>;<ID>, e.g. domain=Tenant1;<IMPORT_ID>, to the name and the ID.
+// The name ends at the first ";", so that the ID may hold any character, e.g. the ";" of <LAYER>;<UID> or the "@" of
+// a name. An ID without the prefix is returned as is with an empty name.
+func splitScopedId(id string, scope string) (string, string, error) {
+	prefix := scope + "="
+	if !strings.HasPrefix(id, prefix) {
+		return "", id, nil
+	}
+	i := strings.Index(id, ";")
+	if i <= len(prefix) {
+		return "", "", fmt.Errorf("invalid ID %s. Expected %s<%s>;<ID>", id, prefix, strings.ToUpper(scope))
+	}
+	return id[len(prefix):i], id[i+1:], nil
+}
+
+// domainClient returns the client of the session of the given domain, and logs in to the domain if needed.
+func domainClient(m interface{}, domain string) (*checkpoint.ApiClient, error) {
+	client := m.(*checkpoint.ApiClient)
+
+	domainSessionsByClient.Lock()
+	ds := domainSessionsByClient.byClient[client]
+	domainSessionsByClient.Unlock()
+
+	if ds == nil {
+		return nil, fmt.Errorf("domain %s can be used only with web_api context", domain)
+	}
+	if domain == ds.domain {
+		return client, nil
+	}
+
+	ds.Lock()
+	defer ds.Unlock()
+
+	if c, ok := ds.byDomain[domain]; ok {
+		return c, nil
+	}
+
+	key := ds.sessionKey(domain)
+	s, err := GetSession(ds.sessionFileName, key)
+	if err != nil {
+		return nil, err
+	}
+
+	args := ds.args
+	args.Sid = s.Sid
+	c := checkpoint.APIClient(args)
+	if ok := CheckSession(c, s.Uid); !ok {
+		s, err = ds.loginToDomain(domain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to login to domain %s: %s", domain, err.Error())
+		}
+		args.Sid = s.Sid
+		c = checkpoint.APIClient(args)
+		if err := s.Save(ds.sessionFileName, key); err != nil {
+			return nil, err
+		}
+	}
+
+	if ds.sessionLifecycle != nil {
+		registerSessionLifecycle(c, s, ds.sessionFileName, key, ds.sessionLifecycle)
+	}
+	registerRetryPolicy(c, ds.maxRetries, ds.retryBackoff, func() error {
+		s, err := ds.login(c, domain)
+		if err != nil {
+			return fmt.Errorf("Failed to login again: %s", err.Error())
+		}
+		log.Printf("Check Point provider logged in again to domain %s with session uid [%s]", domain, s.Uid)
+		if err := s.Save(ds.sessionFileName, key); err != nil {
+			return err
+		}
+		return renewSessionLifecycle(c, s)
+	})
+
+	shareApiVersion(ds.client, c)
+	if referenceValidationEnabled(ds.client) {
+		registerReferenceValidation(c)
+	}
+	if revisionGuardEnabled(ds.client) {
+		registerRevisionGuard(c, ds.sessionFileName, key)
+	}
+
+	log.Printf("Check Point provider connected to domain %s with session uid [%s]", domain, s.Uid)
+	ds.byDomain[domain] = c
+	return c, nil
+}
+
+// loginToDomain opens a session in the given domain from the session of the provider.
+func (ds *domainSessions) loginToDomain(domain string) (Session, error) {
+	payload := map[string]interface{}{
+		"domain": domain,
+	}
+	loginToDomainRes, err := ds.client.ApiCall("login-to-domain", payload, ds.client.GetSessionID(), true, ds.client.IsProxyUsed())
+	if err != nil {
+		return Session{}, err
+	}
+	if !loginToDomainRes.Success {
+		return Session{}, fmt.Errorf(loginToDomainRes.ErrorMsg)
+	}
+
+	var s Session
+	if v, ok := loginToDomainRes.GetData()["sid"].(string); ok {
+		s.Sid = v
+	}
+	if v, ok := loginToDomainRes.GetData()["uid"].(string); ok {
+		s.Uid = v
+	}
+	return s, nil
+}
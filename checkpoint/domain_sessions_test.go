@@ -0,0 +1,30 @@
+package checkpoint
+
+import (
+	"testing"
+)
+
+func TestUnitProvider_splitScopedId(t *testing.T) {
+	tests := []struct {
+		id       string
+		name     string
+		expected string
+	}{
+		{"domain=Tenant1;9423d36f-2d66-4754-b9e2-e7f4493756d4", "Tenant1", "9423d36f-2d66-4754-b9e2-e7f4493756d4"},
+		{"domain=Tenant1;Network;9423d36f-2d66-4754-b9e2-e7f4493756d4", "Tenant1", "Network;9423d36f-2d66-4754-b9e2-e7f4493756d4"},
+		{"domain=Tenant1;web@example", "Tenant1", "web@example"},
+		{"web@example", "", "web@example"},
+		{"target=gw1;dns", "", "target=gw1;dns"},
+	}
+	for _, test := range tests {
+		name, id, err := splitScopedId(test.id, "domain")
+		if err != nil || name != test.name || id != test.expected {
+			t.Errorf("splitScopedId(%q) = %q, %q, %v, expected %q, %q", test.id, name, id, err, test.name, test.expected)
+		}
+	}
+	for _, invalid := range []string{"domain=Tenant1", "domain=;uid"} {
+		if _, _, err := splitScopedId(invalid, "domain"); err == nil {
+			t.Errorf("splitScopedId(%q) did not fail", invalid)
+		}
+	}
+}
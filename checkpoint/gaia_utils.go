@@ -0,0 +1,282 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"strconv"
+	"strings"
+)
+
+// showGaiaObject runs a Gaia show- command. It returns nil without an error if the object does not exist.
+func showGaiaObject(client *checkpoint.ApiClient, target string, command string, payload map[string]interface{}) (map[string]interface{}, error) {
+	showRes, reply, err := gaiaApiCall(client, target, command, payload)
+	if err != nil {
+		return nil, fmt.Errorf(err.Error())
+	}
+	if !showRes.Success {
+		if code, _ := showRes.GetData()["code"].(string); objectNotFound(code) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(showRes.ErrorMsg)
+	}
+	return reply, nil
+}
+
+// callGaiaApi runs a Gaia command that changes the configuration and returns its reply.
+func callGaiaApi(client *checkpoint.ApiClient, target string, command string, payload map[string]interface{}) (map[string]interface{}, error) {
+	res, reply, err := gaiaApiCall(client, target, command, payload)
+	if err != nil {
+		return nil, fmt.Errorf(err.Error())
+	}
+	if !res.Success {
+		return nil, fmt.Errorf(res.ErrorMsg)
+	}
+	return reply, nil
+}
+
+// gaiaApiCall runs a Gaia command on the machine of the provider, or on the target gateway through the gaia-api
+// proxy of the management server. The reply of the machine is returned apart from the response, since the proxy
+// wraps it in the response-message field.
+func gaiaApiCall(client *checkpoint.ApiClient, target string, command string, payload map[string]interface{}) (checkpoint.APIResponse, map[string]interface{}, error) {
+	if target == "" {
+		res, err := client.ApiCall(command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+		return res, res.GetData(), err
+	}
+	if client.GetContext() != checkpoint.WebContext {
+		return checkpoint.APIResponse{}, nil, fmt.Errorf("target %s can be used only with web_api context", target)
+	}
+
+	proxyPayload := map[string]interface{}{"target": target}
+	for k, v := range payload {
+		proxyPayload[k] = v
+	}
+	res, err := client.ApiCall("gaia-api/"+command, proxyPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !res.Success {
+		return res, res.GetData(), err
+	}
+	reply, ok := res.GetData()["response-message"].(map[string]interface{})
+	if !ok {
+		reply = make(map[string]interface{})
+	}
+	return res, reply, nil
+}
+
+// gaiaTarget returns the gateway that a Gaia resource configures through the management server, or an empty string
+// for the machine of the provider.
+func gaiaTarget(d *schema.ResourceData) string {
+	target, _ := d.Get("target").(string)
+	return target
+}
+
+// gaiaResources are the resources of the Gaia API, that configure the operating system of a machine.
+var gaiaResources = []string{
+	"checkpoint_hostname",
+	"checkpoint_put_file",
+	"checkpoint_physical_interface",
+	"checkpoint_dns",
+	"checkpoint_ntp",
+	"checkpoint_static_route",
+	"checkpoint_vlan_interface",
+	"checkpoint_bond_interface",
+	"checkpoint_bridge_interface",
+	"checkpoint_loopback_interface",
+	"checkpoint_user",
+	"checkpoint_role",
+	"checkpoint_syslog",
+	"checkpoint_syslog_server",
+	"checkpoint_snmp",
+	"checkpoint_proxy",
+	"checkpoint_time_zone",
+	"checkpoint_banner",
+	"checkpoint_expert_password",
+}
+
+// addGaiaTargetArgument adds the target argument to the Gaia resources, so that a provider of the web_api context
+// configures gateways through the management server instead of a provider per gateway. The ID of an object of a
+// gateway is target=<TARGET>;<ID>, so that the same setting of several gateways has different IDs.
+func addGaiaTargetArgument(resources map[string]*schema.Resource) {
+	for _, name := range gaiaResources {
+		r, ok := resources[name]
+		if !ok {
+			continue
+		}
+		r.Schema["target"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Name or UID of the gateway to configure through the gaia-api proxy of the management server. Can be used only with web_api context. Default is the machine of the provider.",
+		}
+		r.Create = runOnGaiaTarget(r.Create)
+		r.Read = runOnGaiaTarget(r.Read)
+		r.Update = runOnGaiaTarget(r.Update)
+		r.Delete = runOnGaiaTarget(r.Delete)
+		if r.Importer != nil && r.Importer.State != nil {
+			r.Importer.State = importOnGaiaTarget(r.Importer.State)
+		}
+	}
+}
+
+// runOnGaiaTarget runs the operation with the ID that the machine knows, and adds the target to the ID again.
+func runOnGaiaTarget(op func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		target := gaiaTarget(d)
+		if target == "" {
+			return op(d, m)
+		}
+		d.SetId(strings.TrimPrefix(d.Id(), gaiaTargetId(target, "")))
+		err := op(d, m)
+		if d.Id() != "" {
+			d.SetId(gaiaTargetId(target, d.Id()))
+		}
+		return err
+	}
+}
+
+// importOnGaiaTarget imports objects of gateways by target=<TARGET>;<IMPORT_ID>, same as their ID.
+func importOnGaiaTarget(state schema.StateFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		target, id, err := splitScopedId(d.Id(), "target")
+		if err != nil {
+			return nil, err
+		}
+		if target == "" {
+			return state(d, m)
+		}
+		_ = d.Set("target", target)
+		d.SetId(id)
+		result, err := state(d, m)
+		for _, r := range result {
+			if r.Id() != "" {
+				r.SetId(gaiaTargetId(target, r.Id()))
+			}
+		}
+		return result, err
+	}
+}
+
+// gaiaTargetId returns the ID of an object of a gateway.
+func gaiaTargetId(target string, id string) string {
+	return "target=" + target + ";" + id
+}
+
+// gaiaInt returns a number of a Gaia reply, that is a string in some of the replies.
+func gaiaInt(v interface{}) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case int:
+		return n
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	}
+	return 0
+}
+
+// gaiaInterfaceSchema adds the address and state arguments that are common to the logical interfaces, e.g. VLAN and
+// bond interfaces, to the schema of the interface.
+func gaiaInterfaceSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["ipv4_address"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Interface IPv4 address.",
+	}
+	s["ipv4_mask_length"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Interface IPv4 address mask length.",
+	}
+	s["ipv6_address"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Interface IPv6 address.",
+	}
+	s["ipv6_mask_length"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Interface IPv6 address mask length.",
+	}
+	s["ipv6_autoconfig"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Configure IPv6 auto-configuration.",
+	}
+	s["mtu"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "Interface MTU.",
+	}
+	s["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Interface state.",
+	}
+	s["comments"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Interface comments.",
+	}
+	return s
+}
+
+// gaiaInterfaceParseSchemaToMap adds the common interface arguments to the payload of an add- or set- command. The
+// set- command gets only the arguments that changed.
+func gaiaInterfaceParseSchemaToMap(d *schema.ResourceData, payload map[string]interface{}, update bool) {
+	if !update || d.HasChange("ipv4_address") || d.HasChange("ipv4_mask_length") {
+		if v, ok := d.GetOk("ipv4_address"); ok {
+			payload["ipv4-address"] = v.(string)
+			payload["ipv4-mask-length"] = d.Get("ipv4_mask_length").(int)
+		} else if update {
+			payload["ipv4-address"] = ""
+		}
+	}
+	if !update || d.HasChange("ipv6_address") || d.HasChange("ipv6_mask_length") {
+		if v, ok := d.GetOk("ipv6_address"); ok {
+			payload["ipv6-address"] = v.(string)
+			payload["ipv6-mask-length"] = d.Get("ipv6_mask_length").(int)
+		} else if update {
+			payload["ipv6-address"] = ""
+		}
+	}
+	if v, ok := d.GetOkExists("ipv6_autoconfig"); ok && (!update || d.HasChange("ipv6_autoconfig")) {
+		payload["ipv6-autoconfig"] = v.(bool)
+	}
+	if v, ok := d.GetOk("mtu"); ok && (!update || d.HasChange("mtu")) {
+		payload["mtu"] = v.(int)
+	}
+	if v, ok := d.GetOkExists("enabled"); ok && (!update || d.HasChange("enabled")) {
+		payload["enabled"] = v.(bool)
+	}
+	if !update || d.HasChange("comments") {
+		payload["comments"] = d.Get("comments").(string)
+	}
+}
+
+// readGaiaInterface sets the common interface arguments from the reply of a show- command.
+func readGaiaInterface(d *schema.ResourceData, interfaceJson map[string]interface{}) {
+	_ = d.Set("ipv4_address", interfaceJson["ipv4-address"])
+	if v := interfaceJson["ipv4-mask-length"]; v != nil {
+		_ = d.Set("ipv4_mask_length", gaiaInt(v))
+	}
+	_ = d.Set("ipv6_address", interfaceJson["ipv6-address"])
+	if v := interfaceJson["ipv6-mask-length"]; v != nil {
+		_ = d.Set("ipv6_mask_length", gaiaInt(v))
+	}
+	if v, ok := interfaceJson["ipv6-autoconfig"].(bool); ok {
+		_ = d.Set("ipv6_autoconfig", v)
+	}
+	if v := interfaceJson["mtu"]; v != nil {
+		_ = d.Set("mtu", gaiaInt(v))
+	}
+	if v, ok := interfaceJson["enabled"].(bool); ok {
+		_ = d.Set("enabled", v)
+	}
+	_ = d.Set("comments", interfaceJson["comments"])
+}
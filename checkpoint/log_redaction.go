@@ -0,0 +1,147 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"strings"
+	"sync"
+)
+
+// The resources log their payloads and the replies of the API. The secrets of a payload are masked before it is
+// logged, by the keys that the API sends secrets in and by the Sensitive attributes of the resource.
+const redactedValue = "******"
+
+// secretKeys are the keys of the API that hold secrets. A key also matches with words before it, e.g. password matches
+// new-password and one-time-password, and secret matches shared-secret.
+var secretKeys = []string{
+	"password",
+	"password-hash",
+	"password-base64",
+	"secret",
+	"secret-key",
+	"secret-access-key",
+	"pre-shared-key",
+	"private-key",
+	"api-key",
+	"sid",
+	"token",
+}
+
+// sensitiveKeys are the keys of the Sensitive attributes of the resource or data source of an operation, by the
+// ResourceData of the operation while it runs.
+var sensitiveKeys = struct {
+	sync.Mutex
+	byData map[*schema.ResourceData]map[string]bool
+}{byData: make(map[*schema.ResourceData]map[string]bool)}
+
+// redactSecretsInLogs wraps the operations of every resource or data source that has Sensitive attributes, so that
+// the payloads that the operation logs are masked by the keys of these attributes.
+func redactSecretsInLogs(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		keys := make(map[string]bool)
+		collectSensitiveKeys(r.Schema, keys)
+		if len(keys) == 0 {
+			continue
+		}
+		r.Create = redactOperation(r.Create, keys)
+		r.Read = redactOperation(r.Read, keys)
+		r.Update = redactOperation(r.Update, keys)
+		r.Delete = redactOperation(r.Delete, keys)
+	}
+}
+
+// collectSensitiveKeys adds the keys of the Sensitive attributes of schemaMap and of its blocks to keys, in the format
+// of the API.
+func collectSensitiveKeys(schemaMap map[string]*schema.Schema, keys map[string]bool) {
+	for k, s := range schemaMap {
+		if s.Sensitive {
+			keys[payloadKey(k)] = true
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			collectSensitiveKeys(elem.Schema, keys)
+		}
+	}
+}
+
+func redactOperation(op func(*schema.ResourceData, interface{}) error, keys map[string]bool) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		sensitiveKeys.Lock()
+		sensitiveKeys.byData[d] = keys
+		sensitiveKeys.Unlock()
+		defer func() {
+			sensitiveKeys.Lock()
+			delete(sensitiveKeys.byData, d)
+			sensitiveKeys.Unlock()
+		}()
+		return op(d, m)
+	}
+}
+
+// redactPayload returns a copy of payload, a payload or a reply of the API, to log by the operation of d, with the
+// values of its secrets masked.
+func redactPayload(d *schema.ResourceData, payload interface{}) interface{} {
+	sensitiveKeys.Lock()
+	keys := sensitiveKeys.byData[d]
+	sensitiveKeys.Unlock()
+	return redactValue(payload, keys)
+}
+
+func redactValue(value interface{}, keys map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			if isSecretKey(k, keys) {
+				redacted[k] = redactedValue
+			} else {
+				redacted[k] = redactValue(item, keys)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = redactValue(item, keys)
+		}
+		return redacted
+	case []map[string]interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = redactValue(item, keys)
+		}
+		return redacted
+	case map[string]string:
+		redacted := make(map[string]string, len(v))
+		for k, item := range v {
+			if isSecretKey(k, keys) {
+				redacted[k] = redactedValue
+			} else {
+				redacted[k] = item
+			}
+		}
+		return redacted
+	}
+	return value
+}
+
+// isSecretKey returns whether key of a payload holds a secret, that is a key of secretKeys or of the Sensitive
+// attributes keys.
+func isSecretKey(key string, keys map[string]bool) bool {
+	key = payloadKey(strings.ToLower(key))
+	if keys[key] {
+		return true
+	}
+	for _, secretKey := range secretKeys {
+		if key == secretKey || strings.HasSuffix(key, "-"+secretKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// payloadKey returns the key of the API of an attribute, e.g. shared-secret of shared_secret.
+func payloadKey(attribute string) string {
+	return strings.Replace(attribute, "_", "-", -1)
+}
@@ -0,0 +1,93 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnitProvider_logRedaction(t *testing.T) {
+	mock := newMockApiServer(t)
+	logFile := filepath.Join(t.TempDir(), "terraform.log")
+
+	defer os.Setenv("TF_LOG", os.Getenv("TF_LOG"))
+	defer os.Setenv("TF_LOG_PATH", os.Getenv("TF_LOG_PATH"))
+	os.Setenv("TF_LOG", "DEBUG")
+	os.Setenv("TF_LOG_PATH", logFile)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + `
+resource "checkpoint_management_smtp_server" "test" {
+  name = "tfTestSmtpServer"
+  server = "smtp.example.com"
+  port = 25
+  encryption = "none"
+  authentication = true
+  username = "tfTestSmtpUser"
+  password = "tf test smtp secret"
+}
+`,
+				Check: testUnitCheckMockObject(mock, "smtp-server", "tfTestSmtpServer", "password", "tf test smtp secret"),
+			},
+		},
+	})
+
+	logs, err := ioutil.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The log also holds the plan of the test framework, that is not logged by the provider
+	var providerLogs []string
+	for _, line := range strings.Split(string(logs), "\n") {
+		if strings.Contains(line, "SmtpServer - ") || strings.Contains(line, "api-key") {
+			providerLogs = append(providerLogs, line)
+		}
+	}
+	if !strings.Contains(strings.Join(providerLogs, "\n"), "Create SmtpServer - Map") {
+		t.Fatalf("the log has no payload of the smtp server")
+	}
+	for _, line := range providerLogs {
+		for _, secret := range []string{"tf test smtp secret", "tfTestSmtpUser", "mock-api-key"} {
+			if strings.Contains(line, secret) {
+				t.Errorf("the log holds the secret %q: %s", secret, line)
+			}
+		}
+	}
+}
+
+func TestUnitProvider_redactPayload(t *testing.T) {
+	sensitive := map[string]bool{"username": true}
+	tests := []struct {
+		payload  interface{}
+		expected interface{}
+	}{
+		{map[string]interface{}{"name": "admin", "password": "abc"}, map[string]interface{}{"name": "admin", "password": "******"}},
+		{map[string]interface{}{"new-password": "abc", "password-expiration": 30}, map[string]interface{}{"new-password": "******", "password-expiration": 30}},
+		{map[string]interface{}{"secret_access_key": "abc", "access-key-id": "AKIA"}, map[string]interface{}{"secret_access_key": "******", "access-key-id": "AKIA"}},
+		{map[string]interface{}{"sid": "abc", "sid-count": 3, "show-sids": false}, map[string]interface{}{"sid": "******", "sid-count": 3, "show-sids": false}},
+		{map[string]interface{}{"username": "admin", "user-name": "admin"}, map[string]interface{}{"username": "******", "user-name": "admin"}},
+		{
+			map[string]interface{}{"gateways": []interface{}{map[string]interface{}{"name": "gw", "shared-secret": "abc"}}},
+			map[string]interface{}{"gateways": []interface{}{map[string]interface{}{"name": "gw", "shared-secret": "******"}}},
+		},
+		{"password", "password"},
+	}
+	for _, test := range tests {
+		if result := redactValue(test.payload, sensitive); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("redactValue(%v) = %v, expected %v", test.payload, result, test.expected)
+		}
+	}
+
+	payload := map[string]interface{}{"password": "abc"}
+	redactValue(payload, sensitive)
+	if payload["password"] != "abc" {
+		t.Errorf("redactValue changed the logged payload: %v", payload)
+	}
+}
@@ -0,0 +1,1489 @@
+package checkpoint
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockApiServer is an in-process fake of the management Web API, so that resources can be tested with
+// resource.UnitTest without a management server. It keeps the objects, rulebases, sessions and revisions of the
+// management database in memory, and serves the generic add-, show-, set- and delete- commands of objects of any type,
+// the commands of Gaia settings and objects, the gaia-api/ proxy and the cme-api/ requests.
+type mockApiServer struct {
+	sync.Mutex
+	server             *httptest.Server
+	objects            map[string]map[string]interface{}
+	published          map[string]map[string]interface{}
+	rulebases          map[string][]string
+	publishedRulebases map[string][]string
+	sessions           map[string]string
+	changes            int
+	clock              int64
+	revisions          []map[string]interface{}
+	revisionChanges    map[int][]interface{}
+	publishOn          map[string]int
+	tasks              map[string][]interface{}
+	gaiaSettings       map[string]map[string]interface{}
+	gaiaObjects        map[string]map[string]interface{}
+	failures           map[string][]mockFailure
+	taskFailures       map[string]string
+	failedTasks        map[string]string
+	expireOn           map[string]bool
+	calls              []string
+	apiVersion         string
+	requestVersions    []string
+	cmeApiVersions     []string
+	cmeObjects         map[string]map[string]interface{}
+	cmeSettings        map[string]map[string]interface{}
+}
+
+// mockFailure is an error response that is returned instead of running a command.
+type mockFailure struct {
+	status  int
+	code    string
+	message string
+}
+
+// Fields that hold references to other objects. A reference to an object that does not exist fails, except for
+// tags which are created on the fly.
+var mockReferenceFields = map[string]bool{
+	"members":     true,
+	"groups":      true,
+	"tags":        true,
+	"source":      true,
+	"destination": true,
+	"service":     true,
+	"time":        true,
+	"install-on":  true,
+}
+
+// Payload fields that control the command and are not part of the object.
+var mockControlFields = map[string]bool{
+	"uid":             true,
+	"new-name":        true,
+	"ignore-errors":   true,
+	"ignore-warnings": true,
+	"details-level":   true,
+	"set-if-exist":    true,
+	"position":        true,
+	"new-position":    true,
+}
+
+const mockApiServerVersion = "1.9"
+
+// API versions that a server of a version supports, up to the version.
+var mockApiVersions = []string{"1", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.6.1", "1.7", "1.7.1", "1.8", "1.8.1", "1.9", "1.9.1", "2.0"}
+
+// Fields of CME objects that are sent with _ and returned with - between words, by collection.
+var mockCmeHyphenFields = map[string][]string{
+	"gwConfigurations": {
+		"communication_with_servers_behind_nat",
+		"identity_awareness_settings",
+		"enable_cloudguard_controller",
+		"receive_identities_from",
+		"repository_gateway_scripts",
+		"send_logs_to_server",
+		"send_logs_to_backup_server",
+		"send_alerts_to_server",
+	},
+}
+
+// CME API versions of the mock server.
+var mockCmeApiVersions = []string{"v1", "v1.1", "v1.2", "v1.2.2", "v1.3", "v1.3.1"}
+
+func newMockApiServer(t *testing.T) *mockApiServer {
+	mock := &mockApiServer{
+		objects:            make(map[string]map[string]interface{}),
+		published:          make(map[string]map[string]interface{}),
+		rulebases:          make(map[string][]string),
+		revisionChanges:    make(map[int][]interface{}),
+		publishedRulebases: make(map[string][]string),
+		sessions:           make(map[string]string),
+		tasks:              make(map[string][]interface{}),
+		gaiaSettings:       make(map[string]map[string]interface{}),
+		gaiaObjects:        make(map[string]map[string]interface{}),
+		failures:           make(map[string][]mockFailure),
+		taskFailures:       make(map[string]string),
+		failedTasks:        make(map[string]string),
+		expireOn:           make(map[string]bool),
+		publishOn:          make(map[string]int),
+		clock:              1704067200000,
+		apiVersion:         mockApiServerVersion,
+		cmeApiVersions:     mockCmeApiVersions,
+		cmeObjects:         make(map[string]map[string]interface{}),
+		cmeSettings:        make(map[string]map[string]interface{}),
+	}
+	mock.revisions = append(mock.revisions, mock.revision(newMockUid(), "System Data", 0))
+	mock.server = httptest.NewTLSServer(http.HandlerFunc(mock.serveHTTP))
+	t.Cleanup(mock.server.Close)
+	return mock
+}
+
+// providerConfig returns a provider block that connects to the mock server. arguments are added to the block, or
+// replace the argument of the same name, e.g. hclArgument("max_retries", 2) or "session_lifecycle {}".
+func (mock *mockApiServer) providerConfig(arguments ...string) string {
+	host, port, _ := net.SplitHostPort(mock.server.Listener.Addr().String())
+	return providerBlock(append([]string{
+		hclArgument("server", host),
+		"port = " + port,
+		hclArgument("api_key", "mock-api-key"),
+		hclArgument("context", "web_api"),
+		hclArgument("ignore_server_certificate", true),
+		hclArgument("session_in_memory", true),
+		hclArgument("retry_backoff", 0),
+	}, arguments...))
+}
+
+// gaiaProviderConfig returns a provider block of the gaia_api context that connects to the mock server, with the
+// given arguments as providerConfig.
+func (mock *mockApiServer) gaiaProviderConfig(arguments ...string) string {
+	host, port, _ := net.SplitHostPort(mock.server.Listener.Addr().String())
+	return providerBlock(append([]string{
+		hclArgument("server", host),
+		"port = " + port,
+		hclArgument("username", "admin"),
+		hclArgument("password", "mock-password"),
+		hclArgument("context", "gaia_api"),
+		hclArgument("ignore_server_certificate", true),
+		hclArgument("session_in_memory", true),
+		hclArgument("retry_backoff", 0),
+	}, arguments...))
+}
+
+// providerBlock returns a provider block with the given arguments. An argument replaces the previous argument of the
+// same name.
+func providerBlock(arguments []string) string {
+	var names []string
+	byName := make(map[string]string)
+	for _, argument := range arguments {
+		name := strings.Fields(argument)[0]
+		if _, ok := byName[name]; !ok {
+			names = append(names, name)
+		}
+		byName[name] = argument
+	}
+
+	config := "\nprovider \"checkpoint\" {\n"
+	for _, name := range names {
+		config += "  " + byName[name] + "\n"
+	}
+	return config + "}\n"
+}
+
+// hclArgument returns an argument of a block with the given value, that is quoted if it is a string.
+func hclArgument(name string, value interface{}) string {
+	if s, ok := value.(string); ok {
+		return fmt.Sprintf("%s = %q", name, s)
+	}
+	return fmt.Sprintf("%s = %v", name, value)
+}
+
+// providers returns a new provider instance for every test, so that tests do not share the configured client.
+func (mock *mockApiServer) providers() map[string]terraform.ResourceProvider {
+	return map[string]terraform.ResourceProvider{
+		"checkpoint": Provider().(*schema.Provider),
+	}
+}
+
+// failNext makes the next call of command fail with the given status and error code.
+func (mock *mockApiServer) failNext(command string, status int, code string, message string) {
+	mock.Lock()
+	defer mock.Unlock()
+	mock.failures[command] = append(mock.failures[command], mockFailure{status: status, code: code, message: message})
+}
+
+// failTaskOfNext makes the task of the next call of command fail with the given message, without running the command.
+func (mock *mockApiServer) failTaskOfNext(command string, message string) {
+	mock.Lock()
+	defer mock.Unlock()
+	mock.taskFailures[command] = message
+}
+
+// publishOnCall publishes a revision of another session, as if an administrator published from SmartConsole, right
+// before the n-th next call of command.
+func (mock *mockApiServer) publishOnCall(command string, n int) {
+	mock.Lock()
+	defer mock.Unlock()
+	mock.publishOn[command] = n
+}
+
+// expireSessionsOn makes every session unknown on the next call of command, as if they timed out.
+func (mock *mockApiServer) expireSessionsOn(command string) {
+	mock.Lock()
+	defer mock.Unlock()
+	mock.expireOn[command] = true
+}
+
+// object returns a copy of the object with the given type and name, or nil.
+func (mock *mockApiServer) object(objectType string, name string) map[string]interface{} {
+	mock.Lock()
+	defer mock.Unlock()
+	if obj := mock.find(objectType, map[string]interface{}{"name": name}); obj != nil {
+		return copyMockObject(obj)
+	}
+	return nil
+}
+
+// setField changes a field of an object outside of Terraform.
+func (mock *mockApiServer) setField(objectType string, name string, field string, value interface{}) {
+	mock.Lock()
+	defer mock.Unlock()
+	if obj := mock.find(objectType, map[string]interface{}{"name": name}); obj != nil {
+		obj[field] = value
+	}
+}
+
+// callCount returns the number of times command was called.
+func (mock *mockApiServer) callCount(command string) int {
+	mock.Lock()
+	defer mock.Unlock()
+	count := 0
+	for _, call := range mock.calls {
+		if call == command {
+			count++
+		}
+	}
+	return count
+}
+
+// serveHTTP runs the command of the request path. Requests to an API version in the path, e.g. /web_api/v1.8/login,
+// fail when the server of apiVersion does not support the version, and the versions are kept in requestVersions.
+func (mock *mockApiServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	command := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	if strings.HasSuffix(r.URL.Path, "/gaia-api/"+command) {
+		command = "gaia-api/" + command
+	}
+	if i := strings.Index(r.URL.Path, "/cme-api/"); i >= 0 {
+		command = r.URL.Path[i+1:]
+	}
+
+	payload := make(map[string]interface{})
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		writeMockResponse(w, http.StatusBadRequest, mockError("generic_err_invalid_syntax", err.Error()))
+		return
+	}
+	if payload == nil {
+		// CME requests without a body send null
+		payload = make(map[string]interface{})
+	}
+
+	mock.Lock()
+	defer mock.Unlock()
+
+	mock.calls = append(mock.calls, command)
+
+	version := ""
+	if prefix := strings.TrimSuffix(r.URL.Path, "/"+command); prefix != r.URL.Path {
+		if i := strings.Index(prefix, "/v"); i >= 0 {
+			version = prefix[i+2:]
+		}
+	}
+	mock.requestVersions = append(mock.requestVersions, version)
+	if version != "" && !containsApiVersion(mock.supportedApiVersions(), version) {
+		writeMockResponse(w, http.StatusNotFound, mockError("generic_err_command_version_not_found", "Command "+command+" with version "+version+" not found"))
+		return
+	}
+
+	if failures := mock.failures[command]; len(failures) > 0 {
+		mock.failures[command] = failures[1:]
+		writeMockResponse(w, failures[0].status, mockError(failures[0].code, failures[0].message))
+		return
+	}
+
+	if message, ok := mock.taskFailures[command]; ok {
+		delete(mock.taskFailures, command)
+		res := mock.task(nil)
+		mock.failedTasks[res["task-id"].(string)] = message
+		writeMockResponse(w, http.StatusOK, res)
+		return
+	}
+
+	if mock.expireOn[command] {
+		delete(mock.expireOn, command)
+		mock.sessions = make(map[string]string)
+	}
+
+	if n, ok := mock.publishOn[command]; ok {
+		if n--; n > 0 {
+			mock.publishOn[command] = n
+		} else {
+			delete(mock.publishOn, command)
+			mock.revisions = append(mock.revisions, mock.revision(newMockUid(), "SmartConsole session", 1))
+		}
+	}
+
+	if command == "login" {
+		writeMockResponse(w, http.StatusOK, mock.login())
+		return
+	}
+	if _, ok := mock.sessions[r.Header.Get("X-chkp-sid")]; !ok {
+		writeMockResponse(w, http.StatusForbidden, mockError("generic_err_wrong_session_id", "Wrong session id ["+r.Header.Get("X-chkp-sid")+"]. Session may be expired. Please check session id and resend the request"))
+		return
+	}
+
+	if strings.HasPrefix(command, "cme-api/") {
+		status, res := mock.runCmeApi(r.Method, strings.TrimPrefix(command, "cme-api/"), payload)
+		writeMockResponse(w, status, res)
+		return
+	}
+
+	status, res := mock.run(command, payload, r.Header.Get("X-chkp-sid"))
+	writeMockResponse(w, status, res)
+}
+
+// run runs a command. Changes are kept in the session until publish, that adds a revision, and discard restores the
+// published objects.
+func (mock *mockApiServer) run(command string, payload map[string]interface{}, sid string) (int, map[string]interface{}) {
+	if strings.HasPrefix(command, "gaia-api/") {
+		return mock.runGaiaApi(strings.TrimPrefix(command, "gaia-api/"), payload)
+	}
+
+	switch command {
+	case "login-to-domain":
+		return http.StatusOK, mock.login()
+	case "logout":
+		delete(mock.sessions, sid)
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	case "keepalive":
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	case "show-api-versions":
+		var supported []interface{}
+		for _, v := range mock.supportedApiVersions() {
+			supported = append(supported, v)
+		}
+		return http.StatusOK, map[string]interface{}{"current-version": mock.apiVersion, "supported-versions": supported}
+	case "show-session":
+		if uid, ok := payload["uid"].(string); ok && uid != "" {
+			for s, sessionUid := range mock.sessions {
+				if sessionUid == uid {
+					return http.StatusOK, mock.session(s)
+				}
+			}
+			return mockNotFound(payload)
+		}
+		return http.StatusOK, mock.session(sid)
+	case "show-sessions":
+		if v, _ := payload["view-published-sessions"].(bool); v {
+			return mock.showRevisions(payload)
+		}
+		return mock.showSessions(payload)
+	case "show-last-published-session":
+		return http.StatusOK, copyMockObject(mock.revisions[len(mock.revisions)-1])
+	case "show-changes":
+		if fromDate, ok := payload["from-date"].(string); ok {
+			return mock.showPublishedChanges(fromDate)
+		}
+		return http.StatusOK, mock.task(mock.showChanges())
+	case "publish":
+		mock.revisionChanges[len(mock.revisions)] = mock.showChanges()
+		mock.revisions = append(mock.revisions, mock.revision(mock.sessions[sid], "mock-session", mock.changes))
+		mock.published = copyMockObjects(mock.objects)
+		mock.publishedRulebases = copyMockRulebases(mock.rulebases)
+		mock.changes = 0
+		return http.StatusOK, mock.task(nil)
+	case "discard":
+		mock.objects = copyMockObjects(mock.published)
+		mock.rulebases = copyMockRulebases(mock.publishedRulebases)
+		mock.changes = 0
+		return http.StatusOK, map[string]interface{}{"message": "OK", "number-of-discarded-changes": 0}
+	case "show-task":
+		return http.StatusOK, mock.showTask(payload)
+	case "show-objects":
+		return mock.showObjects("", payload)
+	case "show-gateways-and-servers":
+		return mock.showObjects("simple-gateway", payload)
+	case "install-policy":
+		return mock.installPolicy(payload)
+	case "where-used":
+		return mock.whereUsed(payload)
+	}
+
+	action := command[:strings.Index(command+"-", "-")]
+	objectType := strings.TrimPrefix(command, action+"-")
+	if mockGaiaSettings[objectType] {
+		return mock.runGaiaSetting("", action, objectType, payload)
+	}
+	if _, ok := mockGaiaObjectNames[objectType]; ok {
+		return mock.runGaiaObject("", action, objectType, payload)
+	}
+	switch action {
+	case "add":
+		return mock.add(objectType, payload)
+	case "show":
+		if strings.HasSuffix(objectType, "-rulebase") {
+			return mock.showRulebase(payload)
+		}
+		if obj := mock.find(objectType, payload); obj != nil {
+			return http.StatusOK, copyMockObject(obj)
+		}
+		if singular, ok := mockSingularType(objectType); ok {
+			status, res := mock.showObjects(singular, payload)
+			if key, ok := mockListKeys[objectType]; ok {
+				res[key] = res["objects"]
+				delete(res, "objects")
+			}
+			return status, res
+		}
+		return mockNotFound(payload)
+	case "set":
+		return mock.set(objectType, payload)
+	case "delete":
+		obj := mock.find(objectType, payload)
+		if obj == nil {
+			return mockNotFound(payload)
+		}
+		delete(mock.objects, obj["uid"].(string))
+		mock.removeFromRulebase(obj["uid"].(string))
+		mock.changes++
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	}
+
+	return http.StatusNotFound, mockError("generic_err_command_not_found", "Unknown command \""+command+"\"")
+}
+
+// Gaia settings of the machine, that have a set- and a show- command.
+var mockGaiaSettings = map[string]bool{
+	"dns":             true,
+	"ntp":             true,
+	"syslog":          true,
+	"snmp":            true,
+	"proxy":           true,
+	"time-and-date":   true,
+	"banner":          true,
+	"expert-password": true,
+}
+
+// Gaia objects and how the machine names them, when they are not identified by name.
+var mockGaiaObjectNames = map[string]func(payload map[string]interface{}) string{
+	"vlan-interface": func(payload map[string]interface{}) string {
+		return fmt.Sprintf("%v.%v", payload["parent"], payload["id"])
+	},
+	"bond-interface": func(payload map[string]interface{}) string {
+		return fmt.Sprintf("bond%v", payload["id"])
+	},
+	"bridge-interface": func(payload map[string]interface{}) string {
+		return fmt.Sprintf("br%v", payload["id"])
+	},
+	"loopback-interface": nil,
+	"static-route": func(payload map[string]interface{}) string {
+		return fmt.Sprintf("%v/%v", payload["address"], payload["mask-length"])
+	},
+	"syslog-server": func(payload map[string]interface{}) string {
+		return fmt.Sprint(payload["address"])
+	},
+	"user": nil,
+	"role": nil,
+}
+
+// runGaiaApi runs a Gaia command on the target gateway, as the gaia-api proxy of the management server does. The
+// Gaia configuration of every gateway is kept apart from the configuration of the machine of the server.
+func (mock *mockApiServer) runGaiaApi(command string, payload map[string]interface{}) (int, map[string]interface{}) {
+	target, _ := payload["target"].(string)
+	gateway := mock.find("simple-gateway", map[string]interface{}{"uid": target})
+	if gateway == nil {
+		gateway = mock.find("simple-gateway", map[string]interface{}{"name": target})
+	}
+	if gateway == nil {
+		return http.StatusNotFound, mockError("generic_err_object_not_found", "Target ["+target+"] not found")
+	}
+
+	gaiaPayload := copyMockObject(payload)
+	delete(gaiaPayload, "target")
+
+	action := command[:strings.Index(command+"-", "-")]
+	objectType := strings.TrimPrefix(command, action+"-")
+	var status int
+	var res map[string]interface{}
+	if mockGaiaSettings[objectType] {
+		status, res = mock.runGaiaSetting(gateway["name"].(string), action, objectType, gaiaPayload)
+	} else if _, ok := mockGaiaObjectNames[objectType]; ok {
+		status, res = mock.runGaiaObject(gateway["name"].(string), action, objectType, gaiaPayload)
+	} else {
+		return http.StatusNotFound, mockError("generic_err_command_not_found", "Unknown command \""+command+"\"")
+	}
+	if status != http.StatusOK {
+		return status, res
+	}
+	return status, map[string]interface{}{"command-name": command, "response-message": res}
+}
+
+// runCmeApi runs a CME API request of method on path, that starts with the CME API version. Requests to a version that
+// is not in cmeApiVersions fail. CME accounts, GW configurations and gateways are stored by their collection and name
+// and are listed by GET of their collection, and general configurations are stored by their name.
+func (mock *mockApiServer) runCmeApi(method string, path string, payload map[string]interface{}) (int, map[string]interface{}) {
+	parts := strings.Split(path, "/")
+	version, parts := parts[0], parts[1:]
+	if !containsApiVersion(mock.cmeApiVersions, version) {
+		return http.StatusNotFound, mockCmeError(http.StatusNotFound, 404, "CME API version "+version+" is not supported")
+	}
+
+	switch {
+	case len(parts) == 1 && parts[0] == "api-versions" && method == http.MethodGet:
+		var supported []interface{}
+		for _, v := range mock.cmeApiVersions {
+			supported = append(supported, v)
+		}
+		return mockCmeResult(map[string]interface{}{
+			"current_version":    mock.cmeApiVersions[len(mock.cmeApiVersions)-1],
+			"supported_versions": supported,
+		})
+	case len(parts) == 2 && parts[0] == "generalConfiguration":
+		if method == http.MethodPut {
+			if mock.cmeSettings[parts[1]] == nil {
+				mock.cmeSettings[parts[1]] = make(map[string]interface{})
+			}
+			for k, v := range payload {
+				mock.cmeSettings[parts[1]][k] = v
+			}
+		}
+		return mockCmeResult(copyMockObject(mock.cmeSettings[parts[1]]))
+	case len(parts) == 2 && method == http.MethodPost:
+		name, _ := payload["name"].(string)
+		key := parts[0] + "/" + name
+		if _, ok := mock.cmeObjects[key]; ok {
+			return http.StatusBadRequest, mockCmeError(http.StatusBadRequest, 400, parts[0]+" "+name+" already exists")
+		}
+		obj := mockCmeFields(parts[0], copyMockObject(payload))
+		obj["platform"] = parts[1]
+		mock.cmeObjects[key] = obj
+		return mockCmeResult(copyMockObject(obj))
+	case len(parts) == 3 && method == http.MethodPut:
+		obj, ok := mock.cmeObjects[parts[0]+"/"+parts[2]]
+		if !ok {
+			return http.StatusNotFound, mockCmeError(http.StatusNotFound, 800, parts[0]+" "+parts[2]+" does not exist")
+		}
+		for k, v := range mockCmeFields(parts[0], payload) {
+			obj[k] = v
+		}
+		return mockCmeResult(copyMockObject(obj))
+	case len(parts) == 1 && method == http.MethodGet:
+		var keys []string
+		for key := range mock.cmeObjects {
+			if strings.HasPrefix(key, parts[0]+"/") {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		result := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			result = append(result, copyMockObject(mock.cmeObjects[key]))
+		}
+		return http.StatusOK, map[string]interface{}{"status-code": http.StatusOK, "result": result}
+	case len(parts) == 2 && method == http.MethodGet:
+		obj, ok := mock.cmeObjects[parts[0]+"/"+parts[1]]
+		if !ok {
+			return http.StatusNotFound, mockCmeError(http.StatusNotFound, 800, parts[0]+" "+parts[1]+" does not exist")
+		}
+		return mockCmeResult(copyMockObject(obj))
+	case len(parts) == 2 && method == http.MethodDelete:
+		key := parts[0] + "/" + parts[1]
+		if _, ok := mock.cmeObjects[key]; !ok {
+			return http.StatusNotFound, mockCmeError(http.StatusNotFound, 800, parts[0]+" "+parts[1]+" does not exist")
+		}
+		delete(mock.cmeObjects, key)
+		return mockCmeResult(nil)
+	}
+	return http.StatusNotFound, mockCmeError(http.StatusNotFound, 404, "Unknown CME API request "+method+" "+path)
+}
+
+// cmeObject returns a copy of the CME object of collection, e.g. accounts, with the given name, or nil.
+func (mock *mockApiServer) cmeObject(collection string, name string) map[string]interface{} {
+	mock.Lock()
+	defer mock.Unlock()
+	if obj, ok := mock.cmeObjects[collection+"/"+name]; ok {
+		return copyMockObject(obj)
+	}
+	return nil
+}
+
+// mockCmeFields renames the fields of a CME object of collection, and of the objects in it, to the names that CME
+// returns.
+func mockCmeFields(collection string, obj map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		if nested, ok := v.(map[string]interface{}); ok {
+			v = mockCmeFields(collection, nested)
+		}
+		for _, field := range mockCmeHyphenFields[collection] {
+			if k == field {
+				k = strings.Replace(k, "_", "-", -1)
+			}
+		}
+		res[k] = v
+	}
+	return res
+}
+
+// setCmeObject adds or replaces a CME object outside of Terraform, e.g. a gateway that CME provisioned.
+func (mock *mockApiServer) setCmeObject(collection string, obj map[string]interface{}) {
+	mock.Lock()
+	defer mock.Unlock()
+	mock.cmeObjects[collection+"/"+obj["name"].(string)] = copyMockObject(obj)
+}
+
+func mockCmeResult(result map[string]interface{}) (int, map[string]interface{}) {
+	return http.StatusOK, map[string]interface{}{"status-code": http.StatusOK, "result": result}
+}
+
+func mockCmeError(status int, code int, message string) map[string]interface{} {
+	return map[string]interface{}{"status-code": status, "error": map[string]interface{}{"message": message, "error-code": code}}
+}
+
+// mockGaiaKey returns the key of a Gaia setting or object of the given machine, that is empty for the machine of the
+// server.
+func mockGaiaKey(machine string, key string) string {
+	if machine == "" {
+		return key
+	}
+	return machine + ":" + key
+}
+
+// runGaiaSetting runs the set-, show- and delete- commands of a Gaia setting of machine, e.g. DNS.
+func (mock *mockApiServer) runGaiaSetting(machine string, action string, setting string, payload map[string]interface{}) (int, map[string]interface{}) {
+	key := mockGaiaKey(machine, setting)
+	switch action {
+	case "set":
+		if mock.gaiaSettings[key] == nil {
+			mock.gaiaSettings[key] = make(map[string]interface{})
+		}
+		for k, v := range payload {
+			mock.gaiaSettings[key][k] = v
+		}
+		return http.StatusOK, copyMockObject(mock.gaiaSettings[key])
+	case "show":
+		return http.StatusOK, copyMockObject(mock.gaiaSettings[key])
+	case "delete":
+		delete(mock.gaiaSettings, key)
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	}
+	return http.StatusNotFound, mockError("generic_err_command_not_found", "Unknown command \""+action+"-"+setting+"\"")
+}
+
+// runGaiaObject runs the add-, set-, show- and delete- commands of a Gaia object of machine, e.g. a VLAN interface.
+// Gaia objects are kept apart from the management objects, since they are named by the machine.
+func (mock *mockApiServer) runGaiaObject(machine string, action string, objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	name, _ := payload["name"].(string)
+	if nameOf := mockGaiaObjectNames[objectType]; nameOf != nil && name == "" {
+		name = nameOf(payload)
+	}
+	if objectType == "loopback-interface" && action == "add" {
+		name = fmt.Sprintf("loop%02d", len(mock.gaiaObjects))
+	}
+	key := mockGaiaKey(machine, objectType+"/"+name)
+	obj := mock.gaiaObjects[key]
+
+	// set-static-route adds the route if it does not exist
+	if objectType == "static-route" && action == "set" && obj == nil {
+		action = "add"
+	}
+
+	switch action {
+	case "add":
+		if obj != nil {
+			return http.StatusBadRequest, mockError("err_validation_failed", objectType+" "+name+" already exists")
+		}
+		obj = map[string]interface{}{"name": name}
+		mock.gaiaObjects[key] = obj
+		fallthrough
+	case "set":
+		if obj == nil {
+			return mockNotFound(map[string]interface{}{"name": name})
+		}
+		for k, v := range payload {
+			obj[k] = v
+		}
+		return http.StatusOK, copyMockObject(obj)
+	case "show":
+		if obj == nil {
+			return mockNotFound(map[string]interface{}{"name": name})
+		}
+		return http.StatusOK, copyMockObject(obj)
+	case "delete":
+		if obj == nil {
+			return mockNotFound(map[string]interface{}{"name": name})
+		}
+		delete(mock.gaiaObjects, key)
+		return http.StatusOK, map[string]interface{}{"message": "OK"}
+	}
+	return http.StatusNotFound, mockError("generic_err_command_not_found", "Unknown command \""+action+"-"+objectType+"\"")
+}
+
+// gaiaObject returns the Gaia object of the given type and name, or nil.
+func (mock *mockApiServer) gaiaObject(objectType string, name string) map[string]interface{} {
+	mock.Lock()
+	defer mock.Unlock()
+	if obj, ok := mock.gaiaObjects[objectType+"/"+name]; ok {
+		return copyMockObject(obj)
+	}
+	return nil
+}
+
+// setGaiaObjectField changes a field of a Gaia object, as if it was changed on the machine.
+func (mock *mockApiServer) setGaiaObjectField(objectType string, name string, field string, value interface{}) {
+	mock.Lock()
+	defer mock.Unlock()
+	if obj, ok := mock.gaiaObjects[objectType+"/"+name]; ok {
+		obj[field] = value
+	}
+}
+
+// setGaiaSetting changes a field of a Gaia setting, as if it was changed on the machine.
+func (mock *mockApiServer) setGaiaSetting(setting string, field string, value interface{}) {
+	mock.Lock()
+	defer mock.Unlock()
+	if mock.gaiaSettings[setting] == nil {
+		mock.gaiaSettings[setting] = make(map[string]interface{})
+	}
+	mock.gaiaSettings[setting][field] = value
+}
+
+func (mock *mockApiServer) login() map[string]interface{} {
+	sid := newMockUid()
+	uid := newMockUid()
+	mock.sessions[sid] = uid
+	return map[string]interface{}{
+		"sid":                sid,
+		"uid":                uid,
+		"api-server-version": mock.apiVersion,
+		"session-timeout":    600,
+		"read-only":          false,
+	}
+}
+
+// supportedApiVersions returns the API versions up to the version of the server.
+func (mock *mockApiServer) supportedApiVersions() []string {
+	var versions []string
+	for _, v := range mockApiVersions {
+		if compareApiVersions(v, mock.apiVersion) <= 0 {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
+
+// session returns the session of sid. Changes are counted for every session since the mock server does not lock
+// objects by session.
+func (mock *mockApiServer) session(sid string) map[string]interface{} {
+	return map[string]interface{}{
+		"uid":       mock.sessions[sid],
+		"name":      "mock-session",
+		"type":      "session",
+		"state":     "open",
+		"user-name": "admin",
+		"changes":   mock.changes,
+		"locks":     mock.changes,
+		"in-work":   true,
+	}
+}
+
+// showSessions lists the open sessions.
+func (mock *mockApiServer) showSessions(payload map[string]interface{}) (int, map[string]interface{}) {
+	var sids []string
+	for sid := range mock.sessions {
+		sids = append(sids, sid)
+	}
+	sort.Strings(sids)
+	objects := make([]interface{}, 0, len(sids))
+	for _, sid := range sids {
+		objects = append(objects, mock.session(sid))
+	}
+	return http.StatusOK, map[string]interface{}{
+		"objects": objects,
+		"from":    1,
+		"to":      len(objects),
+		"total":   len(objects),
+	}
+}
+
+// showRevisions lists the published sessions, from the oldest.
+func (mock *mockApiServer) showRevisions(payload map[string]interface{}) (int, map[string]interface{}) {
+	objects := make([]interface{}, 0, len(mock.revisions))
+	for _, revision := range mock.revisions {
+		objects = append(objects, copyMockObject(revision))
+	}
+	return http.StatusOK, map[string]interface{}{
+		"objects": objects,
+		"from":    1,
+		"to":      len(objects),
+		"total":   len(objects),
+	}
+}
+
+func (mock *mockApiServer) revision(uid string, name string, changes int) map[string]interface{} {
+	return map[string]interface{}{
+		"uid":          uid,
+		"name":         name,
+		"type":         "session",
+		"state":        "published",
+		"user-name":    "admin",
+		"changes":      changes,
+		"publish-time": mock.now(),
+	}
+}
+
+// now advances the clock and returns its time.
+func (mock *mockApiServer) now() map[string]interface{} {
+	mock.clock += 60000
+	return map[string]interface{}{
+		"iso-8601": time.Unix(mock.clock/1000, 0).UTC().Format("2006-01-02T15:04-0700"),
+		"posix":    mock.clock,
+	}
+}
+
+// installPolicy installs the access and threat prevention policies of a package on the targets, unless they are
+// disabled in the payload.
+func (mock *mockApiServer) installPolicy(payload map[string]interface{}) (int, map[string]interface{}) {
+	pkg := mock.find("package", map[string]interface{}{"name": payload["policy-package"]})
+	if pkg == nil {
+		return mockNotFound(map[string]interface{}{"name": payload["policy-package"]})
+	}
+	targets, _ := payload["targets"].([]interface{})
+	for _, target := range targets {
+		if gateway := mock.findAny(fmt.Sprint(target)); gateway == nil || gateway["type"] != "simple-gateway" {
+			return mockNotFound(map[string]interface{}{"name": target})
+		}
+	}
+
+	installationDate := mock.now()
+	for _, target := range targets {
+		gateway := mock.findAny(fmt.Sprint(target))
+		policy, _ := gateway["policy"].(map[string]interface{})
+		if policy == nil {
+			policy = make(map[string]interface{})
+		}
+		if v, ok := payload["access"].(bool); !ok || v {
+			policy["access-policy-installed"] = true
+			policy["access-policy-name"] = pkg["name"]
+			policy["access-policy-installation-date"] = installationDate
+		}
+		if v, ok := payload["threat-prevention"].(bool); !ok || v {
+			policy["threat-policy-installed"] = true
+			policy["threat-policy-name"] = pkg["name"]
+			policy["threat-policy-installation-date"] = installationDate
+		}
+		gateway["policy"] = policy
+		// The installed policy is a status of the gateway and not a change of the session
+		if published, ok := mock.published[gateway["uid"].(string)]; ok {
+			published["policy"] = copyMockObject(policy)
+		}
+	}
+	return http.StatusOK, mock.task(nil)
+}
+
+// whereUsed returns the rules that use an object directly, and through the groups that contain it when indirect is
+// set, same as where-used.
+func (mock *mockApiServer) whereUsed(payload map[string]interface{}) (int, map[string]interface{}) {
+	identifier, _ := payload["uid"].(string)
+	if identifier == "" {
+		identifier, _ = payload["name"].(string)
+	}
+	obj := mock.findAny(identifier)
+	if obj == nil {
+		return mockNotFound(payload)
+	}
+
+	usedBy := map[string]bool{obj["uid"].(string): true}
+	indirect, _ := payload["indirect"].(bool)
+	for found := indirect; found; {
+		found = false
+		for uid, group := range mock.objects {
+			members, _ := group["members"].([]interface{})
+			for _, member := range members {
+				if !usedBy[uid] && usedBy[fmt.Sprint(member.(map[string]interface{})["uid"])] {
+					usedBy[uid] = true
+					found = true
+				}
+			}
+		}
+	}
+
+	usage := map[string]map[string]interface{}{"used-directly": {}, "used-indirectly": {}}
+	for _, rule := range mock.objects {
+		layer, ok := rule["layer"].(string)
+		if !ok || !strings.HasSuffix(fmt.Sprint(rule["type"]), "-rule") {
+			continue
+		}
+		for field := range mockReferenceFields {
+			refs, _ := rule[field].([]interface{})
+			for _, ref := range refs {
+				uid := fmt.Sprint(ref.(map[string]interface{})["uid"])
+				if !usedBy[uid] {
+					continue
+				}
+				kind := "used-indirectly"
+				if uid == obj["uid"] {
+					kind = "used-directly"
+				}
+				key := map[string]string{"access-rule": "access-control-rules", "threat-rule": "threat-prevention-rules"}[fmt.Sprint(rule["type"])]
+				if key == "" {
+					key = fmt.Sprint(rule["type"]) + "s"
+				}
+				layerRef := map[string]interface{}{"name": layer}
+				if layerObj := mock.findAny(layer); layerObj != nil {
+					layerRef = map[string]interface{}{"uid": layerObj["uid"], "name": layerObj["name"]}
+				}
+				rules, _ := usage[kind][key].([]interface{})
+				usage[kind][key] = append(rules, map[string]interface{}{
+					"rule":         copyMockObject(rule),
+					"layer":        layerRef,
+					"rule-columns": []interface{}{field},
+				})
+			}
+		}
+	}
+	return http.StatusOK, map[string]interface{}{
+		"used-directly":   usage["used-directly"],
+		"used-indirectly": usage["used-indirectly"],
+	}
+}
+
+// showChanges returns the task details of show-changes with the changes since the last publish.
+func (mock *mockApiServer) showChanges() []interface{} {
+	added := make([]interface{}, 0)
+	modified := make([]interface{}, 0)
+	deleted := make([]interface{}, 0)
+	for uid, obj := range mock.objects {
+		old, ok := mock.published[uid]
+		if !ok {
+			added = append(added, copyMockObject(obj))
+			continue
+		}
+		oldJson, _ := json.Marshal(old)
+		newJson, _ := json.Marshal(obj)
+		if string(oldJson) != string(newJson) {
+			modified = append(modified, map[string]interface{}{
+				"old-object": copyMockObject(old),
+				"new-object": copyMockObject(obj),
+			})
+		}
+	}
+	for uid, obj := range mock.published {
+		if _, ok := mock.objects[uid]; !ok {
+			deleted = append(deleted, copyMockObject(obj))
+		}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"changes": []interface{}{
+				map[string]interface{}{
+					"operations": map[string]interface{}{
+						"added-objects":    added,
+						"modified-objects": modified,
+						"deleted-objects":  deleted,
+					},
+				},
+			},
+		},
+	}
+}
+
+// showPublishedChanges returns the changes of the revisions that were published at or after fromDate.
+func (mock *mockApiServer) showPublishedChanges(fromDate string) (int, map[string]interface{}) {
+	from, err := time.Parse("2006-01-02T15:04:05Z", fromDate)
+	if err != nil {
+		return http.StatusBadRequest, mockError("generic_err_invalid_parameter", "Invalid from-date: "+fromDate)
+	}
+	changes := make([]interface{}, 0)
+	for i, revision := range mock.revisions {
+		publishTime := revision["publish-time"].(map[string]interface{})
+		if publishTime["posix"].(int64) < from.Unix()*1000 {
+			continue
+		}
+		for _, details := range mock.revisionChanges[i] {
+			changes = append(changes, details.(map[string]interface{})["changes"].([]interface{})...)
+		}
+	}
+	return http.StatusOK, mock.task([]interface{}{map[string]interface{}{"changes": changes}})
+}
+
+// task starts a task that succeeds at once with the given details.
+func (mock *mockApiServer) task(details []interface{}) map[string]interface{} {
+	taskId := newMockUid()
+	if details == nil {
+		details = []interface{}{}
+	}
+	mock.tasks[taskId] = details
+	return map[string]interface{}{"task-id": taskId}
+}
+
+func (mock *mockApiServer) showTask(payload map[string]interface{}) map[string]interface{} {
+	var taskIds []interface{}
+	switch v := payload["task-id"].(type) {
+	case string:
+		taskIds = []interface{}{v}
+	case []interface{}:
+		taskIds = v
+	}
+	tasks := make([]interface{}, 0, len(taskIds))
+	for _, taskId := range taskIds {
+		details, ok := mock.tasks[fmt.Sprint(taskId)]
+		if !ok {
+			details = []interface{}{}
+		}
+		status := "succeeded"
+		if message, ok := mock.failedTasks[fmt.Sprint(taskId)]; ok {
+			status = "failed"
+			details = []interface{}{map[string]interface{}{"fault-message": message}}
+		}
+		tasks = append(tasks, map[string]interface{}{
+			"task-id":             taskId,
+			"task-name":           "Publish operation",
+			"status":              status,
+			"progress-percentage": 100,
+			"task-details":        details,
+		})
+	}
+	return map[string]interface{}{"tasks": tasks}
+}
+
+func (mock *mockApiServer) add(objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	name, _ := payload["name"].(string)
+	if name == "" {
+		return http.StatusBadRequest, mockError("generic_err_missing_required_parameters", "Missing parameter: [name]")
+	}
+	if mock.find(objectType, map[string]interface{}{"name": name}) != nil {
+		return http.StatusBadRequest, mockError("err_validation_failed", "More than one object named '"+name+"' exists.")
+	}
+
+	obj := map[string]interface{}{
+		"uid":  newMockUid(),
+		"type": objectType,
+		"domain": map[string]interface{}{
+			"uid":         "41e821a0-3720-11e3-aa6e-0800200c9fde",
+			"name":        "SMC User",
+			"domain-type": "domain",
+		},
+		"read-only": false,
+	}
+	for k, v := range payload {
+		if mockControlFields[k] {
+			continue
+		}
+		ref, err := mock.resolveReferences(k, v, nil)
+		if err != nil {
+			return mockNotFound(map[string]interface{}{"name": err.Error()})
+		}
+		obj[k] = ref
+	}
+
+	mock.objects[obj["uid"].(string)] = obj
+	if layer, ok := payload["layer"].(string); ok && isMockRulebaseEntry(objectType) {
+		mock.placeInRulebase(layer, obj["uid"].(string), payload["position"])
+	}
+	mock.changes++
+	return http.StatusOK, copyMockObject(obj)
+}
+
+func (mock *mockApiServer) set(objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	obj := mock.find(objectType, payload)
+	if obj == nil {
+		return mockNotFound(payload)
+	}
+	if v, ok := payload["new-name"].(string); ok && v != "" {
+		obj["name"] = v
+	}
+	for k, v := range payload {
+		if mockControlFields[k] || k == "name" {
+			continue
+		}
+		ref, err := mock.resolveReferences(k, v, obj[k])
+		if err != nil {
+			return mockNotFound(map[string]interface{}{"name": err.Error()})
+		}
+		obj[k] = ref
+	}
+	if position, ok := payload["new-position"]; ok {
+		if layer, ok := obj["layer"].(string); ok {
+			mock.removeFromRulebase(obj["uid"].(string))
+			mock.placeInRulebase(layer, obj["uid"].(string), position)
+		}
+	}
+	mock.changes++
+	return http.StatusOK, copyMockObject(obj)
+}
+
+// showObjects lists the objects of the given type, or of any type when objectType is empty. The objects of any type
+// can be filtered by uids and by text in their name, same as show-objects.
+func (mock *mockApiServer) showObjects(objectType string, payload map[string]interface{}) (int, map[string]interface{}) {
+	uids := make(map[interface{}]bool)
+	if list, ok := payload["uids"].([]interface{}); ok {
+		for _, uid := range list {
+			uids[uid] = true
+		}
+	}
+	filter, _ := payload["filter"].(string)
+
+	var objects []map[string]interface{}
+	for _, obj := range mock.objects {
+		if objectType != "" && obj["type"] != objectType {
+			continue
+		}
+		if len(uids) > 0 && !uids[obj["uid"]] {
+			continue
+		}
+		if filter != "" && !mockFilterMatches(filter, fmt.Sprint(obj["name"])) {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return fmt.Sprint(objects[i]["name"]) < fmt.Sprint(objects[j]["name"])
+	})
+
+	offset, limit := 0, 50
+	if v, ok := payload["offset"].(float64); ok {
+		offset = int(v)
+	}
+	if v, ok := payload["limit"].(float64); ok {
+		limit = int(v)
+	}
+	if offset > len(objects) {
+		offset = len(objects)
+	}
+	to := offset + limit
+	if to > len(objects) {
+		to = len(objects)
+	}
+
+	page := make([]interface{}, 0, to-offset)
+	for _, obj := range objects[offset:to] {
+		page = append(page, copyMockObject(obj))
+	}
+	return http.StatusOK, map[string]interface{}{
+		"objects": page,
+		"from":    offset + 1,
+		"to":      to,
+		"total":   len(objects),
+	}
+}
+
+// mockFilterMatches returns true if name contains the text of filter, or of any of its terms that are joined by OR.
+func mockFilterMatches(filter string, name string) bool {
+	for _, term := range strings.Split(filter, " OR ") {
+		if strings.Contains(name, strings.Trim(term, `"`)) {
+			return true
+		}
+	}
+	return false
+}
+
+// find returns the object of the given type that is identified by the uid or name of the payload.
+func (mock *mockApiServer) find(objectType string, payload map[string]interface{}) map[string]interface{} {
+	if uid, ok := payload["uid"].(string); ok && uid != "" {
+		if obj, ok := mock.objects[uid]; ok && obj["type"] == objectType {
+			return obj
+		}
+		return nil
+	}
+	if name, ok := payload["name"].(string); ok && name != "" {
+		for _, obj := range mock.objects {
+			if obj["type"] == objectType && obj["name"] == name {
+				return obj
+			}
+		}
+	}
+	return nil
+}
+
+// findAny returns the object of any type that is identified by the given name or UID.
+func (mock *mockApiServer) findAny(identifier string) map[string]interface{} {
+	if obj, ok := mock.objects[identifier]; ok {
+		return obj
+	}
+	for _, obj := range mock.objects {
+		if obj["name"] == identifier {
+			return obj
+		}
+	}
+	return nil
+}
+
+// resolveReferences returns the value of a reference field as the API returns it. A value with add or remove
+// changes the current references.
+func (mock *mockApiServer) resolveReferences(field string, value interface{}, current interface{}) (interface{}, error) {
+	if !mockReferenceFields[field] {
+		return value, nil
+	}
+
+	var refs []interface{}
+	switch v := value.(type) {
+	case string:
+		refs = []interface{}{v}
+	case []interface{}:
+		refs = v
+	case map[string]interface{}:
+		existing, _ := current.([]interface{})
+		for _, ref := range existing {
+			refs = append(refs, ref.(map[string]interface{})["name"])
+		}
+		if add, ok := v["add"].([]interface{}); ok {
+			refs = append(refs, add...)
+		} else if add, ok := v["add"].(string); ok {
+			refs = append(refs, add)
+		}
+		remove := make(map[interface{}]bool)
+		if list, ok := v["remove"].([]interface{}); ok {
+			for _, ref := range list {
+				remove[ref] = true
+			}
+		} else if ref, ok := v["remove"].(string); ok {
+			remove[ref] = true
+		}
+		kept := refs[:0]
+		for _, ref := range refs {
+			if !remove[ref] {
+				kept = append(kept, ref)
+			}
+		}
+		refs = kept
+	}
+
+	resolved := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		identifier := fmt.Sprint(ref)
+		obj := mock.findAny(identifier)
+		if obj == nil {
+			if field != "tags" {
+				return nil, fmt.Errorf("%s", identifier)
+			}
+			obj = map[string]interface{}{"uid": newMockUid(), "name": identifier, "type": "tag"}
+			mock.objects[obj["uid"].(string)] = obj
+		}
+		resolved = append(resolved, map[string]interface{}{
+			"uid":  obj["uid"],
+			"name": obj["name"],
+			"type": obj["type"],
+		})
+	}
+	return resolved, nil
+}
+
+// move changes the position of a rule or section outside of Terraform.
+func (mock *mockApiServer) move(objectType string, name string, position interface{}) {
+	mock.Lock()
+	defer mock.Unlock()
+	if obj := mock.find(objectType, map[string]interface{}{"name": name}); obj != nil {
+		mock.removeFromRulebase(obj["uid"].(string))
+		mock.placeInRulebase(obj["layer"].(string), obj["uid"].(string), position)
+	}
+}
+
+// rulebase returns the names of the rules and sections of a layer in order.
+func (mock *mockApiServer) rulebase(layer string) []string {
+	mock.Lock()
+	defer mock.Unlock()
+	var names []string
+	for _, uid := range mock.rulebases[layer] {
+		names = append(names, fmt.Sprint(mock.objects[uid]["name"]))
+	}
+	return names
+}
+
+// placeInRulebase inserts a rule or section in the rulebase of layer at position, as the position argument of the
+// add-*-rule and add-*-section commands.
+func (mock *mockApiServer) placeInRulebase(layer string, uid string, position interface{}) {
+	rulebase := mock.rulebases[layer]
+	index := len(rulebase)
+
+	indexOf := func(identifier interface{}) int {
+		for i, entry := range rulebase {
+			if entry == identifier || mock.objects[entry]["name"] == identifier {
+				return i
+			}
+		}
+		return -1
+	}
+	isSection := func(i int) bool {
+		return strings.HasSuffix(fmt.Sprint(mock.objects[rulebase[i]]["type"]), "-section")
+	}
+
+	switch p := position.(type) {
+	case string:
+		if p == "top" {
+			index = 0
+		}
+	case map[string]interface{}:
+		if v, ok := p["above"]; ok && indexOf(v) >= 0 {
+			index = indexOf(v)
+		} else if v, ok := p["below"]; ok && indexOf(v) >= 0 {
+			index = indexOf(v) + 1
+		} else if v, ok := p["top"]; ok && indexOf(v) >= 0 {
+			index = indexOf(v) + 1
+		} else if v, ok := p["bottom"]; ok && indexOf(v) >= 0 {
+			for index = indexOf(v) + 1; index < len(rulebase) && !isSection(index); index++ {
+			}
+		}
+	}
+
+	rulebase = append(rulebase, "")
+	copy(rulebase[index+1:], rulebase[index:])
+	rulebase[index] = uid
+	mock.rulebases[layer] = rulebase
+}
+
+func (mock *mockApiServer) removeFromRulebase(uid string) {
+	for layer, rulebase := range mock.rulebases {
+		for i, entry := range rulebase {
+			if entry == uid {
+				mock.rulebases[layer] = append(rulebase[:i:i], rulebase[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// showRulebase returns the rules and sections of a layer in order, as a single page.
+func (mock *mockApiServer) showRulebase(payload map[string]interface{}) (int, map[string]interface{}) {
+	layer, _ := payload["name"].(string)
+	if layer == "" {
+		layer, _ = payload["uid"].(string)
+	}
+	rulebase, ok := mock.rulebases[layer]
+	if !ok {
+		return mockNotFound(payload)
+	}
+
+	entries := make([]interface{}, 0, len(rulebase))
+	for _, uid := range rulebase {
+		entries = append(entries, copyMockObject(mock.objects[uid]))
+	}
+	return http.StatusOK, map[string]interface{}{
+		"uid":      layer,
+		"name":     layer,
+		"rulebase": entries,
+		"from":     1,
+		"to":       len(entries),
+		"total":    len(entries),
+	}
+}
+
+func isMockRulebaseEntry(objectType string) bool {
+	return strings.HasSuffix(objectType, "-rule") || strings.HasSuffix(objectType, "-section")
+}
+
+// mockSingularType returns the object type of a plural show command, e.g. host for hosts and service-tcp for
+// services-tcp.
+// mockListKeys are the keys of the objects in the replies of the show commands that do not reply with "objects".
+var mockListKeys = map[string]string{
+	"packages":        "packages",
+	"access-layers":   "access-layers",
+	"threat-profiles": "profiles",
+}
+
+func mockSingularType(objectType string) (string, bool) {
+	if strings.HasPrefix(objectType, "services-") {
+		return "service-" + strings.TrimPrefix(objectType, "services-"), true
+	}
+	if strings.HasSuffix(objectType, "s") {
+		return strings.TrimSuffix(objectType, "s"), true
+	}
+	return "", false
+}
+
+func mockNotFound(payload map[string]interface{}) (int, map[string]interface{}) {
+	identifier := payload["uid"]
+	if identifier == nil {
+		identifier = payload["name"]
+	}
+	return http.StatusNotFound, mockError("generic_err_object_not_found", fmt.Sprintf("Requested object [%v] not found", identifier))
+}
+
+func mockError(code string, message string) map[string]interface{} {
+	return map[string]interface{}{"code": code, "message": message}
+}
+
+func writeMockResponse(w http.ResponseWriter, status int, res map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func copyMockObject(obj map[string]interface{}) map[string]interface{} {
+	b, _ := json.Marshal(obj)
+	var res map[string]interface{}
+	_ = json.Unmarshal(b, &res)
+	return res
+}
+
+func copyMockObjects(objects map[string]map[string]interface{}) map[string]map[string]interface{} {
+	res := make(map[string]map[string]interface{}, len(objects))
+	for uid, obj := range objects {
+		res[uid] = copyMockObject(obj)
+	}
+	return res
+}
+
+func copyMockRulebases(rulebases map[string][]string) map[string][]string {
+	copied := make(map[string][]string, len(rulebases))
+	for layer, rulebase := range rulebases {
+		copied[layer] = append([]string(nil), rulebase...)
+	}
+	return copied
+}
+
+func newMockUid() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// TestMockApiServer verifies the generic commands of the mock server, which the unit tests of the resources rely on.
+func TestMockApiServer(t *testing.T) {
+	mock := newMockApiServer(t)
+
+	login := mock.login()
+	sid := login["sid"].(string)
+
+	status, host := mock.run("add-host", map[string]interface{}{"name": "h1", "ipv4-address": "1.1.1.1", "tags": []interface{}{"t1"}}, sid)
+	if status != http.StatusOK {
+		t.Fatalf("add-host failed: %v", host)
+	}
+	if status, _ := mock.run("add-host", map[string]interface{}{"name": "h1"}, sid); status != http.StatusBadRequest {
+		t.Fatalf("add-host with existing name returned %d", status)
+	}
+	if status, res := mock.run("add-group", map[string]interface{}{"name": "g1", "members": []interface{}{"h1"}}, sid); status != http.StatusOK {
+		t.Fatalf("add-group failed: %v", res)
+	}
+	if status, res := mock.run("add-group", map[string]interface{}{"name": "g2", "members": "missing"}, sid); status != http.StatusNotFound || res["code"] != "generic_err_object_not_found" {
+		t.Fatalf("add-group with missing member returned %d %v", status, res)
+	}
+
+	_, group := mock.run("set-group", map[string]interface{}{"name": "g1", "members": map[string]interface{}{"remove": "h1"}}, sid)
+	if members := group["members"].([]interface{}); len(members) != 0 {
+		t.Fatalf("set-group did not remove member: %v", members)
+	}
+
+	_, hosts := mock.run("show-hosts", map[string]interface{}{"limit": float64(1)}, sid)
+	if hosts["total"] != 1 || len(hosts["objects"].([]interface{})) != 1 {
+		t.Fatalf("show-hosts returned %v", hosts)
+	}
+
+	if _, session := mock.run("show-session", map[string]interface{}{}, sid); session["changes"] != 3 {
+		t.Fatalf("show-session returned %v changes, expected 3", session["changes"])
+	}
+	mock.run("discard", map[string]interface{}{}, sid)
+	if status, _ := mock.run("show-host", map[string]interface{}{"uid": host["uid"]}, sid); status != http.StatusNotFound {
+		t.Fatalf("show-host after discard returned %d", status)
+	}
+
+	for i := 0; i < 3; i++ {
+		mock.run("add-host", map[string]interface{}{"name": "h" + strconv.Itoa(i)}, sid)
+	}
+	mock.run("publish", map[string]interface{}{}, sid)
+	mock.run("discard", map[string]interface{}{}, sid)
+	if _, hosts := mock.run("show-hosts", map[string]interface{}{}, sid); hosts["total"] != 3 {
+		t.Fatalf("show-hosts after publish returned %v", hosts)
+	}
+}
@@ -0,0 +1,117 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"strconv"
+)
+
+// Maximal number of objects that the API returns in one request.
+const objectsListPageLimit = 500
+
+// showObjectsList runs a show command that lists objects, e.g. show-hosts, with the filter, order, details_level,
+// limit and offset arguments of the data source. With fetch_all, the objects are fetched page by page until the last
+// one, limit is the size of a page and defaults to the maximum. The command replies with the objects under listKey,
+// e.g. packages for show-packages. The objects of the reply, of all the pages, are under "objects".
+func showObjectsList(client *checkpoint.ApiClient, command string, listKey string, d *schema.ResourceData) (map[string]interface{}, error) {
+	return showObjectsListWithPayload(client, command, listKey, make(map[string]interface{}), d)
+}
+
+// showObjectsListWithPayload is showObjectsList for commands that take more arguments, that are given in payload.
+func showObjectsListWithPayload(client *checkpoint.ApiClient, command string, listKey string, payload map[string]interface{}, d *schema.ResourceData) (map[string]interface{}, error) {
+
+	if v, ok := d.GetOk("filter"); ok {
+		payload["filter"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("order"); ok {
+		orderList := v.([]interface{})
+		if len(orderList) > 0 {
+			var orderPayload []map[string]interface{}
+			for i := range orderList {
+				order := make(map[string]interface{})
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".asc"); ok {
+					order["ASC"] = v.(string)
+				}
+				if v, ok := d.GetOk("order." + strconv.Itoa(i) + ".desc"); ok {
+					order["DESC"] = v.(string)
+				}
+				orderPayload = append(orderPayload, order)
+			}
+			payload["order"] = orderPayload
+		}
+	}
+
+	payload["details-level"] = "full"
+	if v, ok := d.GetOk("details_level"); ok {
+		payload["details-level"] = v.(string)
+	}
+
+	offset := 0
+	if v, ok := d.GetOk("offset"); ok {
+		offset = v.(int)
+		payload["offset"] = offset
+	}
+
+	limit := 0
+	if v, ok := d.GetOk("limit"); ok {
+		limit = v.(int)
+		payload["limit"] = limit
+	}
+
+	if fetchAll, ok := d.GetOk("fetch_all"); !ok || !fetchAll.(bool) {
+		return showObjectsPage(client, command, listKey, payload)
+	}
+
+	if limit <= 0 || limit > objectsListPageLimit {
+		limit = objectsListPageLimit
+	}
+	payload["limit"] = limit
+
+	objects := make([]interface{}, 0)
+	var reply map[string]interface{}
+	for {
+		payload["offset"] = offset
+		page, err := showObjectsPage(client, command, listKey, payload)
+		if err != nil {
+			return nil, err
+		}
+		pageObjects, _ := page["objects"].([]interface{})
+		objects = append(objects, pageObjects...)
+		if reply == nil {
+			reply = page
+		}
+		reply["to"] = page["to"]
+		reply["total"] = page["total"]
+
+		offset += len(pageObjects)
+		total, _ := page["total"].(float64)
+		if len(pageObjects) == 0 || offset >= int(total) {
+			break
+		}
+	}
+	reply["objects"] = objects
+	return reply, nil
+}
+
+// showObjectsPage runs a show command that lists objects. The objects of the reply are moved from listKey to
+// "objects" if the command replies with another key.
+func showObjectsPage(client *checkpoint.ApiClient, command string, listKey string, payload map[string]interface{}) (map[string]interface{}, error) {
+	showRes, err := client.ApiCall(command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return nil, fmt.Errorf(err.Error())
+	}
+	if !showRes.Success {
+		return nil, fmt.Errorf(showRes.ErrorMsg)
+	}
+
+	data := showRes.GetData()
+	if listKey != "objects" {
+		if list, ok := data[listKey]; ok {
+			data["objects"] = list
+			delete(data, listKey)
+		}
+	}
+	return data, nil
+}
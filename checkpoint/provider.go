@@ -11,7 +11,7 @@ import (
 )
 
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"server": {
 				Type:        schema.TypeString,
@@ -37,6 +37,20 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_CONTEXT", checkpoint.WebContext),
 				Description: "Check Point access context - gaia_api or web_api",
 			},
+			"api_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CHECKPOINT_API_VERSION", ""),
+				Description:  "Management API version that the requests are sent to, e.g. 1.8. Default is the version of the server",
+				ValidateFunc: validateApiVersion,
+			},
+			"cme_api_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("CHECKPOINT_CME_API_VERSION", ""),
+				Description:  "CME API version that the requests of the CME resources are sent to, e.g. v1.2.2. Default is the latest version that both CME and the provider support",
+				ValidateFunc: validateApiVersion,
+			},
 			"domain": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -59,19 +73,44 @@ func Provider() terraform.ResourceProvider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_SESSION_FILE_NAME", DefaultSessionFilename),
-				Description: "File name used to store the current session id",
+				Description: "File name used to store the current session id. The file holds a session per server, domain and user",
+			},
+			"session_in_memory": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_SESSION_IN_MEMORY", false),
+				Description: "Keep the session id in memory only instead of storing it in the session file. Every run logs in to a new session",
 			},
 			"proxy_host": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_PROXY_HOST", checkpoint.DefaultProxyHost),
-				Description: "HTTP proxy server address (without \"http://\")",
+				Description: "Proxy server address, optionally with the http:// or https:// scheme. Default is the HTTPS_PROXY environment variable",
 			},
 			"proxy_port": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_PROXY_PORT", checkpoint.DefaultProxyPort),
-				Description: "HTTP proxy port",
+				Description: "Proxy port",
+			},
+			"proxy_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_PROXY_USERNAME", ""),
+				Description: "User name to authenticate with the proxy",
+			},
+			"proxy_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_PROXY_PASSWORD", ""),
+				Description: "Password to authenticate with the proxy",
+			},
+			"proxy_ca_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_PROXY_CA_FILE", ""),
+				Description: "File with the CA certificates in PEM format that verify the certificate of an HTTPS proxy, in addition to the CA certificates of the system",
 			},
 			"api_key": {
 				Type:        schema.TypeString,
@@ -115,6 +154,76 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_IGNORE_SERVER_CERTIFICATE", false),
 				Description: "Indicates that the client should not check the server's certificate",
 			},
+			"server_fingerprint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_SERVER_FINGERPRINT", ""),
+				Description: "SHA-256 fingerprint of the server certificate in hex, optionally separated by colons. The connections to the server fail when the server presents another certificate",
+			},
+			"server_ca_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_SERVER_CA_FILE", ""),
+				Description: "File with the CA certificates in PEM format that the certificate of the server is verified by",
+			},
+			"server_ca": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_SERVER_CA", ""),
+				Description: "CA certificates in PEM format that the certificate of the server is verified by",
+			},
+			"server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_SERVER_NAME", ""),
+				Description: "Name that the certificate of the server is issued to, when it is not the server address",
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_MAX_RETRIES", 3),
+				Description: "Number of times to retry an operation that failed on a transient error, e.g. locked object, busy server or expired session. Use 0 to disable retries",
+			},
+			"retry_backoff": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_RETRY_BACKOFF", 2),
+				Description: "Time in seconds to wait before the first retry. The time is doubled on every retry, up to 60 seconds",
+			},
+			"validate_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_VALIDATE_REFERENCES", false),
+				Description: "Check during plan that the objects referred by rules and groups exist and are of the expected type",
+			},
+			"revision_guard": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHECKPOINT_REVISION_GUARD", false),
+				Description: "Fail the apply when a revision was published on the server since the plan, e.g. from SmartConsole. Relevant only for web_api context",
+			},
+			"session_lifecycle": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Let the provider track the changes of its session, that the checkpoint_management_session_publish resource publishes at the end of the run, or discards when an operation failed. Relevant only for web_api context",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publish_on_success": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Publish the session changes by the checkpoint_management_session_publish resource when all the operations of the provider succeeded",
+						},
+						"discard_on_failure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Discard the session changes by the checkpoint_management_session_publish resource when an operation of the provider failed, so that no object is left locked",
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"checkpoint_management_outbound_inspection_certificate":                resourceManagementOutboundInspectionCertificate(),
@@ -188,6 +297,7 @@ func Provider() terraform.ResourceProvider {
 			"checkpoint_management_service_rpc":                                    resourceManagementServiceRpc(),
 			"checkpoint_management_access_rule":                                    resourceManagementAccessRule(),
 			"checkpoint_management_access_section":                                 resourceManagementAccessSection(),
+			"checkpoint_management_access_rulebase":                                resourceManagementAccessRulebase(),
 			"checkpoint_management_access_layer":                                   resourceManagementAccessLayer(),
 			"checkpoint_management_vpn_community_meshed":                           resourceManagementVpnCommunityMeshed(),
 			"checkpoint_management_vpn_community_star":                             resourceManagementVpnCommunityStar(),
@@ -235,10 +345,28 @@ func Provider() terraform.ResourceProvider {
 			"checkpoint_hostname":                                                  resourceHostname(),
 			"checkpoint_put_file":                                                  resourcePutFile(),
 			"checkpoint_physical_interface":                                        resourcePhysicalInterface(),
+			"checkpoint_dns":                                                       resourceDns(),
+			"checkpoint_ntp":                                                       resourceNtp(),
+			"checkpoint_static_route":                                              resourceStaticRoute(),
+			"checkpoint_vlan_interface":                                            resourceVlanInterface(),
+			"checkpoint_bond_interface":                                            resourceBondInterface(),
+			"checkpoint_bridge_interface":                                          resourceBridgeInterface(),
+			"checkpoint_loopback_interface":                                        resourceLoopbackInterface(),
+			"checkpoint_user":                                                      resourceUser(),
+			"checkpoint_role":                                                      resourceRole(),
+			"checkpoint_syslog":                                                    resourceSyslog(),
+			"checkpoint_syslog_server":                                             resourceSyslogServer(),
+			"checkpoint_snmp":                                                      resourceSnmp(),
+			"checkpoint_proxy":                                                     resourceProxy(),
+			"checkpoint_time_zone":                                                 resourceTimeZone(),
+			"checkpoint_banner":                                                    resourceBanner(),
+			"checkpoint_expert_password":                                           resourceExpertPassword(),
 			"checkpoint_management_login":                                          resourceManagementLogin(),
 			"checkpoint_management_logout":                                         resourceManagementLogout(),
 			"checkpoint_management_publish":                                        resourceManagementPublish(),
+			"checkpoint_management_session_publish":                                resourceManagementSessionPublish(),
 			"checkpoint_management_install_policy":                                 resourceManagementInstallPolicy(),
+			"checkpoint_management_policy_installation":                            resourceManagementPolicyInstallation(),
 			"checkpoint_management_run_ips_update":                                 resourceManagementRunIpsUpdate(),
 			"checkpoint_management_access_point_name":                              resourceManagementAccessPointName(),
 			"checkpoint_management_gsn_handover_group":                             resourceManagementGsnHandoverGroup(),
@@ -331,14 +459,20 @@ func Provider() terraform.ResourceProvider {
 			"checkpoint_management_cme_accounts_azure":                             resourceManagementCMEAccountsAzure(),
 			"checkpoint_management_cme_accounts_gcp":                               resourceManagementCMEAccountsGCP(),
 			"checkpoint_management_cme_accounts_aws":                               resourceManagementCMEAccountsAWS(),
+			"checkpoint_management_cme_accounts_oci":                               resourceManagementCMEAccountsOCI(),
 			"checkpoint_management_cme_gw_configurations_aws":                      resourceManagementCMEGWConfigurationsAWS(),
 			"checkpoint_management_cme_gw_configurations_azure":                    resourceManagementCMEGWConfigurationsAzure(),
 			"checkpoint_management_cme_gw_configurations_gcp":                      resourceManagementCMEGWConfigurationsGCP(),
+			"checkpoint_management_cme_gw_configurations_oci":                      resourceManagementCMEGWConfigurationsOCI(),
 			"checkpoint_generic_api":                                               resourceManagementGenericApi(),
+			"checkpoint_management_generic_object":                                 resourceManagementGenericObject(),
 			"checkpoint_management_syslog_server":                                  resourceManagementSyslogServer(),
 			"checkpoint_management_securid_server":                                 resourceManagementSecuridServer(),
 			"checkpoint_management_securemote_dns_server":                          resourceManagementSecuremoteDnsServer(),
 			"checkpoint_management_resource_uri_for_qos":                           resourceManagementResourceUriForQos(),
+			"checkpoint_management_qos_layer":                                      resourceManagementQosLayer(),
+			"checkpoint_management_qos_rule":                                       resourceManagementQosRule(),
+			"checkpoint_management_qos_section":                                    resourceManagementQosSection(),
 			"checkpoint_management_resource_tcp":                                   resourceManagementResourceTcp(),
 			"checkpoint_management_resource_mms":                                   resourceManagementResourceMms(),
 			"checkpoint_management_log_exporter":                                   resourceManagementLogExporter(),
@@ -520,18 +654,85 @@ func Provider() terraform.ResourceProvider {
 			"checkpoint_management_cme_accounts_aws":                          dataSourceManagementCMEAccountsAWS(),
 			"checkpoint_management_cme_accounts_azure":                        dataSourceManagementCMEAccountsAzure(),
 			"checkpoint_management_cme_accounts_gcp":                          dataSourceManagementCMEAccountsGCP(),
+			"checkpoint_management_cme_accounts_oci":                          dataSourceManagementCMEAccountsOCI(),
 			"checkpoint_management_cme_gw_configurations":                     dataSourceManagementCMEGWConfigurations(),
 			"checkpoint_management_cme_gw_configurations_aws":                 dataSourceManagementCMEGWConfigurationsAWS(),
 			"checkpoint_management_cme_gw_configurations_azure":               dataSourceManagementCMEGWConfigurationsAzure(),
 			"checkpoint_management_cme_gw_configurations_gcp":                 dataSourceManagementCMEGWConfigurationsGCP(),
+			"checkpoint_management_cme_gw_configurations_oci":                 dataSourceManagementCMEGWConfigurationsOCI(),
+			"checkpoint_management_cme_gateways":                              dataSourceManagementCMEGateways(),
+			"checkpoint_management_cme_gateway":                               dataSourceManagementCMEGateway(),
 			"checkpoint_management_hosts":                                     dataSourceManagementHosts(),
 			"checkpoint_management_networks":                                  dataSourceManagementNetworks(),
 			"checkpoint_management_services_tcp":                              dataSourceManagementServicesTcp(),
 			"checkpoint_management_services_udp":                              dataSourceManagementServicesUdp(),
+			"checkpoint_management_groups":                                    dataSourceManagementObjectsList("show-groups", "objects"),
+			"checkpoint_management_groups_with_exclusion":                     dataSourceManagementObjectsList("show-groups-with-exclusion", "objects"),
+			"checkpoint_management_address_ranges":                            dataSourceManagementObjectsList("show-address-ranges", "objects"),
+			"checkpoint_management_multicast_address_ranges":                  dataSourceManagementObjectsList("show-multicast-address-ranges", "objects"),
+			"checkpoint_management_wildcards":                                 dataSourceManagementObjectsList("show-wildcards", "objects"),
+			"checkpoint_management_dns_domains":                               dataSourceManagementObjectsList("show-dns-domains", "objects"),
+			"checkpoint_management_security_zones":                            dataSourceManagementObjectsList("show-security-zones", "objects"),
+			"checkpoint_management_dynamic_objects":                           dataSourceManagementObjectsList("show-dynamic-objects", "objects"),
+			"checkpoint_management_tags":                                      dataSourceManagementObjectsList("show-tags", "objects"),
+			"checkpoint_management_times":                                     dataSourceManagementObjectsList("show-times", "objects"),
+			"checkpoint_management_time_groups":                               dataSourceManagementObjectsList("show-time-groups", "objects"),
+			"checkpoint_management_access_roles":                              dataSourceManagementObjectsList("show-access-roles", "objects"),
+			"checkpoint_management_access_layers":                             dataSourceManagementObjectsList("show-access-layers", "access-layers"),
+			"checkpoint_management_threat_layers":                             dataSourceManagementObjectsList("show-threat-layers", "threat-layers"),
+			"checkpoint_management_https_layers":                              dataSourceManagementObjectsList("show-https-layers", "https-layers"),
+			"checkpoint_management_packages":                                  dataSourceManagementObjectsList("show-packages", "packages"),
+			"checkpoint_management_threat_profiles":                           dataSourceManagementObjectsList("show-threat-profiles", "profiles"),
+			"checkpoint_management_service_groups":                            dataSourceManagementObjectsList("show-service-groups", "objects"),
+			"checkpoint_management_services_icmp":                             dataSourceManagementObjectsList("show-services-icmp", "objects"),
+			"checkpoint_management_services_icmp6":                            dataSourceManagementObjectsList("show-services-icmp6", "objects"),
+			"checkpoint_management_services_sctp":                             dataSourceManagementObjectsList("show-services-sctp", "objects"),
+			"checkpoint_management_services_other":                            dataSourceManagementObjectsList("show-services-other", "objects"),
+			"checkpoint_management_services_dce_rpc":                          dataSourceManagementObjectsList("show-services-dce-rpc", "objects"),
+			"checkpoint_management_services_rpc":                              dataSourceManagementObjectsList("show-services-rpc", "objects"),
+			"checkpoint_management_services_gtp":                              dataSourceManagementObjectsList("show-services-gtp", "objects"),
+			"checkpoint_management_services_citrix_tcp":                       dataSourceManagementObjectsList("show-services-citrix-tcp", "objects"),
+			"checkpoint_management_services_compound_tcp":                     dataSourceManagementObjectsList("show-services-compound-tcp", "objects"),
+			"checkpoint_management_application_sites":                         dataSourceManagementObjectsList("show-application-sites", "objects"),
+			"checkpoint_management_application_site_categories":               dataSourceManagementObjectsList("show-application-site-categories", "objects"),
+			"checkpoint_management_application_site_groups":                   dataSourceManagementObjectsList("show-application-site-groups", "objects"),
+			"checkpoint_management_simple_gateways":                           dataSourceManagementObjectsList("show-simple-gateways", "objects"),
+			"checkpoint_management_simple_clusters":                           dataSourceManagementObjectsList("show-simple-clusters", "objects"),
+			"checkpoint_management_gateways_and_servers":                      dataSourceManagementObjectsList("show-gateways-and-servers", "objects"),
+			"checkpoint_management_lsm_gateways":                              dataSourceManagementObjectsList("show-lsm-gateways", "objects"),
+			"checkpoint_management_lsm_clusters":                              dataSourceManagementObjectsList("show-lsm-clusters", "objects"),
+			"checkpoint_management_checkpoint_hosts":                          dataSourceManagementObjectsList("show-checkpoint-hosts", "objects"),
+			"checkpoint_management_users":                                     dataSourceManagementObjectsList("show-users", "objects"),
+			"checkpoint_management_user_groups":                               dataSourceManagementObjectsList("show-user-groups", "objects"),
+			"checkpoint_management_user_templates":                            dataSourceManagementObjectsList("show-user-templates", "objects"),
+			"checkpoint_management_identity_tags":                             dataSourceManagementObjectsList("show-identity-tags", "objects"),
+			"checkpoint_management_opsec_applications":                        dataSourceManagementObjectsList("show-opsec-applications", "objects"),
+			"checkpoint_management_administrators":                            dataSourceManagementObjectsList("show-administrators", "objects"),
+			"checkpoint_management_vpn_communities_meshed":                    dataSourceManagementObjectsList("show-vpn-communities-meshed", "objects"),
+			"checkpoint_management_vpn_communities_star":                      dataSourceManagementObjectsList("show-vpn-communities-star", "objects"),
+			"checkpoint_management_vpn_communities_remote_access":             dataSourceManagementObjectsList("show-vpn-communities-remote-access", "objects"),
+			"checkpoint_management_trusted_clients":                           dataSourceManagementObjectsList("show-trusted-clients", "objects"),
+			"checkpoint_management_interoperable_devices":                     dataSourceManagementObjectsList("show-interoperable-devices", "objects"),
+			"checkpoint_management_data_center_servers":                       dataSourceManagementObjectsList("show-data-center-servers", "objects"),
+			"checkpoint_management_network_feeds":                             dataSourceManagementObjectsList("show-network-feeds", "objects"),
+			"checkpoint_management_radius_servers":                            dataSourceManagementObjectsList("show-radius-servers", "objects"),
+			"checkpoint_management_radius_groups":                             dataSourceManagementObjectsList("show-radius-groups", "objects"),
+			"checkpoint_management_tacacs_servers":                            dataSourceManagementObjectsList("show-tacacs-servers", "objects"),
+			"checkpoint_management_tacacs_groups":                             dataSourceManagementObjectsList("show-tacacs-groups", "objects"),
+			"checkpoint_management_access_point_names":                        dataSourceManagementObjectsList("show-access-point-names", "objects"),
+			"checkpoint_management_gsn_handover_groups":                       dataSourceManagementObjectsList("show-gsn-handover-groups", "objects"),
+			"checkpoint_management_domains":                                   dataSourceManagementObjectsList("show-domains", "objects"),
 			"checkpoint_management_syslog_server":                             dataSourceManagementSyslogServer(),
 			"checkpoint_management_securid_server":                            dataSourceManagementSecuridServer(),
 			"checkpoint_management_securemote_dns_server":                     dataSourceManagementSecuremoteDnsServer(),
 			"checkpoint_management_resource_uri_for_qos":                      dataSourceManagementResourceUriForQos(),
+			"checkpoint_management_qos_layer":                                 dataSourceManagementQosLayer(),
+			"checkpoint_management_qos_rule":                                  dataSourceManagementQosRule(),
+			"checkpoint_management_qos_section":                               dataSourceManagementQosSection(),
+			"checkpoint_management_session":                                   dataSourceManagementSession(),
+			"checkpoint_management_sessions":                                  dataSourceManagementSessions(),
+			"checkpoint_management_changes":                                   dataSourceManagementChanges(),
+			"checkpoint_management_revisions":                                 dataSourceManagementRevisions(),
 			"checkpoint_management_resource_tcp":                              dataSourceManagementResourceTcp(),
 			"checkpoint_management_resource_mms":                              dataSourceManagementResourceMms(),
 			"checkpoint_management_log_exporter":                              dataSourceManagementLogExporter(),
@@ -545,6 +746,19 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	retryOnTransientErrors(provider.ResourcesMap)
+	retryOnTransientErrors(provider.DataSourcesMap)
+	trackSessionChanges(provider.ResourcesMap)
+	validateReferencesAtPlan(provider.ResourcesMap)
+	guardRevisions(provider.ResourcesMap)
+	addDomainArgument(provider.ResourcesMap, true)
+	addDomainArgument(provider.DataSourcesMap, false)
+	addGaiaTargetArgument(provider.ResourcesMap)
+	redactSecretsInLogs(provider.ResourcesMap)
+	redactSecretsInLogs(provider.DataSourcesMap)
+
+	return provider
 }
 
 func providerConfigure(data *schema.ResourceData) (interface{}, error) {
@@ -552,12 +766,19 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 	username := data.Get("username").(string)
 	password := data.Get("password").(string)
 	context := data.Get("context").(string)
+	apiVersion := strings.TrimPrefix(data.Get("api_version").(string), "v")
+	cmeApiVersion := data.Get("cme_api_version").(string)
 	domain := data.Get("domain").(string)
 	port := data.Get("port").(int)
 	timeout := data.Get("timeout").(int)
 	sessionFileName := data.Get("session_file_name").(string)
-	proxyHost := data.Get("proxy_host").(string)
-	proxyPort := data.Get("proxy_port").(int)
+	proxy := ProxyConfig{
+		Host:     data.Get("proxy_host").(string),
+		Port:     data.Get("proxy_port").(int),
+		Username: data.Get("proxy_username").(string),
+		Password: data.Get("proxy_password").(string),
+		CaFile:   data.Get("proxy_ca_file").(string),
+	}
 	apiKey := data.Get("api_key").(string)
 	sessionName := data.Get("session_name").(string)
 	sessionDescription := data.Get("session_description").(string)
@@ -565,19 +786,34 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 	cloudMgmtId := data.Get("cloud_mgmt_id").(string)
 	autoPublishBatchSize := data.Get("auto_publish_batch_size").(int)
 	ignoreServerCertificate := data.Get("ignore_server_certificate").(bool)
+	serverCertificate := ServerCertificateConfig{
+		Fingerprint: data.Get("server_fingerprint").(string),
+		CaFile:      data.Get("server_ca_file").(string),
+		Ca:          data.Get("server_ca").(string),
+		ServerName:  data.Get("server_name").(string),
+	}
+	maxRetries := data.Get("max_retries").(int)
+	retryBackoff := time.Duration(data.Get("retry_backoff").(int)) * time.Second
+	sessionInMemory := data.Get("session_in_memory").(bool)
+	validateReferences := data.Get("validate_references").(bool)
+	revisionGuard := data.Get("revision_guard").(bool)
 
 	if server == "" || ((username == "" || password == "") && apiKey == "") {
 		return nil, fmt.Errorf("checkpoint-provider missing parameters to initialize (server, (username and password) OR api_key)")
 	}
 
+	if serverCertificate.IsSet() && ignoreServerCertificate {
+		return nil, fmt.Errorf("ignore_server_certificate cannot be used with server_fingerprint, server_ca_file, server_ca or server_name")
+	}
+
 	args := checkpoint.ApiClientArgs{
 		Port:                    port,
 		Fingerprint:             "",
 		Sid:                     "",
 		Server:                  server,
-		ProxyHost:               proxyHost,
-		ProxyPort:               proxyPort,
-		ApiVersion:              "",
+		ProxyHost:               checkpoint.DefaultProxyHost,
+		ProxyPort:               checkpoint.DefaultProxyPort,
+		ApiVersion:              apiVersion,
 		IgnoreServerCertificate: ignoreServerCertificate,
 		AcceptServerCertificate: false,
 		DebugFile:               "deb.txt",
@@ -588,12 +824,19 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 		CloudMgmtId:             cloudMgmtId,
 		AutoPublishBatchSize:    autoPublishBatchSize,
 	}
+	if err := ConfigureConnection(&args, proxy, serverCertificate); err != nil {
+		return nil, err
+	}
 
 	switch context {
 	case checkpoint.WebContext:
+		if sessionInMemory {
+			sessionFileName = ""
+		}
+		key := SessionKey(server, port, cloudMgmtId, domain, username, apiKey)
 		var s Session
 		var err error
-		s, err = GetSession(sessionFileName)
+		s, err = GetSession(sessionFileName, key)
 		if err != nil {
 			return nil, err
 		}
@@ -606,12 +849,62 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 			s, err = login(mgmt, username, password, apiKey, domain, sessionName, sessionDescription, sessionTimeout)
 			if err != nil {
 				log.Println("Failed to perform login")
+				if apiVersion != "" {
+					return nil, fmt.Errorf("%s\nCheck that the server supports api_version %s", err.Error(), apiVersion)
+				}
 				return nil, err
 			}
-			if err := s.Save(sessionFileName); err != nil {
+			if err := s.Save(sessionFileName, key); err != nil {
 				return nil, err
 			}
 		}
+		if err := registerApiVersion(mgmt, apiVersion); err != nil {
+			return nil, err
+		}
+		registerCmeApiVersion(mgmt, cmeApiVersion)
+		var lifecycle map[string]interface{}
+		if v, ok := data.GetOk("session_lifecycle"); ok {
+			lifecycle = map[string]interface{}{
+				"publish_on_success": true,
+				"discard_on_failure": true,
+			}
+			if config, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+				lifecycle = config
+			}
+			registerSessionLifecycle(mgmt, s, sessionFileName, key, lifecycle)
+		}
+		registerRetryPolicy(mgmt, maxRetries, retryBackoff, func() error {
+			s, err := login(mgmt, username, password, apiKey, domain, sessionName, sessionDescription, sessionTimeout)
+			if err != nil {
+				return fmt.Errorf("Failed to login again: %s", err.Error())
+			}
+			log.Printf("Check Point provider logged in again with session uid [%s]", s.Uid)
+			if err := s.Save(sessionFileName, key); err != nil {
+				return err
+			}
+			return renewSessionLifecycle(mgmt, s)
+		})
+		if validateReferences {
+			registerReferenceValidation(mgmt)
+		}
+		if revisionGuard {
+			registerRevisionGuard(mgmt, sessionFileName, key)
+		}
+		registerDomainSessions(&domainSessions{
+			client:          mgmt,
+			args:            args,
+			domain:          domain,
+			sessionFileName: sessionFileName,
+			sessionKey: func(domain string) string {
+				return SessionKey(server, port, cloudMgmtId, domain, username, apiKey)
+			},
+			login: func(client *checkpoint.ApiClient, domain string) (Session, error) {
+				return login(client, username, password, apiKey, domain, sessionName, sessionDescription, sessionTimeout)
+			},
+			sessionLifecycle: lifecycle,
+			maxRetries:       maxRetries,
+			retryBackoff:     retryBackoff,
+		})
 		log.Printf("Check Point provider connected with session uid [%s]", s.Uid)
 		return mgmt, nil
 	case checkpoint.GaiaContext:
@@ -621,6 +914,12 @@ func providerConfigure(data *schema.ResourceData) (interface{}, error) {
 			log.Println("Failed to perform login")
 			return nil, err
 		}
+		registerRetryPolicy(gaia, maxRetries, retryBackoff, func() error {
+			if _, err := login(gaia, username, password, "", "", "", "", sessionTimeout); err != nil {
+				return fmt.Errorf("Failed to login again: %s", err.Error())
+			}
+			return nil
+		})
 		return gaia, nil
 	default:
 		return nil, fmt.Errorf("Invalid access context. Use 'web_api' or 'gaia_api'")
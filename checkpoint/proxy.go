@@ -0,0 +1,193 @@
+package checkpoint
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProxyConfig is the proxy that the connections to a server go through. Host is the address of the proxy, optionally
+// with the http:// or https:// scheme. When Host is empty the proxy is taken from the HTTPS_PROXY environment
+// variable. Servers in the NO_PROXY environment variable are connected directly.
+type ProxyConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	CaFile   string
+}
+
+// proxyURLOf returns the URL of the proxy of config, or nil for a direct connection.
+func proxyURLOf(config ProxyConfig) (*url.URL, error) {
+	host := config.Host
+	if host == "" {
+		host = os.Getenv("HTTPS_PROXY")
+	}
+	if host == "" {
+		host = os.Getenv("https_proxy")
+	}
+	if host == "" {
+		return nil, nil
+	}
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+
+	proxyURL, err := url.Parse(host)
+	if err != nil || proxyURL.Hostname() == "" {
+		return nil, fmt.Errorf("invalid proxy address %s", host)
+	}
+	if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid proxy address %s. The scheme of the proxy must be http or https", host)
+	}
+	if config.Port > 0 && proxyURL.Port() == "" {
+		proxyURL.Host = net.JoinHostPort(proxyURL.Hostname(), strconv.Itoa(config.Port))
+	}
+	if config.Username != "" {
+		proxyURL.User = url.UserPassword(config.Username, config.Password)
+	}
+	return proxyURL, nil
+}
+
+// noProxy returns true if server:port is in noProxyValue, a comma separated list of host names, domains, IP addresses
+// and CIDR blocks with an optional port, or * for all the servers. Same as NO_PROXY of the Go and curl HTTP clients.
+func noProxy(server string, port int, noProxyValue string) bool {
+	server = strings.ToLower(server)
+	serverIP := net.ParseIP(server)
+	for _, entry := range strings.Split(noProxyValue, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if serverIP != nil && cidr.Contains(serverIP) {
+				return true
+			}
+			continue
+		}
+		if host, entryPort, err := net.SplitHostPort(entry); err == nil {
+			if entryPort != strconv.Itoa(port) {
+				continue
+			}
+			entry = host
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			if serverIP != nil && ip.Equal(serverIP) {
+				return true
+			}
+			continue
+		}
+		domain := strings.TrimPrefix(strings.TrimPrefix(entry, "*"), ".")
+		if server == domain || strings.HasSuffix(server, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+var dialer = &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+
+const tlsHandshakeTimeout = 10 * time.Second
+
+// proxyRootCAs returns the CA certificates that the certificate of an HTTPS proxy is verified by, the ones of the system
+// and of caFile, or nil for the ones of the system only.
+func proxyRootCAs(caFile string) (*x509.CertPool, error) {
+	if caFile == "" {
+		return nil, nil
+	}
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proxy CA file: %s", err.Error())
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil || rootCAs == nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("proxy CA file %s holds no PEM certificates", caFile)
+	}
+	return rootCAs, nil
+}
+
+// dialProxy opens a tunnel to addr through the proxy by a CONNECT request. The certificate of an HTTPS proxy is
+// verified by rootCAs.
+func dialProxy(ctx context.Context, proxyURL *url.URL, rootCAs *x509.CertPool, addr string) (net.Conn, error) {
+	proxyAddr := proxyAddress(proxyURL)
+	conn, err := dialer.DialContext(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname(), RootCAs: rootCAs})
+		if err := handshake(tlsConn); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to connect to proxy %s: %s", proxyAddr, err.Error())
+		}
+		conn = tlsConn
+	}
+
+	connect := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		connect.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	_ = conn.SetDeadline(time.Now().Add(tlsHandshakeTimeout))
+	if err := connect.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	res, err := http.ReadResponse(bufio.NewReader(conn), connect)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to connect to %s through proxy %s: %s", addr, proxyAddr, err.Error())
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused the connection to %s: %s", proxyAddr, addr, res.Status)
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	return conn, nil
+}
+
+func handshake(conn *tls.Conn) error {
+	_ = conn.SetDeadline(time.Now().Add(tlsHandshakeTimeout))
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+// proxyAddress returns the host:port that the transport connects to for the proxy.
+func proxyAddress(proxyURL *url.URL) string {
+	if proxyURL.Port() != "" {
+		return proxyURL.Host
+	}
+	if proxyURL.Scheme == "https" {
+		return net.JoinHostPort(proxyURL.Hostname(), "443")
+	}
+	return net.JoinHostPort(proxyURL.Hostname(), "80")
+}
@@ -0,0 +1,253 @@
+package checkpoint
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+func TestUnitProvider_proxy(t *testing.T) {
+	mock := newMockApiServer(t)
+	proxy := newMockProxy(t, false, "proxyuser", "proxypass")
+	providerConfig := mock.providerConfig(
+		hclArgument("proxy_host", proxy.server.URL),
+		hclArgument("proxy_username", "proxyuser"),
+		hclArgument("proxy_password", "proxypass"),
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccManagementHostConfig("tfTestProxy", "192.0.2.1", "blue"),
+				Check: func(s *terraform.State) error {
+					if proxy.connectCount() == 0 {
+						return fmt.Errorf("no connection went through the proxy")
+					}
+					return testUnitCheckMockObject(mock, "host", "tfTestProxy", "color", "blue")(s)
+				},
+			},
+		},
+	})
+
+	if proxy.rejectedCount() != 0 {
+		t.Fatalf("the proxy rejected %d connections without the proxy credentials", proxy.rejectedCount())
+	}
+}
+
+func TestUnitProvider_proxyOnlyRoute(t *testing.T) {
+	mock := newMockApiServer(t)
+	proxy := newMockProxy(t, false, "", "")
+
+	// The server has a name that only the proxy resolves, so every connection to the server must go through the proxy
+	_, port, _ := net.SplitHostPort(mock.server.Listener.Addr().String())
+	server := "mgmt.tftest.invalid"
+	proxy.resolve(net.JoinHostPort(server, port), mock.server.Listener.Addr().String())
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      mock.providerConfig(hclArgument("server", server)) + testAccManagementHostConfig("tfTestProxyOnlyRoute", "192.0.2.1", "blue"),
+				ExpectError: regexp.MustCompile(`Connection to mgmt.tftest.invalid`),
+			},
+			{
+				Config: mock.providerConfig(hclArgument("server", server), hclArgument("proxy_host", proxy.server.URL)) + testAccManagementHostConfig("tfTestProxyOnlyRoute", "192.0.2.1", "blue"),
+				Check:  testUnitCheckMockObject(mock, "host", "tfTestProxyOnlyRoute", "color", "blue"),
+			},
+		},
+	})
+}
+
+func TestUnitProvider_httpsProxy(t *testing.T) {
+	mock := newMockApiServer(t)
+	proxy := newMockProxy(t, true, "", "")
+
+	caFile := filepath.Join(t.TempDir(), "proxy-ca.pem")
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: proxy.server.Certificate().Raw})
+	if err := ioutil.WriteFile(caFile, caPem, 0600); err != nil {
+		t.Fatal(err)
+	}
+	providerConfig := func(proxyCaFile string) string {
+		return mock.providerConfig(hclArgument("proxy_host", proxy.server.URL), hclArgument("proxy_ca_file", proxyCaFile))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				// The certificate of the proxy is not trusted without the CA of the proxy
+				Config:      providerConfig("") + testAccManagementHostConfig("tfTestHttpsProxy", "192.0.2.1", "blue"),
+				ExpectError: regexp.MustCompile(`certificate`),
+			},
+			{
+				Config: providerConfig(caFile) + testAccManagementHostConfig("tfTestHttpsProxy", "192.0.2.1", "blue"),
+				Check: func(s *terraform.State) error {
+					if proxy.connectCount() == 0 {
+						return fmt.Errorf("no connection went through the proxy")
+					}
+					return testUnitCheckMockObject(mock, "host", "tfTestHttpsProxy", "color", "blue")(s)
+				},
+			},
+		},
+	})
+}
+
+func TestUnitProvider_proxyEnvironment(t *testing.T) {
+	mock := newMockApiServer(t)
+	proxy := newMockProxy(t, false, "", "")
+
+	defer os.Setenv("HTTPS_PROXY", os.Getenv("HTTPS_PROXY"))
+	defer os.Setenv("NO_PROXY", os.Getenv("NO_PROXY"))
+	os.Setenv("HTTPS_PROXY", proxy.server.URL)
+	os.Setenv("NO_PROXY", "127.0.0.1")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testAccManagementHostConfig("tfTestNoProxy", "192.0.2.1", "blue"),
+				Check: func(s *terraform.State) error {
+					if count := proxy.connectCount(); count != 0 {
+						return fmt.Errorf("%d connections went through the proxy, expected the server to be in NO_PROXY", count)
+					}
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					os.Setenv("NO_PROXY", "")
+				},
+				Config: mock.providerConfig() + testAccManagementHostConfig("tfTestNoProxy", "192.0.2.1", "red"),
+				Check: func(s *terraform.State) error {
+					if proxy.connectCount() == 0 {
+						return fmt.Errorf("no connection went through the proxy of HTTPS_PROXY")
+					}
+					return testUnitCheckMockObject(mock, "host", "tfTestNoProxy", "color", "red")(s)
+				},
+			},
+		},
+	})
+}
+
+func TestUnitProvider_noProxy(t *testing.T) {
+	tests := []struct {
+		server   string
+		noProxy  string
+		expected bool
+	}{
+		{"mgmt.example.com", "", false},
+		{"mgmt.example.com", "*", true},
+		{"mgmt.example.com", "example.com", true},
+		{"mgmt.example.com", ".example.com", true},
+		{"mgmt.example.com", "other.com, example.com", true},
+		{"mgmt.example.com", "mgmt.example.com:443", true},
+		{"mgmt.example.com", "mgmt.example.com:8443", false},
+		{"mgmt.example.com", "ample.com", false},
+		{"192.0.2.10", "192.0.2.0/24", true},
+		{"192.0.2.10", "198.51.100.0/24", false},
+		{"192.0.2.10", "192.0.2.10", true},
+		{"192.0.2.10", "192.0.2.1", false},
+	}
+	for _, test := range tests {
+		if result := noProxy(test.server, 443, test.noProxy); result != test.expected {
+			t.Errorf("noProxy(%q, 443, %q) = %t, expected %t", test.server, test.noProxy, result, test.expected)
+		}
+	}
+}
+
+// mockProxy is a proxy that tunnels CONNECT requests, over HTTP or HTTPS, and optionally requires basic proxy
+// authentication. The addresses of resolve are known to the proxy only.
+type mockProxy struct {
+	sync.Mutex
+	server   *httptest.Server
+	auth     string
+	hosts    map[string]string
+	connects int
+	rejected int
+}
+
+func newMockProxy(t *testing.T, useTls bool, username string, password string) *mockProxy {
+	proxy := &mockProxy{hosts: make(map[string]string)}
+	if username != "" {
+		proxy.auth = "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	}
+	if useTls {
+		proxy.server = httptest.NewTLSServer(http.HandlerFunc(proxy.serveHTTP))
+	} else {
+		proxy.server = httptest.NewServer(http.HandlerFunc(proxy.serveHTTP))
+	}
+	t.Cleanup(proxy.server.Close)
+	return proxy
+}
+
+func (proxy *mockProxy) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodConnect {
+		http.Error(w, "only CONNECT is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	proxy.Lock()
+	if proxy.auth != "" && r.Header.Get("Proxy-Authorization") != proxy.auth {
+		proxy.rejected++
+		proxy.Unlock()
+		w.Header().Set("Proxy-Authenticate", "Basic")
+		http.Error(w, "proxy authentication required", http.StatusProxyAuthRequired)
+		return
+	}
+	proxy.connects++
+	addr := r.Host
+	if resolved, ok := proxy.hosts[addr]; ok {
+		addr = resolved
+	}
+	proxy.Unlock()
+
+	server, err := net.Dial("tcp", addr)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	conn, buf, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		server.Close()
+		return
+	}
+	_, _ = conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+	go tunnel(server, buf.Reader)
+	go tunnel(conn, server)
+}
+
+// resolve makes the proxy connect to addr for the CONNECT requests of host.
+func (proxy *mockProxy) resolve(host string, addr string) {
+	proxy.Lock()
+	defer proxy.Unlock()
+	proxy.hosts[host] = addr
+}
+
+func tunnel(dst net.Conn, src io.Reader) {
+	defer dst.Close()
+	_, _ = io.Copy(dst, src)
+}
+
+func (proxy *mockProxy) connectCount() int {
+	proxy.Lock()
+	defer proxy.Unlock()
+	return proxy.connects
+}
+
+func (proxy *mockProxy) rejectedCount() int {
+	proxy.Lock()
+	defer proxy.Unlock()
+	return proxy.rejected
+}
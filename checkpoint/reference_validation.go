@@ -0,0 +1,386 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Number of names that are looked up in one show-objects filter.
+const referenceNamesBatchSize = 20
+
+// Maximal number of pages of show-objects that are read to find a name. Names that are not found within them are not
+// validated, since the object may be on a later page.
+const referencePagesLimit = 10
+
+// referenceField is an argument that refers to other objects by name or UID. A referred object must be of one of
+// types, and of none of excludedTypes. Types match by prefix, e.g. service- matches service-tcp.
+type referenceField struct {
+	name          string
+	types         []string
+	excludedTypes []string
+}
+
+var serviceTypes = []string{"service-", "application-site"}
+
+// Reference fields that are validated during plan, by resource.
+var referenceFields = map[string][]referenceField{
+	"checkpoint_management_access_rule": {
+		{name: "source", excludedTypes: serviceTypes},
+		{name: "destination", excludedTypes: serviceTypes},
+		{name: "service", types: serviceTypes},
+		{name: "time", types: []string{"time"}},
+		{name: "install_on"},
+	},
+	"checkpoint_management_nat_rule": {
+		{name: "original_source", excludedTypes: serviceTypes},
+		{name: "original_destination", excludedTypes: serviceTypes},
+		{name: "original_service", types: serviceTypes},
+		{name: "translated_source", excludedTypes: serviceTypes},
+		{name: "translated_destination", excludedTypes: serviceTypes},
+		{name: "translated_service", types: serviceTypes},
+		{name: "install_on"},
+	},
+	"checkpoint_management_threat_rule": {
+		{name: "source", excludedTypes: serviceTypes},
+		{name: "destination", excludedTypes: serviceTypes},
+		{name: "protected_scope", excludedTypes: serviceTypes},
+		{name: "service", types: serviceTypes},
+		{name: "install_on"},
+	},
+	"checkpoint_management_https_rule": {
+		{name: "source", excludedTypes: serviceTypes},
+		{name: "destination", excludedTypes: serviceTypes},
+		{name: "service", types: serviceTypes},
+		{name: "install_on"},
+	},
+	"checkpoint_management_qos_rule": {
+		{name: "source", excludedTypes: serviceTypes},
+		{name: "destination", excludedTypes: serviceTypes},
+		{name: "service", types: serviceTypes},
+		{name: "time", types: []string{"time"}},
+		{name: "install_on"},
+	},
+	"checkpoint_management_group": {
+		{name: "members", excludedTypes: serviceTypes},
+	},
+	"checkpoint_management_group_with_exclusion": {
+		{name: "include", excludedTypes: serviceTypes},
+		{name: "except", excludedTypes: serviceTypes},
+	},
+	"checkpoint_management_service_group": {
+		{name: "members", types: serviceTypes},
+	},
+	"checkpoint_management_time_group": {
+		{name: "members", types: []string{"time"}},
+	},
+}
+
+// Values of reference fields that are not objects.
+var referenceKeywords = map[string]bool{
+	"Any":            true,
+	"Original":       true,
+	"Policy Targets": true,
+}
+
+// referenceValidator resolves the references of a plan with show-objects. Objects that are created or renamed in
+// the same plan are known by their planned name. Names that could not be looked up are skipped.
+type referenceValidator struct {
+	sync.Mutex
+	client  *checkpoint.ApiClient
+	objects map[string]string
+	planned map[string]string
+	skipped map[string]bool
+}
+
+var referenceValidators = struct {
+	sync.Mutex
+	byClient map[*checkpoint.ApiClient]*referenceValidator
+}{byClient: make(map[*checkpoint.ApiClient]*referenceValidator)}
+
+func registerReferenceValidation(client *checkpoint.ApiClient) {
+	referenceValidators.Lock()
+	referenceValidators.byClient[client] = &referenceValidator{
+		client:  client,
+		objects: make(map[string]string),
+		planned: make(map[string]string),
+		skipped: make(map[string]bool),
+	}
+	referenceValidators.Unlock()
+}
+
+func referenceValidationEnabled(client *checkpoint.ApiClient) bool {
+	referenceValidators.Lock()
+	defer referenceValidators.Unlock()
+	return referenceValidators.byClient[client] != nil
+}
+
+// validateReferencesAtPlan adds a plan step to every management resource. Resources with reference fields fail the
+// plan when a reference is to an object that does not exist or is of the wrong type. Other resources record the name
+// of the object they create.
+func validateReferencesAtPlan(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if !strings.HasPrefix(name, "checkpoint_management_") || r.Schema["name"] == nil {
+			continue
+		}
+		r.CustomizeDiff = customizeDiffReferences(name, r.CustomizeDiff)
+	}
+}
+
+func customizeDiffReferences(resourceType string, customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	objectType := strings.ReplaceAll(strings.TrimPrefix(resourceType, "checkpoint_management_"), "_", "-")
+	fields := referenceFields[resourceType]
+
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, m); err != nil {
+				return err
+			}
+		}
+
+		client, ok := m.(*checkpoint.ApiClient)
+		if !ok {
+			return nil
+		}
+		referenceValidators.Lock()
+		v := referenceValidators.byClient[client]
+		referenceValidators.Unlock()
+		if v == nil {
+			return nil
+		}
+
+		if name, ok := d.Get("name").(string); ok && name != "" && d.NewValueKnown("name") && d.HasChange("name") {
+			v.plan(name, objectType)
+		}
+
+		var problems []string
+		for _, field := range fields {
+			if !d.NewValueKnown(field.name) || !d.HasChange(field.name) {
+				continue
+			}
+			fieldProblems, err := v.validate(field, referenceValues(d, field.name))
+			if err != nil {
+				return err
+			}
+			problems = append(problems, fieldProblems...)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("invalid references in %s:\n%s", resourceType, strings.Join(problems, "\n"))
+		}
+		return nil
+	}
+}
+
+// referenceValues returns the known values of a string, list or set argument. The SDK plans a set that has an unknown
+// value as unknown as a whole, so only the values of lists are checked one by one. Values that are unknown during
+// plan, e.g. the UID of an object that is not created yet, are found by the SDK and not by their placeholder, which
+// is internal to the SDK.
+func referenceValues(d *schema.ResourceDiff, key string) []string {
+	var items []interface{}
+	switch value := d.Get(key).(type) {
+	case string:
+		items = []interface{}{value}
+	case []interface{}:
+		for i, item := range value {
+			if d.NewValueKnown(fmt.Sprintf("%s.%d", key, i)) {
+				items = append(items, item)
+			}
+		}
+	case *schema.Set:
+		items = value.List()
+	}
+
+	var values []string
+	for _, item := range items {
+		if s, ok := item.(string); ok && s != "" && !referenceKeywords[s] {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+func (v *referenceValidator) plan(name string, objectType string) {
+	v.Lock()
+	defer v.Unlock()
+	v.planned[name] = objectType
+}
+
+// validate returns a problem for every value that is not an object of the types of field.
+func (v *referenceValidator) validate(field referenceField, values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	v.Lock()
+	defer v.Unlock()
+
+	if err := v.resolve(values); err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	for _, value := range values {
+		if v.skipped[value] {
+			continue
+		}
+		objectType, ok := v.objects[value]
+		if !ok {
+			objectType, ok = v.planned[value]
+		}
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: object %q not found", field.name, value))
+			continue
+		}
+		if !referenceTypeMatches(objectType, field) {
+			problems = append(problems, fmt.Sprintf("%s: object %q is of type %s", field.name, value, objectType))
+		}
+	}
+	return problems, nil
+}
+
+// resolve looks up the values that were not resolved yet. UIDs are looked up in one show-objects request. Names are
+// looked up in batches by a filter that matches any of them, and the names that the batch did not find are looked up
+// one by one before they are reported missing.
+func (v *referenceValidator) resolve(values []string) error {
+	var uids, names []string
+	for _, value := range values {
+		if _, ok := v.objects[value]; ok {
+			continue
+		}
+		if _, ok := v.planned[value]; ok {
+			continue
+		}
+		if v.skipped[value] {
+			continue
+		}
+		if uidRegexp.MatchString(value) {
+			uids = append(uids, value)
+		} else {
+			names = append(names, value)
+		}
+	}
+	sort.Strings(names)
+
+	if len(uids) > 0 {
+		objects, _, err := v.showObjects(map[string]interface{}{"uids": uids, "limit": len(uids)})
+		if err != nil {
+			return err
+		}
+		for _, obj := range objects {
+			uid, _ := obj["uid"].(string)
+			objectType, _ := obj["type"].(string)
+			v.objects[uid] = objectType
+		}
+	}
+
+	for from := 0; from < len(names); from += referenceNamesBatchSize {
+		to := from + referenceNamesBatchSize
+		if to > len(names) {
+			to = len(names)
+		}
+		var terms []string
+		for _, name := range names[from:to] {
+			// Names with quotes are looked up alone, since they cannot be quoted in the filter
+			if !strings.Contains(name, `"`) {
+				terms = append(terms, `"`+name+`"`)
+			}
+		}
+		if len(terms) < 2 {
+			continue
+		}
+		if _, err := v.findNames(strings.Join(terms, " OR "), names[from:to]); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := v.objects[name]; ok {
+			continue
+		}
+		complete, err := v.findNames(name, []string{name})
+		if err != nil {
+			return err
+		}
+		if _, ok := v.objects[name]; !ok && !complete {
+			log.Printf("[WARN] Reference %q is not validated, since more than %d objects match it", name, referencePagesLimit*objectsListPageLimit)
+			v.skipped[name] = true
+		}
+	}
+
+	return nil
+}
+
+// findNames reads the objects that match filter page by page, until the objects of all names are found. It returns
+// false if the objects were not read to the last page.
+func (v *referenceValidator) findNames(filter string, names []string) (bool, error) {
+	missing := make(map[string]bool)
+	for _, name := range names {
+		if _, ok := v.objects[name]; !ok {
+			missing[name] = true
+		}
+	}
+
+	offset := 0
+	for page := 0; page < referencePagesLimit && len(missing) > 0; page++ {
+		objects, total, err := v.showObjects(map[string]interface{}{"filter": filter, "limit": objectsListPageLimit, "offset": offset})
+		if err != nil {
+			return false, err
+		}
+		for _, obj := range objects {
+			if objName, _ := obj["name"].(string); missing[objName] {
+				objectType, _ := obj["type"].(string)
+				v.objects[objName] = objectType
+				delete(missing, objName)
+			}
+		}
+		offset += len(objects)
+		if len(objects) == 0 || offset >= total {
+			return true, nil
+		}
+	}
+	return len(missing) == 0, nil
+}
+
+// showObjects returns the objects of a show-objects request and the total number of objects that match it.
+func (v *referenceValidator) showObjects(payload map[string]interface{}) ([]map[string]interface{}, int, error) {
+	payload["details-level"] = "standard"
+	showObjectsRes, err := v.client.ApiCall("show-objects", payload, v.client.GetSessionID(), true, v.client.IsProxyUsed())
+	if err != nil {
+		return nil, 0, fmt.Errorf(err.Error())
+	}
+	if !showObjectsRes.Success {
+		return nil, 0, fmt.Errorf(showObjectsRes.ErrorMsg)
+	}
+
+	var objects []map[string]interface{}
+	if list, ok := showObjectsRes.GetData()["objects"].([]interface{}); ok {
+		for _, obj := range list {
+			if objMap, ok := obj.(map[string]interface{}); ok {
+				objects = append(objects, objMap)
+			}
+		}
+	}
+	total, _ := showObjectsRes.GetData()["total"].(float64)
+	return objects, int(total), nil
+}
+
+func referenceTypeMatches(objectType string, field referenceField) bool {
+	for _, excluded := range field.excludedTypes {
+		if strings.HasPrefix(objectType, excluded) {
+			return false
+		}
+	}
+	if len(field.types) == 0 {
+		return true
+	}
+	for _, t := range field.types {
+		if strings.HasPrefix(objectType, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestUnitProvider_validateReferences(t *testing.T) {
+	mock := newMockApiServer(t)
+	providerConfig := mock.providerConfig(hclArgument("validate_references", true))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "checkpoint_management_group" "test" {
+  name = "tfTestGroup"
+  members = ["tfTestMissingHost"]
+}
+`,
+				ExpectError: regexp.MustCompile(`members: object "tfTestMissingHost" not found`),
+			},
+			{
+				Config: providerConfig + `
+resource "checkpoint_management_host" "test" {
+  name = "tfTestHost"
+  ipv4_address = "192.0.2.1"
+}
+
+resource "checkpoint_management_group" "test" {
+  name = "tfTestGroup"
+  members = ["tfTestHost"]
+  depends_on = [checkpoint_management_host.test]
+}
+`,
+				Check: func(s *terraform.State) error {
+					if mock.callCount("add-group") != 1 {
+						return fmt.Errorf("group was not added")
+					}
+					return nil
+				},
+			},
+			{
+				Config: providerConfig + `
+resource "checkpoint_management_host" "test" {
+  name = "tfTestHost"
+  ipv4_address = "192.0.2.1"
+}
+
+resource "checkpoint_management_service_group" "test" {
+  name = "tfTestServiceGroup"
+  members = [checkpoint_management_host.test.name]
+}
+`,
+				ExpectError: regexp.MustCompile(`members: object "tfTestHost" is of type host`),
+			},
+		},
+	})
+
+	if count := mock.callCount("add-service-group"); count != 0 {
+		t.Fatalf("add-service-group was called %d times, expected validation to fail during plan", count)
+	}
+}
+
+func TestUnitProvider_validateReferencesPaging(t *testing.T) {
+	mock := newMockApiServer(t)
+	providerConfig := mock.providerConfig(hclArgument("validate_references", true))
+
+	// The objects that match the names come before them in the order of show-objects
+	sid := mock.login()["sid"].(string)
+	mock.run("add-host", map[string]interface{}{"name": "tfTestPaged", "ipv4-address": "192.0.2.1"}, sid)
+	for i := 0; i < 600; i++ {
+		mock.run("add-host", map[string]interface{}{"name": fmt.Sprintf("a_tfTestPaged_%04d", i), "ipv4-address": "192.0.2.2"}, sid)
+	}
+	for i := 0; i <= referencePagesLimit*objectsListPageLimit; i++ {
+		mock.run("add-host", map[string]interface{}{"name": fmt.Sprintf("a_tfTestTruncated_%04d", i), "ipv4-address": "192.0.2.3"}, sid)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				// tfTestPaged is found on the second page, and tfTestTruncated is not validated
+				Config: providerConfig + `
+resource "checkpoint_management_group" "test" {
+  name = "tfTestGroup"
+  members = ["tfTestPaged", "tfTestTruncated"]
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: providerConfig + `
+resource "checkpoint_management_group" "test" {
+  name = "tfTestGroup"
+  members = ["tfTestPaged", "tfTestPagedMissing"]
+}
+`,
+				ExpectError: regexp.MustCompile(`members: object "tfTestPagedMissing" not found`),
+			},
+		},
+	})
+}
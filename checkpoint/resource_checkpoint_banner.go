@@ -0,0 +1,87 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBanner() *schema.Resource {
+	return &schema.Resource{
+		Create: createBanner,
+		Read:   readBanner,
+		Update: updateBanner,
+		Delete: deleteBanner,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Banner message, that is shown before login.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Show the banner message.",
+			},
+		},
+	}
+}
+
+func bannerParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	bannerMap := make(map[string]interface{})
+
+	if v, ok := d.GetOk("message"); ok {
+		bannerMap["message"] = v.(string)
+	}
+	bannerMap["enabled"] = d.Get("enabled").(bool)
+
+	return bannerMap
+}
+
+func createBanner(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-banner", bannerParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	// The banner is a single setting of the machine
+	d.SetId("banner")
+
+	return readBanner(d, m)
+}
+
+func readBanner(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	bannerJson, err := showGaiaObject(client, gaiaTarget(d), "show-banner", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if bannerJson == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("message", bannerJson["message"])
+	if v, ok := bannerJson["enabled"].(bool); ok {
+		_ = d.Set("enabled", v)
+	}
+
+	return nil
+}
+
+func updateBanner(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-banner", bannerParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readBanner(d, m)
+}
+
+func deleteBanner(d *schema.ResourceData, m interface{}) error {
+	d.SetId("") // Destroy resource
+	return nil
+}
@@ -0,0 +1,72 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointBanner_basic(t *testing.T) {
+	resourceName := "checkpoint_banner.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBannerConfig("Authorized use only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "message", "Authorized use only"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointBanner_basic(t *testing.T) {
+	resourceName := "checkpoint_banner.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccBannerConfig("Authorized use only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "banner"),
+					resource.TestCheckResourceAttr(resourceName, "message", "Authorized use only"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccBannerConfig("Terraform managed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "message", "Terraform managed"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccBannerConfig("Terraform managed"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "banner",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBannerConfig(message string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_banner" "test" {
+    message = "%s"
+    enabled = true
+}
+`, message)
+}
@@ -0,0 +1,191 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBondInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: createBondInterface,
+		Read:   readBondInterface,
+		Update: updateBondInterface,
+		Delete: deleteBondInterface,
+		Importer: &schema.ResourceImporter{
+			State: importStateByField("name"),
+		},
+		Schema: gaiaInterfaceSchema(map[string]*schema.Schema{
+			"bond_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Bond ID, the number in the name of the interface.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Interface name, bond<bond_id>.",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Names of the slave interfaces of the bond.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Bond operating mode, round-robin, active-backup, xor or 8023AD.",
+				ValidateFunc: validateStringValue("round-robin", "active-backup", "xor", "8023AD"),
+			},
+			"primary": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The preferred member of an active-backup bond.",
+			},
+			"mii_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Media monitoring interval in milliseconds.",
+			},
+			"down_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time in milliseconds to wait before a member that went down is disabled.",
+			},
+			"up_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time in milliseconds to wait before a member that came up is enabled.",
+			},
+			"lacp_rate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "LACPDU packet transmission rate of an 8023AD bond, slow or fast.",
+				ValidateFunc: validateStringValue("slow", "fast"),
+			},
+			"xmit_hash_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Transmit hash policy of a xor or 8023AD bond, layer2 or layer3+4.",
+				ValidateFunc: validateStringValue("layer2", "layer3+4"),
+			},
+		}),
+	}
+}
+
+func bondInterfaceParseSchemaToMap(d *schema.ResourceData, payload map[string]interface{}, update bool) {
+	if !update || d.HasChange("members") {
+		payload["members"] = d.Get("members").(*schema.Set).List()
+	}
+	for field, arg := range map[string]string{
+		"mode":             "mode",
+		"primary":          "primary",
+		"lacp-rate":        "lacp_rate",
+		"xmit-hash-policy": "xmit_hash_policy",
+	} {
+		if v, ok := d.GetOk(arg); ok && (!update || d.HasChange(arg)) {
+			payload[field] = v.(string)
+		}
+	}
+	for field, arg := range map[string]string{
+		"mii-interval": "mii_interval",
+		"down-delay":   "down_delay",
+		"up-delay":     "up_delay",
+	} {
+		if v, ok := d.GetOkExists(arg); ok && (!update || d.HasChange(arg)) {
+			payload[field] = v.(int)
+		}
+	}
+	gaiaInterfaceParseSchemaToMap(d, payload, update)
+}
+
+func createBondInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"id": d.Get("bond_id").(int),
+	}
+	bondInterfaceParseSchemaToMap(d, payload, false)
+
+	addBondInterfaceRes, err := callGaiaApi(client, gaiaTarget(d), "add-bond-interface", payload)
+	if err != nil {
+		return err
+	}
+
+	name, _ := addBondInterfaceRes["name"].(string)
+	if name == "" {
+		name = fmt.Sprintf("bond%d", d.Get("bond_id").(int))
+	}
+	_ = d.Set("name", name)
+	d.SetId(name)
+
+	return readBondInterface(d, m)
+}
+
+func readBondInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	bondInterfaceJson, err := showGaiaObject(client, gaiaTarget(d), "show-bond-interface", map[string]interface{}{"name": d.Get("name")})
+	if err != nil {
+		return err
+	}
+	if bondInterfaceJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("name", bondInterfaceJson["name"])
+	if v := bondInterfaceJson["id"]; v != nil {
+		_ = d.Set("bond_id", gaiaInt(v))
+	}
+	if v, ok := bondInterfaceJson["members"].([]interface{}); ok {
+		_ = d.Set("members", v)
+	}
+	_ = d.Set("mode", bondInterfaceJson["mode"])
+	_ = d.Set("primary", bondInterfaceJson["primary"])
+	_ = d.Set("lacp_rate", bondInterfaceJson["lacp-rate"])
+	_ = d.Set("xmit_hash_policy", bondInterfaceJson["xmit-hash-policy"])
+	if v := bondInterfaceJson["mii-interval"]; v != nil {
+		_ = d.Set("mii_interval", gaiaInt(v))
+	}
+	if v := bondInterfaceJson["down-delay"]; v != nil {
+		_ = d.Set("down_delay", gaiaInt(v))
+	}
+	if v := bondInterfaceJson["up-delay"]; v != nil {
+		_ = d.Set("up_delay", gaiaInt(v))
+	}
+	readGaiaInterface(d, bondInterfaceJson)
+
+	return nil
+}
+
+func updateBondInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	bondInterfaceParseSchemaToMap(d, payload, true)
+
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-bond-interface", payload); err != nil {
+		return err
+	}
+	return readBondInterface(d, m)
+}
+
+func deleteBondInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-bond-interface", map[string]interface{}{"name": d.Get("name")}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,84 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointBondInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_bond_interface.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBondInterfaceConfig("active-backup", "eth2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "bond10"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "active-backup"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointBondInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_bond_interface.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "bond-interface", "bond10")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccBondInterfaceConfig("active-backup", "eth2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "bond10"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "active-backup"),
+					resource.TestCheckResourceAttr(resourceName, "primary", "eth2"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccBondInterfaceConfig("active-backup", "eth3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "primary", "eth3"),
+					testUnitCheckGaiaObject(mock, "bond-interface", "bond10", "primary", "eth3"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccBondInterfaceConfig("active-backup", "eth3"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBondInterfaceConfig(mode string, primary string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_bond_interface" "test" {
+    bond_id = 10
+    members = ["eth2", "eth3"]
+    mode = "%s"
+    primary = "%s"
+    mii_interval = 100
+    ipv4_address = "198.51.100.10"
+    ipv4_mask_length = 24
+}
+`, mode, primary)
+}
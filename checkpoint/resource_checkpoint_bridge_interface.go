@@ -0,0 +1,111 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceBridgeInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: createBridgeInterface,
+		Read:   readBridgeInterface,
+		Update: updateBridgeInterface,
+		Delete: deleteBridgeInterface,
+		Importer: &schema.ResourceImporter{
+			State: importStateByField("name"),
+		},
+		Schema: gaiaInterfaceSchema(map[string]*schema.Schema{
+			"bridge_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Bridge ID, the number in the name of the interface.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Interface name, br<bridge_id>.",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Names of the interfaces of the bridge.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+func createBridgeInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"id":      d.Get("bridge_id").(int),
+		"members": d.Get("members").(*schema.Set).List(),
+	}
+	gaiaInterfaceParseSchemaToMap(d, payload, false)
+
+	addBridgeInterfaceRes, err := callGaiaApi(client, gaiaTarget(d), "add-bridge-interface", payload)
+	if err != nil {
+		return err
+	}
+
+	name, _ := addBridgeInterfaceRes["name"].(string)
+	if name == "" {
+		name = fmt.Sprintf("br%d", d.Get("bridge_id").(int))
+	}
+	_ = d.Set("name", name)
+	d.SetId(name)
+
+	return readBridgeInterface(d, m)
+}
+
+func readBridgeInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	bridgeInterfaceJson, err := showGaiaObject(client, gaiaTarget(d), "show-bridge-interface", map[string]interface{}{"name": d.Get("name")})
+	if err != nil {
+		return err
+	}
+	if bridgeInterfaceJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("name", bridgeInterfaceJson["name"])
+	if v := bridgeInterfaceJson["id"]; v != nil {
+		_ = d.Set("bridge_id", gaiaInt(v))
+	}
+	if v, ok := bridgeInterfaceJson["members"].([]interface{}); ok {
+		_ = d.Set("members", v)
+	}
+	readGaiaInterface(d, bridgeInterfaceJson)
+
+	return nil
+}
+
+func updateBridgeInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	if d.HasChange("members") {
+		payload["members"] = d.Get("members").(*schema.Set).List()
+	}
+	gaiaInterfaceParseSchemaToMap(d, payload, true)
+
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-bridge-interface", payload); err != nil {
+		return err
+	}
+	return readBridgeInterface(d, m)
+}
+
+func deleteBridgeInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-bridge-interface", map[string]interface{}{"name": d.Get("name")}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,77 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointBridgeInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_bridge_interface.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBridgeInterfaceConfig("terratest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "br10"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointBridgeInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_bridge_interface.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "bridge-interface", "br10")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccBridgeInterfaceConfig("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "br10"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "comments", "first"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccBridgeInterfaceConfig("second"),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckGaiaObject(mock, "bridge-interface", "br10", "comments", "second"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccBridgeInterfaceConfig("second"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBridgeInterfaceConfig(comments string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_bridge_interface" "test" {
+    bridge_id = 10
+    members = ["eth4", "eth5"]
+    comments = "%s"
+}
+`, comments)
+}
@@ -0,0 +1,95 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceDns() *schema.Resource {
+	return &schema.Resource{
+		Create: createDns,
+		Read:   readDns,
+		Update: updateDns,
+		Delete: deleteDns,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"primary": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The primary DNS server IPv4 or IPv6 address.",
+			},
+			"secondary": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The secondary DNS server IPv4 or IPv6 address.",
+			},
+			"tertiary": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The tertiary DNS server IPv4 or IPv6 address.",
+			},
+			"suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The DNS suffix, that is added to host names that are not fully qualified.",
+			},
+		},
+	}
+}
+
+func dnsParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	dnsMap := make(map[string]interface{})
+
+	dnsMap["primary"] = d.Get("primary").(string)
+	dnsMap["secondary"] = d.Get("secondary").(string)
+	dnsMap["tertiary"] = d.Get("tertiary").(string)
+	dnsMap["suffix"] = d.Get("suffix").(string)
+
+	return dnsMap
+}
+
+func createDns(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-dns", dnsParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	// The DNS settings are a single object of the machine
+	d.SetId("dns")
+
+	return readDns(d, m)
+}
+
+func readDns(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	dnsJson, err := showGaiaObject(client, gaiaTarget(d), "show-dns", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if dnsJson == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("primary", dnsJson["primary"])
+	_ = d.Set("secondary", dnsJson["secondary"])
+	_ = d.Set("tertiary", dnsJson["tertiary"])
+	_ = d.Set("suffix", dnsJson["suffix"])
+
+	return nil
+}
+
+func updateDns(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-dns", dnsParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readDns(d, m)
+}
+
+func deleteDns(d *schema.ResourceData, m interface{}) error {
+	d.SetId("") // Destroy resource
+	return nil
+}
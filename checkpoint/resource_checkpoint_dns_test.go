@@ -0,0 +1,154 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccCheckpointDns_basic(t *testing.T) {
+	resourceName := "checkpoint_dns.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsConfig("8.8.8.8", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "primary", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resourceName, "suffix", "example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointDns_basic(t *testing.T) {
+	resourceName := "checkpoint_dns.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccDnsConfig("192.0.2.53", "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "dns"),
+					resource.TestCheckResourceAttr(resourceName, "primary", "192.0.2.53"),
+					resource.TestCheckResourceAttr(resourceName, "secondary", "8.8.4.4"),
+					resource.TestCheckResourceAttr(resourceName, "suffix", "example.com"),
+				),
+			},
+			{
+				// A change on the machine is detected
+				PreConfig: func() {
+					mock.setGaiaSetting("dns", "primary", "198.51.100.53")
+				},
+				Config:             mock.gaiaProviderConfig() + testAccDnsConfig("192.0.2.53", "example.com"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccDnsConfig("192.0.2.53", "example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "primary", "192.0.2.53"),
+					resource.TestCheckResourceAttr(resourceName, "suffix", "example.org"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccDnsConfig("192.0.2.53", "example.org"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "dns",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointDns_target(t *testing.T) {
+	mock := newMockApiServer(t)
+	login := mock.login()
+	mock.run("add-simple-gateway", map[string]interface{}{"name": "gw1", "ipv4-address": "192.0.2.1"}, login["sid"].(string))
+	mock.run("add-simple-gateway", map[string]interface{}{"name": "gw2", "ipv4-address": "192.0.2.2"}, login["sid"].(string))
+	mock.run("publish", map[string]interface{}{}, login["sid"].(string))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testUnitDnsTargetConfig("gw1", "192.0.2.53") + testUnitDnsTargetConfig("gw2", "198.51.100.53"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("checkpoint_dns.gw1", "id", "target=gw1;dns"),
+					resource.TestCheckResourceAttr("checkpoint_dns.gw1", "target", "gw1"),
+					resource.TestCheckResourceAttr("checkpoint_dns.gw1", "primary", "192.0.2.53"),
+					resource.TestCheckResourceAttr("checkpoint_dns.gw2", "primary", "198.51.100.53"),
+					testUnitCheckGaiaSetting(mock, mockGaiaKey("gw1", "dns"), "primary", "192.0.2.53"),
+					testUnitCheckGaiaSetting(mock, mockGaiaKey("gw2", "dns"), "primary", "198.51.100.53"),
+					testUnitCheckGaiaSetting(mock, "dns", "primary", nil),
+					testUnitCheckCallCount(mock, "gaia-api/set-dns", 2),
+				),
+			},
+			{
+				// A change on the gateway is detected
+				PreConfig: func() {
+					mock.setGaiaSetting(mockGaiaKey("gw2", "dns"), "primary", "203.0.113.53")
+				},
+				Config:             mock.providerConfig() + testUnitDnsTargetConfig("gw1", "192.0.2.53") + testUnitDnsTargetConfig("gw2", "198.51.100.53"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:            mock.providerConfig() + testUnitDnsTargetConfig("gw1", "192.0.2.53") + testUnitDnsTargetConfig("gw2", "198.51.100.53"),
+				ResourceName:      "checkpoint_dns.gw1",
+				ImportState:       true,
+				ImportStateId:     "target=gw1;dns",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointDns_targetRequiresWebApi(t *testing.T) {
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      mock.gaiaProviderConfig() + testUnitDnsTargetConfig("gw1", "192.0.2.53"),
+				ExpectError: regexp.MustCompile("target gw1 can be used only with web_api context"),
+			},
+		},
+	})
+}
+
+func testAccDnsConfig(primary string, suffix string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_dns" "test" {
+    primary = "%s"
+    secondary = "8.8.4.4"
+    suffix = "%s"
+}
+`, primary, suffix)
+}
+
+func testUnitDnsTargetConfig(target string, primary string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_dns" "%s" {
+    target = "%s"
+    primary = "%s"
+    secondary = "8.8.4.4"
+    suffix = "example.com"
+}
+`, target, target, primary)
+}
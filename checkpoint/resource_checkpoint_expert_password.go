@@ -0,0 +1,78 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceExpertPassword sets the password of the expert mode. The machine does not return the password, so a change
+// of the password outside of Terraform is not detected.
+func resourceExpertPassword() *schema.Resource {
+	return &schema.Resource{
+		Create: createExpertPassword,
+		Read:   readExpertPassword,
+		Update: updateExpertPassword,
+		Delete: deleteExpertPassword,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Expert mode password.",
+				ConflictsWith: []string{"password_hash"},
+			},
+			"password_hash": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Hash of the expert mode password, instead of password.",
+				ConflictsWith: []string{"password"},
+			},
+		},
+	}
+}
+
+func expertPasswordParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	expertPasswordMap := make(map[string]interface{})
+
+	if v, ok := d.GetOk("password"); ok {
+		expertPasswordMap["password"] = v.(string)
+	}
+	if v, ok := d.GetOk("password_hash"); ok {
+		expertPasswordMap["password-hash"] = v.(string)
+	}
+
+	return expertPasswordMap
+}
+
+func createExpertPassword(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-expert-password", expertPasswordParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	// The expert password is a single setting of the machine
+	d.SetId("expert-password")
+
+	return readExpertPassword(d, m)
+}
+
+func readExpertPassword(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func updateExpertPassword(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-expert-password", expertPasswordParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readExpertPassword(d, m)
+}
+
+func deleteExpertPassword(d *schema.ResourceData, m interface{}) error {
+	d.SetId("") // Destroy resource
+	return nil
+}
@@ -0,0 +1,73 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointExpertPassword_basic(t *testing.T) {
+	resourceName := "checkpoint_expert_password.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExpertPasswordConfig("Terra-Test-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "expert-password"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointExpertPassword_basic(t *testing.T) {
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccExpertPasswordConfig("Terra-Test-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckGaiaSetting(mock, "expert-password", "password", "Terra-Test-1"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccExpertPasswordConfig("Terra-Test-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckGaiaSetting(mock, "expert-password", "password", "Terra-Test-2"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitCheckGaiaSetting(mock *mockApiServer, setting string, field string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mock.Lock()
+		defer mock.Unlock()
+		if v := mock.gaiaSettings[setting][field]; fmt.Sprint(v) != fmt.Sprint(value) {
+			return fmt.Errorf("%s of %s is %v, expected %v", field, setting, v, value)
+		}
+		return nil
+	}
+}
+
+func testAccExpertPasswordConfig(password string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_expert_password" "test" {
+    password = "%s"
+}
+`, password)
+}
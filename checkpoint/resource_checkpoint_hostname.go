@@ -1,7 +1,6 @@
 package checkpoint
 
 import (
-	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -38,13 +37,17 @@ func hostnameParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
 func createHostname(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 	payload := hostnameParseSchemaToMap(d)
-	setPIRes, _ := client.ApiCall("set-hostname", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !setPIRes.Success {
-		return fmt.Errorf(setPIRes.ErrorMsg)
+	hostnameJson, err := callGaiaApi(client, gaiaTarget(d), "set-hostname", payload)
+	if err != nil {
+		return err
 	}
 
-	// Set Schema UID = Object key
-	d.SetId(setPIRes.GetData()["name"].(string))
+	// Set Schema UID = Object key. The reply of a target gateway may not include the name
+	if name, ok := hostnameJson["name"].(string); ok && name != "" {
+		d.SetId(name)
+	} else {
+		d.SetId(d.Get("name").(string))
+	}
 
 	return readHostname(d, m)
 }
@@ -52,18 +55,19 @@ func createHostname(d *schema.ResourceData, m interface{}) error {
 func readHostname(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 	payload := map[string]interface{}{}
-	showHostnameRes, _ := client.ApiCall("show-hostname", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !showHostnameRes.Success {
-		// Handle deletion of an object from other clients - Object not found
-		if objectNotFound(showHostnameRes.GetData()["code"].(string)) {
-			d.SetId("") // Destroy resource
-			return nil
-		}
-		return fmt.Errorf(showHostnameRes.ErrorMsg)
+	hostnameJson, err := showGaiaObject(client, gaiaTarget(d), "show-hostname", payload)
+	if err != nil {
+		return err
+	}
+	// Handle deletion of an object from other clients - Object not found
+	if hostnameJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
 	}
-	hostnameJson := showHostnameRes.GetData()
 
-	_ = d.Set("name", hostnameJson["name"].(string))
+	if v := hostnameJson["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
 
 	return nil
 }
@@ -71,9 +75,8 @@ func readHostname(d *schema.ResourceData, m interface{}) error {
 func updateHostname(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 	payload := hostnameParseSchemaToMap(d)
-	setNetworkRes, _ := client.ApiCall("set-hostname", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !setNetworkRes.Success {
-		return fmt.Errorf(setNetworkRes.ErrorMsg)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-hostname", payload); err != nil {
+		return err
 	}
 	return readHostname(d, m)
 }
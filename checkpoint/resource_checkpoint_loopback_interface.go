@@ -0,0 +1,87 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceLoopbackInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: createLoopbackInterface,
+		Read:   readLoopbackInterface,
+		Update: updateLoopbackInterface,
+		Delete: deleteLoopbackInterface,
+		Importer: &schema.ResourceImporter{
+			State: importStateByField("name"),
+		},
+		Schema: gaiaInterfaceSchema(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Interface name, that is given by the machine, e.g. loop00.",
+			},
+		}),
+	}
+}
+
+func createLoopbackInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := make(map[string]interface{})
+	gaiaInterfaceParseSchemaToMap(d, payload, false)
+
+	addLoopbackInterfaceRes, err := callGaiaApi(client, gaiaTarget(d), "add-loopback-interface", payload)
+	if err != nil {
+		return err
+	}
+
+	name, _ := addLoopbackInterfaceRes["name"].(string)
+	if name == "" {
+		return fmt.Errorf("add-loopback-interface did not return the name of the interface")
+	}
+	_ = d.Set("name", name)
+	d.SetId(name)
+
+	return readLoopbackInterface(d, m)
+}
+
+func readLoopbackInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	loopbackInterfaceJson, err := showGaiaObject(client, gaiaTarget(d), "show-loopback-interface", map[string]interface{}{"name": d.Get("name")})
+	if err != nil {
+		return err
+	}
+	if loopbackInterfaceJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("name", loopbackInterfaceJson["name"])
+	readGaiaInterface(d, loopbackInterfaceJson)
+
+	return nil
+}
+
+func updateLoopbackInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	gaiaInterfaceParseSchemaToMap(d, payload, true)
+
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-loopback-interface", payload); err != nil {
+		return err
+	}
+	return readLoopbackInterface(d, m)
+}
+
+func deleteLoopbackInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-loopback-interface", map[string]interface{}{"name": d.Get("name")}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
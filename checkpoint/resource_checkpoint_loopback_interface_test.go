@@ -0,0 +1,77 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointLoopbackInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_loopback_interface.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoopbackInterfaceConfig("198.51.100.100"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_address", "198.51.100.100"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointLoopbackInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_loopback_interface.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "loopback-interface", "loop00")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccLoopbackInterfaceConfig("198.51.100.100"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "loop00"),
+					resource.TestCheckResourceAttr(resourceName, "name", "loop00"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_address", "198.51.100.100"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccLoopbackInterfaceConfig("198.51.100.101"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "loop00"),
+					testUnitCheckGaiaObject(mock, "loopback-interface", "loop00", "ipv4-address", "198.51.100.101"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccLoopbackInterfaceConfig("198.51.100.101"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLoopbackInterfaceConfig(address string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_loopback_interface" "test" {
+    ipv4_address = "%s"
+    ipv4_mask_length = 32
+}
+`, address)
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func resourceManagementAccessLayer() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementAccessLayer,
 		Read:   readManagementAccessLayer,
 		Update: updateManagementAccessLayer,
@@ -101,7 +101,9 @@ func resourceManagementAccessLayer() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "", map[string]string{
+		"content_awareness": "1.1",
+	})
 }
 
 func createManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
@@ -165,7 +167,7 @@ func createManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
 		accessLayer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create AccessLayer - Map = ", accessLayer)
+	log.Println("Create AccessLayer - Map = ", redactPayload(d, accessLayer))
 
 	addAccessLayerRes, err := client.ApiCall("add-access-layer", accessLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessLayerRes.Success {
@@ -202,7 +204,7 @@ func readManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
 
 	accessLayer := showAccessLayerRes.GetData()
 
-	log.Println("Read AccessLayer - Show JSON = ", accessLayer)
+	log.Println("Read AccessLayer - Show JSON = ", redactPayload(d, accessLayer))
 
 	if v := accessLayer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -338,7 +340,7 @@ func updateManagementAccessLayer(d *schema.ResourceData, m interface{}) error {
 		accessLayer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update AccessLayer - Map = ", accessLayer)
+	log.Println("Update AccessLayer - Map = ", redactPayload(d, accessLayer))
 
 	updateAccessLayerRes, err := client.ApiCall("set-access-layer", accessLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAccessLayerRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementAccessPointName() *schema.Resource {
 		Read:   readManagementAccessPointName,
 		Update: updateManagementAccessPointName,
 		Delete: deleteManagementAccessPointName,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -131,7 +134,7 @@ func createManagementAccessPointName(d *schema.ResourceData, m interface{}) erro
 		accessPointName["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create AccessPointName - Map = ", accessPointName)
+	log.Println("Create AccessPointName - Map = ", redactPayload(d, accessPointName))
 
 	addAccessPointNameRes, err := client.ApiCall("add-access-point-name", accessPointName, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessPointNameRes.Success {
@@ -168,7 +171,7 @@ func readManagementAccessPointName(d *schema.ResourceData, m interface{}) error
 
 	accessPointName := showAccessPointNameRes.GetData()
 
-	log.Println("Read AccessPointName - Show JSON = ", accessPointName)
+	log.Println("Read AccessPointName - Show JSON = ", redactPayload(d, accessPointName))
 
 	if v := accessPointName["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -279,7 +282,7 @@ func updateManagementAccessPointName(d *schema.ResourceData, m interface{}) erro
 		accessPointName["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update AccessPointName - Map = ", accessPointName)
+	log.Println("Update AccessPointName - Map = ", redactPayload(d, accessPointName))
 
 	updateAccessPointNameRes, err := client.ApiCall("set-access-point-name", accessPointName, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAccessPointNameRes.Success {
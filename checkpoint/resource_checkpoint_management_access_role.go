@@ -231,7 +231,7 @@ func createManagementAccessRole(d *schema.ResourceData, m interface{}) error {
 		accessRole["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create AccessRole - Map = ", accessRole)
+	log.Println("Create AccessRole - Map = ", redactPayload(d, accessRole))
 
 	addAccessRoleRes, err := client.ApiCall("add-access-role", accessRole, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessRoleRes.Success {
@@ -269,7 +269,7 @@ func readManagementAccessRole(d *schema.ResourceData, m interface{}) error {
 
 	TypeToSource := getTypeToSource()
 
-	log.Println("Read AccessRole - Show JSON = ", accessRole)
+	log.Println("Read AccessRole - Show JSON = ", redactPayload(d, accessRole))
 
 	if v := accessRole["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -547,7 +547,7 @@ func updateManagementAccessRole(d *schema.ResourceData, m interface{}) error {
 		accessRole["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update AccessRole - Map = ", accessRole)
+	log.Println("Update AccessRole - Map = ", redactPayload(d, accessRole))
 
 	updateAccessRoleRes, err := client.ApiCall("set-access-role", accessRole, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAccessRoleRes.Success {
@@ -11,21 +11,13 @@ import (
 )
 
 func resourceManagementAccessRule() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementAccessRule,
 		Read:   readManagementAccessRule,
 		Update: updateManagementAccessRule,
 		Delete: deleteManagementAccessRule,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				arr := strings.Split(d.Id(), ";")
-				if len(arr) != 2 {
-					return nil, fmt.Errorf("invalid unique identifier format. UID format: <LAYER_IDENTIFIER>;<RULE_UID>")
-				}
-				_ = d.Set("layer", arr[0])
-				d.SetId(arr[1])
-				return []*schema.ResourceData{d}, nil
-			},
+			State: importStateCompositeId("<LAYER_IDENTIFIER>;<RULE_UID>", "layer"),
 		},
 		Schema: map[string]*schema.Schema{
 			"layer": &schema.Schema{
@@ -349,7 +341,11 @@ func resourceManagementAccessRule() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "", map[string]string{
+		"content":           "1.1",
+		"content_direction": "1.1",
+		"content_negate":    "1.1",
+	})
 }
 
 func createManagementAccessRule(d *schema.ResourceData, m interface{}) error {
@@ -540,7 +536,7 @@ func createManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 		accessRule["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Access Rule - Map = ", accessRule)
+	log.Println("Create Access Rule - Map = ", redactPayload(d, accessRule))
 
 	addAccessRuleRes, err := client.ApiCall("add-access-rule", accessRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessRuleRes.Success {
@@ -552,6 +548,8 @@ func createManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(addAccessRuleRes.GetData()["uid"].(string))
 
+	invalidateRulebaseCache(client, "show-access-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
 	return readManagementAccessRule(d, m)
 }
 
@@ -579,7 +577,11 @@ func readManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 
 	accessRule := showAccessRuleRes.GetData()
 
-	log.Println("Read Access Rule - Show JSON = ", accessRule)
+	log.Println("Read Access Rule - Show JSON = ", redactPayload(d, accessRule))
+
+	if err := readRulePosition(client, "show-access-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)), d); err != nil {
+		return err
+	}
 
 	if v := accessRule["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1105,7 +1107,7 @@ func updateManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 		accessRule["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Access Rule - Map = ", accessRule)
+	log.Println("Update Access Rule - Map = ", redactPayload(d, accessRule))
 
 	if len(accessRule) != 4 {
 		updateAccessRuleRes, err := client.ApiCall("set-access-rule", accessRule, client.GetSessionID(), true, client.IsProxyUsed())
@@ -1121,6 +1123,8 @@ func updateManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 		log.Println("Got empty update. Skip update API call...")
 	}
 
+	invalidateRulebaseCache(client, "show-access-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
 	return readManagementAccessRule(d, m)
 }
 
@@ -1140,6 +1144,7 @@ func deleteManagementAccessRule(d *schema.ResourceData, m interface{}) error {
 		}
 		return fmt.Errorf(err.Error())
 	}
+	invalidateRulebaseCache(client, "show-access-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
 	d.SetId("")
 
 	return nil
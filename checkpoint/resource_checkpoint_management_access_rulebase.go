@@ -0,0 +1,676 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+const (
+	accessRulebaseRuleType    = "access-rule"
+	accessRulebaseSectionType = "access-section"
+)
+
+var uidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func resourceManagementAccessRulebase() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementAccessRulebase,
+		Read:   readManagementAccessRulebase,
+		Update: updateManagementAccessRulebase,
+		Delete: deleteManagementAccessRulebase,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("layer", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"layer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Layer that the rulebase belongs to identified by the name or UID.",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ordered list of rules placed at the top of the layer, before the first section.",
+				Elem:        accessRulebaseRuleResource(),
+			},
+			"section": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ordered list of access sections and the rules they contain.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Section name.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Section unique identifier.",
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Ordered list of rules in the section.",
+							Elem:        accessRulebaseRuleResource(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func accessRulebaseRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Rule name. Must be unique within the layer.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rule unique identifier.",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "\"Accept\", \"Drop\", \"Ask\", \"Inform\", \"Reject\", \"User Auth\", \"Client Auth\", \"Apply Layer\".",
+				Default:     "Drop",
+			},
+			"inline_layer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Inline Layer identified by the name. Relevant only if \"Action\" was set to \"Apply Layer\".",
+			},
+			"source": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Collection of Network objects identified by the name. Empty means \"Any\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "True if negate is set for source.",
+				Default:     false,
+			},
+			"destination": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Collection of Network objects identified by the name. Empty means \"Any\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"destination_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "True if negate is set for destination.",
+				Default:     false,
+			},
+			"service": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Collection of Network objects identified by the name. Empty means \"Any\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "True if negate is set for service.",
+				Default:     false,
+			},
+			"install_on": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Which Gateways identified by the name to install the rule on. Empty means \"Policy Targets\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"time": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of time objects identified by the name. Empty means \"Any\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"track": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Track type, e.g. \"Log\", \"Extended Log\", \"Detailed Log\" or \"None\".",
+				Default:     "None",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable/Disable the rule.",
+				Default:     true,
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments string.",
+			},
+		},
+	}
+}
+
+// accessRulebaseEntry is a single row of a flattened rulebase, either a section title or a rule.
+type accessRulebaseEntry struct {
+	Type string
+	Uid  string
+	Name string
+	Rule map[string]interface{}
+}
+
+func createManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	layerUid, err := reconcileAccessRulebase(client, d.Get("layer").(string), flattenAccessRulebaseConfig(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(layerUid)
+
+	return readManagementAccessRulebase(d, m)
+}
+
+func readManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	layerUid, entries, err := showAccessRulebaseEntries(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if layerUid == "" {
+		// Handle delete resource from other clients
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(layerUid)
+
+	var rules []interface{}
+	var sections []interface{}
+	var currentSection map[string]interface{}
+
+	for _, entry := range entries {
+		if entry.Type == accessRulebaseSectionType {
+			currentSection = map[string]interface{}{
+				"name": entry.Name,
+				"uid":  entry.Uid,
+				"rule": []interface{}{},
+			}
+			sections = append(sections, currentSection)
+			continue
+		}
+		if currentSection == nil {
+			rules = append(rules, entry.Rule)
+		} else {
+			currentSection["rule"] = append(currentSection["rule"].([]interface{}), entry.Rule)
+		}
+	}
+
+	_ = d.Set("rule", rules)
+	_ = d.Set("section", sections)
+
+	return nil
+}
+
+func updateManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	if d.HasChange("rule") || d.HasChange("section") {
+		if _, err := reconcileAccessRulebase(client, d.Id(), flattenAccessRulebaseConfig(d)); err != nil {
+			return err
+		}
+	} else {
+		log.Println("Got empty update. Skip update API call...")
+	}
+
+	return readManagementAccessRulebase(d, m)
+}
+
+func deleteManagementAccessRulebase(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+	defer invalidateRulebaseCache(client, "show-access-rulebase", rulebaseIdentifierPayload(d.Id()))
+
+	for _, entry := range flattenAccessRulebaseConfig(d) {
+		if entry.Uid == "" {
+			continue
+		}
+		command := "delete-access-rule"
+		if entry.Type == accessRulebaseSectionType {
+			command = "delete-access-section"
+		}
+		payload := map[string]interface{}{
+			"uid":   entry.Uid,
+			"layer": d.Id(),
+		}
+		deleteRes, err := client.ApiCall(command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+		if err != nil {
+			return fmt.Errorf(err.Error())
+		}
+		if !deleteRes.Success && !objectNotFound(deleteRes.GetData()["code"].(string)) {
+			return fmt.Errorf(deleteRes.ErrorMsg)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// reconcileAccessRulebase makes the layer match the desired flattened rulebase. Existing rules and sections are matched
+// by name, whatever is not desired is deleted, and only entries outside the longest run that is already in the right
+// relative order are moved. Returns the layer UID.
+func reconcileAccessRulebase(client *checkpoint.ApiClient, layer string, desired []accessRulebaseEntry) (string, error) {
+
+	seen := make(map[string]bool)
+	for _, entry := range desired {
+		if entry.Type != accessRulebaseRuleType {
+			continue
+		}
+		if seen[entry.Name] {
+			return "", fmt.Errorf("rule name '%s' is used more than once in layer '%s'. Rule names must be unique", entry.Name, layer)
+		}
+		seen[entry.Name] = true
+	}
+
+	layerUid, current, err := showAccessRulebaseEntries(client, layer)
+	if err != nil {
+		return "", err
+	}
+	if layerUid == "" {
+		return "", fmt.Errorf("access layer '%s' not found", layer)
+	}
+	// The positions of the rules of the layer change with the rules that are moved, added or deleted, also on failure
+	defer invalidateRulebaseCache(client, "show-access-rulebase", rulebaseIdentifierPayload(layerUid))
+
+	used := make([]bool, len(current))
+	matched := make([]int, len(desired))
+	for i, entry := range desired {
+		matched[i] = -1
+		for j, c := range current {
+			if !used[j] && c.Type == entry.Type && c.Name == entry.Name {
+				matched[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+
+	var staleSections []string
+	for j, c := range current {
+		if used[j] {
+			continue
+		}
+		if c.Type == accessRulebaseSectionType {
+			// Section titles are removed last so their rules are not re-parented before they are moved
+			staleSections = append(staleSections, c.Uid)
+			continue
+		}
+		if err := accessRulebaseCall(client, "delete-access-rule", map[string]interface{}{"uid": c.Uid, "layer": layerUid}); err != nil {
+			return "", err
+		}
+	}
+
+	var order []int
+	var orderIdx []int
+	for i := range desired {
+		if matched[i] >= 0 {
+			order = append(order, matched[i])
+			orderIdx = append(orderIdx, i)
+		}
+	}
+
+	uids := make([]string, len(desired))
+	settled := make([]bool, len(desired))
+	for i := range desired {
+		if matched[i] >= 0 {
+			uids[i] = current[matched[i]].Uid
+		}
+	}
+	for k, inPlace := range longestIncreasingSubsequence(order) {
+		if inPlace {
+			settled[orderIdx[k]] = true
+		}
+	}
+
+	for i, entry := range desired {
+		if settled[i] {
+			continue
+		}
+
+		// Every entry is placed right above the next entry that is already in its final place
+		var position interface{} = "bottom"
+		for k := i + 1; k < len(desired); k++ {
+			if settled[k] {
+				position = map[string]interface{}{"above": uids[k]}
+				break
+			}
+		}
+
+		switch {
+		case entry.Type == accessRulebaseRuleType && matched[i] >= 0:
+			payload := map[string]interface{}{
+				"uid":          uids[i],
+				"layer":        layerUid,
+				"new-position": position,
+			}
+			if err := accessRulebaseCall(client, "set-access-rule", payload); err != nil {
+				return "", err
+			}
+		case entry.Type == accessRulebaseRuleType:
+			payload := accessRulebaseRulePayload(entry.Rule)
+			payload["name"] = entry.Name
+			payload["layer"] = layerUid
+			payload["position"] = position
+			log.Println("Create Access Rulebase Rule - Map = ", redactPayload(nil, payload))
+			addRes, err := client.ApiCall("add-access-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+			if err != nil || !addRes.Success {
+				if addRes.ErrorMsg != "" {
+					return "", fmt.Errorf(addRes.ErrorMsg)
+				}
+				return "", fmt.Errorf(err.Error())
+			}
+			uids[i] = addRes.GetData()["uid"].(string)
+		default:
+			// Sections cannot be moved, so an out of order section is re-created in place
+			if matched[i] >= 0 {
+				staleSections = append(staleSections, uids[i])
+			}
+			payload := map[string]interface{}{
+				"name":     entry.Name,
+				"layer":    layerUid,
+				"position": position,
+			}
+			addRes, err := client.ApiCall("add-access-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
+			if err != nil || !addRes.Success {
+				if addRes.ErrorMsg != "" {
+					return "", fmt.Errorf(addRes.ErrorMsg)
+				}
+				return "", fmt.Errorf(err.Error())
+			}
+			uids[i] = addRes.GetData()["uid"].(string)
+		}
+		settled[i] = true
+	}
+
+	for i, entry := range desired {
+		if entry.Type != accessRulebaseRuleType || matched[i] < 0 {
+			continue
+		}
+		payload := accessRulebaseRulePayload(entry.Rule)
+		if reflect.DeepEqual(payload, accessRulebaseRulePayload(current[matched[i]].Rule)) {
+			continue
+		}
+		payload["uid"] = uids[i]
+		payload["layer"] = layerUid
+		log.Println("Update Access Rulebase Rule - Map = ", redactPayload(nil, payload))
+		if err := accessRulebaseCall(client, "set-access-rule", payload); err != nil {
+			return "", err
+		}
+	}
+
+	for _, uid := range staleSections {
+		if err := accessRulebaseCall(client, "delete-access-section", map[string]interface{}{"uid": uid, "layer": layerUid}); err != nil {
+			return "", err
+		}
+	}
+
+	return layerUid, nil
+}
+
+func accessRulebaseCall(client *checkpoint.ApiClient, command string, payload map[string]interface{}) error {
+	res, err := client.ApiCall(command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !res.Success {
+		if res.ErrorMsg != "" {
+			return fmt.Errorf(res.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+	return nil
+}
+
+// showAccessRulebaseEntries reads the whole layer and keeps the rule fields this resource manages.
+// Returns empty layer UID if the layer does not exist.
+func showAccessRulebaseEntries(client *checkpoint.ApiClient, layer string) (string, []accessRulebaseEntry, error) {
+
+	layerUid, rulebaseEntries, err := showRulebaseEntries(client, "show-access-rulebase", rulebaseIdentifierPayload(layer))
+	if err != nil || layerUid == "" {
+		return "", nil, err
+	}
+
+	var entries []accessRulebaseEntry
+	for _, entry := range rulebaseEntries {
+		switch entry.Type {
+		case accessRulebaseSectionType:
+			entries = append(entries, accessRulebaseEntry{Type: accessRulebaseSectionType, Uid: entry.Uid, Name: entry.Name})
+		case accessRulebaseRuleType:
+			entries = append(entries, newAccessRulebaseRuleEntry(entry.Json))
+		}
+	}
+
+	return layerUid, entries, nil
+}
+
+func newAccessRulebaseRuleEntry(ruleJson map[string]interface{}) accessRulebaseEntry {
+
+	rule := map[string]interface{}{
+		"name":               "",
+		"uid":                "",
+		"action":             "",
+		"inline_layer":       "",
+		"source":             accessRulebaseObjectNames(ruleJson["source"], "Any"),
+		"source_negate":      false,
+		"destination":        accessRulebaseObjectNames(ruleJson["destination"], "Any"),
+		"destination_negate": false,
+		"service":            accessRulebaseObjectNames(ruleJson["service"], "Any"),
+		"service_negate":     false,
+		"install_on":         accessRulebaseObjectNames(ruleJson["install-on"], "Policy Targets"),
+		"time":               accessRulebaseObjectNames(ruleJson["time"], "Any"),
+		"track":              "None",
+		"enabled":            true,
+		"comments":           "",
+	}
+
+	if v, ok := ruleJson["name"].(string); ok {
+		rule["name"] = v
+	}
+	if v, ok := ruleJson["uid"].(string); ok {
+		rule["uid"] = v
+	}
+	if v := ruleJson["action"]; v != nil {
+		action := accessRulebaseObjectName(v)
+		if action == "Inner Layer" {
+			action = "Apply Layer"
+		}
+		rule["action"] = action
+	}
+	if v := ruleJson["inline-layer"]; v != nil {
+		rule["inline_layer"] = accessRulebaseObjectName(v)
+	}
+	if v, ok := ruleJson["source-negate"].(bool); ok {
+		rule["source_negate"] = v
+	}
+	if v, ok := ruleJson["destination-negate"].(bool); ok {
+		rule["destination_negate"] = v
+	}
+	if v, ok := ruleJson["service-negate"].(bool); ok {
+		rule["service_negate"] = v
+	}
+	if track, ok := ruleJson["track"].(map[string]interface{}); ok && track["type"] != nil {
+		rule["track"] = accessRulebaseObjectName(track["type"])
+	}
+	if v, ok := ruleJson["enabled"].(bool); ok {
+		rule["enabled"] = v
+	}
+	if v, ok := ruleJson["comments"].(string); ok {
+		rule["comments"] = v
+	}
+
+	return accessRulebaseEntry{
+		Type: accessRulebaseRuleType,
+		Uid:  rule["uid"].(string),
+		Name: rule["name"].(string),
+		Rule: rule,
+	}
+}
+
+// flattenAccessRulebaseConfig returns the configured rulebase as one ordered list of section titles and rules.
+func flattenAccessRulebaseConfig(d *schema.ResourceData) []accessRulebaseEntry {
+
+	var entries []accessRulebaseEntry
+
+	appendRules := func(rules []interface{}) {
+		for _, rule := range rules {
+			ruleMap := rule.(map[string]interface{})
+			entries = append(entries, accessRulebaseEntry{
+				Type: accessRulebaseRuleType,
+				Uid:  ruleMap["uid"].(string),
+				Name: ruleMap["name"].(string),
+				Rule: ruleMap,
+			})
+		}
+	}
+
+	appendRules(d.Get("rule").([]interface{}))
+
+	for _, section := range d.Get("section").([]interface{}) {
+		sectionMap := section.(map[string]interface{})
+		entries = append(entries, accessRulebaseEntry{
+			Type: accessRulebaseSectionType,
+			Uid:  sectionMap["uid"].(string),
+			Name: sectionMap["name"].(string),
+		})
+		appendRules(sectionMap["rule"].([]interface{}))
+	}
+
+	return entries
+}
+
+// accessRulebaseRulePayload builds the add/set-access-rule fields of a rule. Lists are sorted so that two payloads
+// can be compared to find out whether the rule has to be updated.
+func accessRulebaseRulePayload(rule map[string]interface{}) map[string]interface{} {
+
+	payload := map[string]interface{}{
+		"action":             rule["action"],
+		"source":             accessRulebaseListOrDefault(rule["source"], "Any"),
+		"source-negate":      rule["source_negate"],
+		"destination":        accessRulebaseListOrDefault(rule["destination"], "Any"),
+		"destination-negate": rule["destination_negate"],
+		"service":            accessRulebaseListOrDefault(rule["service"], "Any"),
+		"service-negate":     rule["service_negate"],
+		"install-on":         accessRulebaseListOrDefault(rule["install_on"], "Policy Targets"),
+		"time":               accessRulebaseListOrDefault(rule["time"], "Any"),
+		"track":              map[string]interface{}{"type": rule["track"]},
+		"enabled":            rule["enabled"],
+		"comments":           rule["comments"],
+	}
+
+	if v, ok := rule["inline_layer"].(string); ok && v != "" {
+		payload["inline-layer"] = v
+	}
+
+	return payload
+}
+
+func accessRulebaseListOrDefault(v interface{}, defaultValue string) []string {
+	var res []string
+	switch list := v.(type) {
+	case *schema.Set:
+		for _, item := range list.List() {
+			res = append(res, item.(string))
+		}
+	case []interface{}:
+		for _, item := range list {
+			res = append(res, item.(string))
+		}
+	}
+	if len(res) == 0 {
+		return []string{defaultValue}
+	}
+	sort.Strings(res)
+	return res
+}
+
+// accessRulebaseObjectNames returns the names of a rule column. A column that holds only the default object is
+// returned empty, matching an omitted argument.
+func accessRulebaseObjectNames(v interface{}, defaultValue string) []interface{} {
+	res := make([]interface{}, 0)
+	if list, ok := v.([]interface{}); ok {
+		for _, obj := range list {
+			res = append(res, accessRulebaseObjectName(obj))
+		}
+	}
+	if len(res) == 1 && res[0] == defaultValue {
+		return make([]interface{}, 0)
+	}
+	return res
+}
+
+func accessRulebaseObjectName(v interface{}) string {
+	switch obj := v.(type) {
+	case map[string]interface{}:
+		if name, ok := obj["name"].(string); ok {
+			return name
+		}
+		if uid, ok := obj["uid"].(string); ok {
+			return uid
+		}
+	case string:
+		return obj
+	}
+	return ""
+}
+
+// longestIncreasingSubsequence marks the members of one longest strictly increasing subsequence of seq.
+func longestIncreasingSubsequence(seq []int) []bool {
+	tails := make([]int, 0)
+	prev := make([]int, len(seq))
+	for i, v := range seq {
+		pos := sort.Search(len(tails), func(k int) bool { return seq[tails[k]] >= v })
+		if pos > 0 {
+			prev[i] = tails[pos-1]
+		} else {
+			prev[i] = -1
+		}
+		if pos == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[pos] = i
+		}
+	}
+
+	res := make([]bool, len(seq))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			res[i] = true
+		}
+	}
+	return res
+}
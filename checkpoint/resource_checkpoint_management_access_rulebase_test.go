@@ -0,0 +1,135 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointManagementAccessRulebase_basic(t *testing.T) {
+
+	resourceName := "checkpoint_management_access_rulebase.test"
+	objName := "tfTestManagementAccessRulebase_" + acctest.RandString(6)
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckpointManagementAccessRulebaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementAccessRulebaseConfig(objName, "web", "dns"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCheckpointManagementAccessRulebaseOrder(resourceName, []string{"section web", "rule web", "section dns", "rule dns", "rule cleanup"}),
+					resource.TestCheckResourceAttr(resourceName, "section.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "section.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "section.1.rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccManagementAccessRulebaseConfig(objName, "dns", "web"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCheckpointManagementAccessRulebaseOrder(resourceName, []string{"section dns", "rule dns", "section web", "rule web", "rule cleanup"}),
+					resource.TestCheckResourceAttr(resourceName, "section.0.name", "dns"),
+					resource.TestCheckResourceAttr(resourceName, "section.1.rule.1.name", "cleanup"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckpointManagementAccessRulebaseDestroy(s *terraform.State) error {
+
+	client := testAccProvider.Meta().(*checkpoint.ApiClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "checkpoint_management_access_rulebase" {
+			continue
+		}
+		if rs.Primary.ID != "" {
+			res, _ := client.ApiCall("show-access-layer", map[string]interface{}{"uid": rs.Primary.ID}, client.GetSessionID(), true, client.IsProxyUsed())
+			if res.Success {
+				return fmt.Errorf("access layer (%s) still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckCheckpointManagementAccessRulebaseOrder(resourceTfName string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceTfName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceTfName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("access rulebase ID is not set")
+		}
+
+		client := testAccProvider.Meta().(*checkpoint.ApiClient)
+		_, entries, err := showAccessRulebaseEntries(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var actual []string
+		for _, entry := range entries {
+			if entry.Type == accessRulebaseSectionType {
+				actual = append(actual, "section "+entry.Name)
+			} else {
+				actual = append(actual, "rule "+entry.Name)
+			}
+		}
+
+		if fmt.Sprint(actual) != fmt.Sprint(expected) {
+			return fmt.Errorf("rulebase order is %v, expected %v", actual, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccManagementAccessRulebaseConfig(name string, firstSection string, secondSection string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_access_layer" "test" {
+  name = "%s"
+}
+
+resource "checkpoint_management_access_rulebase" "test" {
+  layer = "${checkpoint_management_access_layer.test.name}"
+
+  section {
+    name = "%s"
+    rule {
+      name = "%s"
+      action = "Accept"
+      track = "Log"
+    }
+  }
+
+  section {
+    name = "%s"
+    rule {
+      name = "%s"
+      action = "Accept"
+      service = ["domain-udp"]
+    }
+    rule {
+      name = "cleanup"
+    }
+  }
+}
+`, name, firstSection, firstSection, secondSection, secondSection)
+}
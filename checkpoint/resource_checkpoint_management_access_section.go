@@ -5,7 +5,6 @@ import (
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
-	"strings"
 )
 
 func resourceManagementAccessSection() *schema.Resource {
@@ -15,15 +14,7 @@ func resourceManagementAccessSection() *schema.Resource {
 		Update: updateManagementAccessSection,
 		Delete: deleteManagementAccessSection,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				arr := strings.Split(d.Id(), ";")
-				if len(arr) != 2 {
-					return nil, fmt.Errorf("invalid unique identifier format. UID format: <LAYER_IDENTIFIER>;<SECTION_UID>")
-				}
-				_ = d.Set("layer", arr[0])
-				d.SetId(arr[1])
-				return []*schema.ResourceData{d}, nil
-			},
+			State: importStateCompositeId("<LAYER_IDENTIFIER>;<SECTION_UID>", "layer"),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -129,7 +120,7 @@ func createManagementAccessSection(d *schema.ResourceData, m interface{}) error
 		}
 	}
 
-	log.Println("Create AccessSection - Map = ", accessSection)
+	log.Println("Create AccessSection - Map = ", redactPayload(d, accessSection))
 
 	addAccessSectionRes, err := client.ApiCall("add-access-section", accessSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAccessSectionRes.Success {
@@ -167,7 +158,7 @@ func readManagementAccessSection(d *schema.ResourceData, m interface{}) error {
 
 	accessSection := showAccessSectionRes.GetData()
 
-	log.Println("Read AccessSection - Show JSON = ", accessSection)
+	log.Println("Read AccessSection - Show JSON = ", redactPayload(d, accessSection))
 
 	if v := accessSection["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -214,7 +205,7 @@ func updateManagementAccessSection(d *schema.ResourceData, m interface{}) error
 		accessSection["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update AccessSection - Map = ", accessSection)
+	log.Println("Update AccessSection - Map = ", redactPayload(d, accessSection))
 
 	updateAccessSectionRes, err := client.ApiCall("set-access-section", accessSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAccessSectionRes.Success {
@@ -151,7 +151,7 @@ func createManagementAciDataCenterServer(d *schema.ResourceData, m interface{})
 		aciDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create aciDataCenterServer - Map = ", aciDataCenterServer)
+	log.Println("Create aciDataCenterServer - Map = ", redactPayload(d, aciDataCenterServer))
 
 	addAciDataCenterServerRes, err := client.ApiCall("add-data-center-server", aciDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -323,7 +323,7 @@ func updateManagementAciDataCenterServer(d *schema.ResourceData, m interface{})
 		aciDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update aciDataCenterServer - Map = ", aciDataCenterServer)
+	log.Println("Update aciDataCenterServer - Map = ", redactPayload(d, aciDataCenterServer))
 
 	updateAciDataCenterServerRes, err := client.ApiCall("set-data-center-server", aciDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
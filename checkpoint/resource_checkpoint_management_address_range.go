@@ -183,7 +183,7 @@ func createManagementAddressRange(d *schema.ResourceData, m interface{}) error {
 		addressRange["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Address Range - Map = ", addressRange)
+	log.Println("Create Address Range - Map = ", redactPayload(d, addressRange))
 
 	addAddressRangeRes, err := client.ApiCall("add-address-range", addressRange, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAddressRangeRes.Success {
@@ -221,7 +221,7 @@ func readManagementAddressRange(d *schema.ResourceData, m interface{}) error {
 
 	addressRange := showAddressRangeRes.GetData()
 
-	log.Println("Read Address Range - Show JSON = ", addressRange)
+	log.Println("Read Address Range - Show JSON = ", redactPayload(d, addressRange))
 
 	if v := addressRange["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -391,7 +391,7 @@ func updateManagementAddressRange(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	log.Println("Update Address Range - Map = ", addressRange)
+	log.Println("Update Address Range - Map = ", redactPayload(d, addressRange))
 	updateAddressRangeRes, err := client.ApiCall("set-address-range", addressRange, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAddressRangeRes.Success {
 		if updateAddressRangeRes.ErrorMsg != "" {
@@ -219,7 +219,7 @@ func createManagementAdministrator(d *schema.ResourceData, m interface{}) error
 		administrator["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Administrator - Map = ", administrator)
+	log.Println("Create Administrator - Map = ", redactPayload(d, administrator))
 
 	addAdministratorRes, err := client.ApiCall("add-administrator", administrator, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAdministratorRes.Success {
@@ -254,7 +254,7 @@ func readManagementAdministrator(d *schema.ResourceData, m interface{}) error {
 	}
 
 	administrator := showAdministratorRes.GetData()
-	log.Println("Read Administrator - Show JSON = ", administrator)
+	log.Println("Read Administrator - Show JSON = ", redactPayload(d, administrator))
 
 	if v := administrator["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -472,7 +472,7 @@ func updateManagementAdministrator(d *schema.ResourceData, m interface{}) error
 		administrator["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Administrator - Map = ", administrator)
+	log.Println("Update Administrator - Map = ", redactPayload(d, administrator))
 	updateAdministratorRes, err := client.ApiCall("set-administrator", administrator, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAdministratorRes.Success {
 		if updateAdministratorRes.ErrorMsg != "" {
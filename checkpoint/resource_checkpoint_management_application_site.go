@@ -148,7 +148,7 @@ func createManagementApplicationSite(d *schema.ResourceData, m interface{}) erro
 		applicationSite["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ApplicationSite - Map = ", applicationSite)
+	log.Println("Create ApplicationSite - Map = ", redactPayload(d, applicationSite))
 
 	addApplicationSiteRes, err := client.ApiCall("add-application-site", applicationSite, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addApplicationSiteRes.Success {
@@ -185,7 +185,7 @@ func readManagementApplicationSite(d *schema.ResourceData, m interface{}) error
 
 	applicationSite := showApplicationSiteRes.GetData()
 
-	log.Println("Read ApplicationSite - Show JSON = ", applicationSite)
+	log.Println("Read ApplicationSite - Show JSON = ", redactPayload(d, applicationSite))
 
 	if v := applicationSite["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -347,7 +347,7 @@ func updateManagementApplicationSite(d *schema.ResourceData, m interface{}) erro
 		applicationSite["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ApplicationSite - Map = ", applicationSite)
+	log.Println("Update ApplicationSite - Map = ", redactPayload(d, applicationSite))
 
 	updateApplicationSiteRes, err := client.ApiCall("set-application-site", applicationSite, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateApplicationSiteRes.Success {
@@ -95,7 +95,7 @@ func createManagementApplicationSiteCategory(d *schema.ResourceData, m interface
 		applicationSiteCategory["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ApplicationSiteCategory - Map = ", applicationSiteCategory)
+	log.Println("Create ApplicationSiteCategory - Map = ", redactPayload(d, applicationSiteCategory))
 
 	addApplicationSiteCategoryRes, err := client.ApiCall("add-application-site-category", applicationSiteCategory, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addApplicationSiteCategoryRes.Success {
@@ -132,7 +132,7 @@ func readManagementApplicationSiteCategory(d *schema.ResourceData, m interface{}
 
 	applicationSiteCategory := showApplicationSiteCategoryRes.GetData()
 
-	log.Println("Read ApplicationSiteCategory - Show JSON = ", applicationSiteCategory)
+	log.Println("Read ApplicationSiteCategory - Show JSON = ", redactPayload(d, applicationSiteCategory))
 
 	if v := applicationSiteCategory["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -220,7 +220,7 @@ func updateManagementApplicationSiteCategory(d *schema.ResourceData, m interface
 		applicationSiteCategory["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ApplicationSiteCategory - Map = ", applicationSiteCategory)
+	log.Println("Update ApplicationSiteCategory - Map = ", redactPayload(d, applicationSiteCategory))
 
 	updateApplicationSiteCategoryRes, err := client.ApiCall("set-application-site-category", applicationSiteCategory, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateApplicationSiteCategoryRes.Success {
@@ -98,7 +98,7 @@ func createManagementApplicationSiteGroup(d *schema.ResourceData, m interface{})
 		applicationSiteGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ApplicationSiteGroup - Map = ", applicationSiteGroup)
+	log.Println("Create ApplicationSiteGroup - Map = ", redactPayload(d, applicationSiteGroup))
 
 	addApplicationSiteGroupRes, err := client.ApiCall("add-application-site-group", applicationSiteGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addApplicationSiteGroupRes.Success {
@@ -135,7 +135,7 @@ func readManagementApplicationSiteGroup(d *schema.ResourceData, m interface{}) e
 
 	applicationSiteGroup := showApplicationSiteGroupRes.GetData()
 
-	log.Println("Read ApplicationSiteGroup - Show JSON = ", applicationSiteGroup)
+	log.Println("Read ApplicationSiteGroup - Show JSON = ", redactPayload(d, applicationSiteGroup))
 
 	if v := applicationSiteGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -240,7 +240,7 @@ func updateManagementApplicationSiteGroup(d *schema.ResourceData, m interface{})
 		applicationSiteGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ApplicationSiteGroup - Map = ", applicationSiteGroup)
+	log.Println("Update ApplicationSiteGroup - Map = ", redactPayload(d, applicationSiteGroup))
 
 	updateApplicationSiteGroupRes, err := client.ApiCall("set-application-site-group", applicationSiteGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateApplicationSiteGroupRes.Success {
@@ -157,7 +157,7 @@ func createManagementAwsDataCenterServer(d *schema.ResourceData, m interface{})
 		awsDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create awsDataCenterServer - Map = ", awsDataCenterServer)
+	log.Println("Create awsDataCenterServer - Map = ", redactPayload(d, awsDataCenterServer))
 
 	addAwsDataCenterServerRes, err := client.ApiCall("add-data-center-server", awsDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -327,7 +327,7 @@ func updateManagementAwsDataCenterServer(d *schema.ResourceData, m interface{})
 		awsDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update awsDataCenterServer - Map = ", awsDataCenterServer)
+	log.Println("Update awsDataCenterServer - Map = ", redactPayload(d, awsDataCenterServer))
 
 	updateAwsDataCenterServerRes, err := client.ApiCall("set-data-center-server", awsDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
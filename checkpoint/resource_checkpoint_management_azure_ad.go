@@ -146,7 +146,7 @@ func createManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 		azureAd["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create AzureAd - Map = ", azureAd)
+	log.Println("Create AzureAd - Map = ", redactPayload(d, azureAd))
 
 	addAzureAdRes, err := client.ApiCall("add-azure-ad", azureAd, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addAzureAdRes.Success {
@@ -184,7 +184,7 @@ func readManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 
 	azureAd := showAzureAdRes.GetData()
 
-	log.Println("Read AzureAd - Show JSON = ", azureAd)
+	log.Println("Read AzureAd - Show JSON = ", redactPayload(d, azureAd))
 
 	if v := azureAd["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -334,7 +334,7 @@ func updateManagementAzureAd(d *schema.ResourceData, m interface{}) error {
 		azureAd["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update AzureAd - Map = ", azureAd)
+	log.Println("Update AzureAd - Map = ", redactPayload(d, azureAd))
 
 	updateAzureAdRes, err := client.ApiCall("set-azure-ad", azureAd, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateAzureAdRes.Success {
@@ -165,7 +165,7 @@ func createManagementAzureDataCenterServer(d *schema.ResourceData, m interface{}
 		azureDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create azureDataCenterServer - Map = ", azureDataCenterServer)
+	log.Println("Create azureDataCenterServer - Map = ", redactPayload(d, azureDataCenterServer))
 
 	addAzureDataCenterServerRes, err := client.ApiCall("add-data-center-server", azureDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -339,7 +339,7 @@ func updateManagementAzureDataCenterServer(d *schema.ResourceData, m interface{}
 		azureDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update azureDataCenterServer - Map = ", azureDataCenterServer)
+	log.Println("Update azureDataCenterServer - Map = ", redactPayload(d, azureDataCenterServer))
 
 	updateAzureDataCenterServerRes, err := client.ApiCall("set-data-center-server", azureDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
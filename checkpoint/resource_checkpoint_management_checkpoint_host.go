@@ -16,6 +16,9 @@ func resourceManagementCheckpointHost() *schema.Resource {
 		Read:   readManagementCheckpointHost,
 		Update: updateManagementCheckpointHost,
 		Delete: deleteManagementCheckpointHost,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -707,7 +710,7 @@ func createManagementCheckpointHost(d *schema.ResourceData, m interface{}) error
 		checkpointHost["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create CheckpointHost - Map = ", checkpointHost)
+	log.Println("Create CheckpointHost - Map = ", redactPayload(d, checkpointHost))
 
 	addCheckpointHostRes, err := client.ApiCall("add-checkpoint-host", checkpointHost, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addCheckpointHostRes.Success {
@@ -744,7 +747,7 @@ func readManagementCheckpointHost(d *schema.ResourceData, m interface{}) error {
 
 	checkpointHost := showCheckpointHostRes.GetData()
 
-	log.Println("Read CheckpointHost - Show JSON = ", checkpointHost)
+	log.Println("Read CheckpointHost - Show JSON = ", redactPayload(d, checkpointHost))
 
 	if v := checkpointHost["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1491,7 +1494,7 @@ func updateManagementCheckpointHost(d *schema.ResourceData, m interface{}) error
 		checkpointHost["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update CheckpointHost - Map = ", checkpointHost)
+	log.Println("Update CheckpointHost - Map = ", redactPayload(d, checkpointHost))
 
 	updateCheckpointHostRes, err := client.ApiCall("set-checkpoint-host", checkpointHost, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateCheckpointHostRes.Success {
@@ -10,7 +10,7 @@ import (
 )
 
 func resourceManagementCMEAccountsAWS() *schema.Resource {
-	return &schema.Resource{
+	return requireCmeApiVersions(&schema.Resource{
 		Create: createManagementCMEAccountsAWS,
 		Update: updateManagementCMEAccountsAWS,
 		Read:   readManagementCMEAccountsAWS,
@@ -155,7 +155,9 @@ func resourceManagementCMEAccountsAWS() *schema.Resource {
 				Description: "The account's domain name in MDS environment.",
 			},
 		},
-	}
+	}, map[string]string{
+		"scan_subnets_6": "v1.2.2",
+	})
 }
 
 func readManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error {
@@ -168,7 +170,11 @@ func readManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Println("Read cme AWS account - name = ", name)
 
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	AWSAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -348,7 +354,11 @@ func createManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Create cme AWS account - name = ", payload["name"])
 
-	url := CmeApiPath + "/accounts/aws"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/aws"
 
 	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -455,7 +465,11 @@ func updateManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Set cme AWS account - name = ", name)
 
-	url := CmeApiPath + "/accounts/aws/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/aws/" + name
 	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
@@ -480,7 +494,11 @@ func deleteManagementCMEAccountsAWS(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Delete cme AWS account - name = ", name)
 
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
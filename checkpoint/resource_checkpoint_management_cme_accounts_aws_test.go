@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"os"
 	"reflect"
+	"regexp"
 	"testing"
 )
 
@@ -40,6 +41,73 @@ func TestAccCheckpointManagementCMEAccountsAWS_basic(t *testing.T) {
 		},
 	})
 }
+func TestUnitCheckpointManagementCMEAccountsAWS_apiVersion(t *testing.T) {
+	resourceName := "checkpoint_management_cme_accounts_aws.test"
+	accountName := "tfTestCmeApiVersion"
+	mock := newMockApiServer(t)
+	mock.cmeApiVersions = []string{"v1", "v1.1", "v1.2"}
+
+	providerConfig := func(cmeApiVersion string) string {
+		return mock.providerConfig(hclArgument("cme_api_version", cmeApiVersion))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if mock.cmeObject("accounts", accountName) != nil {
+				return fmt.Errorf("AWS account %s still exists", accountName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig("v1.3.1") + testUnitManagementCMEAccountsAWSConfig(accountName, 2, false),
+				ExpectError: regexp.MustCompile(`cme_api_version v1.3.1 is not supported by CME. Supported versions are v1, v1.1, v1.2`),
+			},
+			{
+				Config:      mock.providerConfig() + testUnitManagementCMEAccountsAWSConfig(accountName, 2, true),
+				ExpectError: regexp.MustCompile(`attribute scan_subnets_6 requires CME API v1.2.2, CME supports v1.2`),
+			},
+			{
+				Config:      providerConfig("1.1") + testUnitManagementCMEAccountsAWSConfig(accountName, 2, true),
+				ExpectError: regexp.MustCompile(`attribute scan_subnets_6 requires CME API v1.2.2, provider cme_api_version is v1.1`),
+			},
+			{
+				// The latest version that both CME and the provider support
+				Config: mock.providerConfig() + testUnitManagementCMEAccountsAWSConfig(accountName, 2, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "platform", "aws"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_tolerance", "2"),
+					testUnitCheckCallCount(mock, "cme-api/v1.2/accounts/aws", 1),
+				),
+			},
+			{
+				Config: providerConfig("1.1") + testUnitManagementCMEAccountsAWSConfig(accountName, 3, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_tolerance", "3"),
+					testUnitCheckCallCount(mock, "cme-api/v1.1/accounts/aws/"+accountName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testUnitManagementCMEAccountsAWSConfig(accountName string, deletionTolerance int, scanSubnets6 bool) string {
+	scanSubnets6Config := ""
+	if scanSubnets6 {
+		scanSubnets6Config = "scan_subnets_6 = true"
+	}
+	return fmt.Sprintf(`
+resource "checkpoint_management_cme_accounts_aws" "test" {
+  name = "%s"
+  regions = ["us-east-1"]
+  credentials_file = "IAM"
+  deletion_tolerance = %d
+  %s
+}
+`, accountName, deletionTolerance, scanSubnets6Config)
+}
+
 func testAccCheckpointManagementCMEAccountAWSDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*checkpoint.ApiClient)
 	for _, rs := range s.RootModule().Resources {
@@ -47,7 +115,11 @@ func testAccCheckpointManagementCMEAccountAWSDestroy(s *terraform.State) error {
 			continue
 		}
 		if rs.Primary.ID != "" {
-			url := CmeApiPath + "/accounts/" + rs.Primary.Attributes["name"]
+			cmePath, err := cmeApiPath(client)
+			if err != nil {
+				return err
+			}
+			url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
 			response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 			if err != nil {
 				return err
@@ -95,7 +167,11 @@ func testAccCheckCheckpointManagementCMEAccountsAWSExists(resourceTfName string,
 		}
 
 		client := testAccProvider.Meta().(*checkpoint.ApiClient)
-		url := CmeApiPath + "/accounts/" + rs.Primary.Attributes["name"]
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
 		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 		if err != nil {
 			return err
@@ -86,7 +86,11 @@ func deleteManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) err
 	}
 
 	log.Println("Delete cme Azure account - name = ", name)
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
@@ -114,7 +118,11 @@ func readManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) error
 	}
 
 	log.Println("Read cme Azure account - name = ", name)
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	AzureAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -184,7 +192,11 @@ func createManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) err
 	}
 	log.Println("Create cme Azure account - name = ", payload["name"])
 
-	url := CmeApiPath + "/accounts/azure"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/azure"
 
 	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -235,7 +247,11 @@ func updateManagementCMEAccountsAzure(d *schema.ResourceData, m interface{}) err
 	}
 	log.Println("Set cme Azure account - name = ", name)
 
-	url := CmeApiPath + "/accounts/azure/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/azure/" + name
 	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
@@ -49,7 +49,11 @@ func testAccCheckpointManagementCMEAccountAzureDestroy(s *terraform.State) error
 			continue
 		}
 		if rs.Primary.ID != "" {
-			url := CmeApiPath + "/accounts/" + rs.Primary.Attributes["name"]
+			cmePath, err := cmeApiPath(client)
+			if err != nil {
+				return err
+			}
+			url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
 			response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 			if err != nil {
 				return err
@@ -89,7 +93,11 @@ func testAccCheckCheckpointManagementCMEAccountsAzureExists(resourceTfName strin
 		}
 
 		client := testAccProvider.Meta().(*checkpoint.ApiClient)
-		url := CmeApiPath + "/accounts/" + rs.Primary.Attributes["name"]
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
 		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 		if err != nil {
 			return err
@@ -75,7 +75,11 @@ func deleteManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error
 	}
 
 	log.Println("Delete cme GCP account - name = ", name)
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
 
@@ -103,7 +107,11 @@ func readManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error {
 	}
 
 	log.Println("Read cme GCP account - name = ", name)
-	url := CmeApiPath + "/accounts/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
 
 	GCPAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -167,7 +175,11 @@ func createManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Create cme GCP account - name = ", payload["name"])
 
-	url := CmeApiPath + "/accounts/gcp"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/gcp"
 
 	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -212,7 +224,11 @@ func updateManagementCMEAccountsGCP(d *schema.ResourceData, m interface{}) error
 	}
 	log.Println("Set cme GCP account - name = ", name)
 
-	url := CmeApiPath + "/accounts/gcp/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/gcp/" + name
 	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
@@ -45,7 +45,11 @@ func testAccCheckpointManagementCMEAccountGCPDestroy(s *terraform.State) error {
 			continue
 		}
 		if rs.Primary.ID != "" {
-			url := CmeApiPath + "/accounts/" + rs.Primary.Attributes["name"]
+			cmePath, err := cmeApiPath(client)
+			if err != nil {
+				return err
+			}
+			url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
 			response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 			if err != nil {
 				return err
@@ -82,7 +86,11 @@ func testAccCheckCheckpointManagementCMEAccountsGCPExists(resourceTfName string,
 		}
 
 		client := testAccProvider.Meta().(*checkpoint.ApiClient)
-		url := CmeApiPath + "/accounts/" + rs.Primary.Attributes["name"]
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
 		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 		if err != nil {
 			return err
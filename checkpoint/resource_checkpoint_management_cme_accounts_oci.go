@@ -0,0 +1,305 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"strings"
+)
+
+func resourceManagementCMEAccountsOCI() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementCMEAccountsOCI,
+		Update: updateManagementCMEAccountsOCI,
+		Read:   readManagementCMEAccountsOCI,
+		Delete: deleteManagementCMEAccountsOCI,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The account name.",
+			},
+			"tenancy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The OCID of the tenancy.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The OCID of the user that CME connects to OCI with.",
+			},
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The fingerprint of the API signing key of the user.",
+			},
+			"private_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The private key file of the API signing key. The file must be in the $FWDIR/conf directory.",
+			},
+			"private_key_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded string that represents the content of the private key file.",
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of OCI regions, in which the gateways are being deployed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"compartments": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of OCIDs of the compartments, in which the gateways are being deployed. Default is all the compartments of the tenancy.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deletion_tolerance": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of CME cycles to wait when the cloud provider does not return a GW until its deletion.",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The account's domain name in MDS environment.",
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The platform of the account.",
+			},
+			"gw_configurations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of GW configurations attached to the account",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func deleteManagementCMEAccountsOCI(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	log.Println("Delete cme OCI account - name = ", name)
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
+
+	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
+
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+
+	data := res.GetData()
+	if checkIfRequestFailed(data) {
+		errMessage := buildErrorMessage(data)
+		return fmt.Errorf(errMessage)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func readManagementCMEAccountsOCI(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	var name string
+
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+
+	log.Println("Read cme OCI account - name = ", name)
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/" + name
+
+	OCIAccountRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	account := OCIAccountRes.GetData()
+	if checkIfRequestFailed(account) {
+		if cmeObjectNotFound(account) {
+			d.SetId("")
+			return nil
+		}
+		errMessage := buildErrorMessage(account)
+		return fmt.Errorf(errMessage)
+	}
+
+	OCIAccount := account["result"].(map[string]interface{})
+
+	_ = d.Set("name", OCIAccount["name"])
+
+	_ = d.Set("tenancy_id", OCIAccount["tenancy_id"])
+
+	_ = d.Set("user_id", OCIAccount["user_id"])
+
+	_ = d.Set("fingerprint", OCIAccount["fingerprint"])
+
+	if keyFile, ok := OCIAccount["private_key_file"].(string); ok {
+		_ = d.Set("private_key_file", strings.TrimPrefix(keyFile, "$FWDIR/conf/"))
+	} else {
+		_ = d.Set("private_key_file", nil)
+	}
+
+	_ = d.Set("regions", OCIAccount["regions"])
+
+	_ = d.Set("compartments", OCIAccount["compartments"])
+
+	_ = d.Set("deletion_tolerance", OCIAccount["deletion_tolerance"])
+
+	_ = d.Set("domain", OCIAccount["domain"])
+
+	_ = d.Set("platform", OCIAccount["platform"])
+
+	_ = d.Set("gw_configurations", OCIAccount["gw_configurations"])
+
+	return nil
+
+}
+
+func createManagementCMEAccountsOCI(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	payload := make(map[string]interface{})
+
+	if v, ok := d.GetOk("tenancy_id"); ok {
+		payload["tenancy_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("user_id"); ok {
+		payload["user_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("fingerprint"); ok {
+		payload["fingerprint"] = v.(string)
+	}
+	if v, ok := d.GetOk("private_key_file"); ok {
+		payload["private_key_file"] = v.(string)
+	}
+	if v, ok := d.GetOk("private_key_data"); ok {
+		payload["private_key_data"] = v.(string)
+	}
+	if v, ok := d.GetOk("regions"); ok {
+		payload["regions"] = v.([]interface{})
+	}
+	if v, ok := d.GetOk("compartments"); ok {
+		payload["compartments"] = v.([]interface{})
+	}
+	if v, ok := d.GetOk("deletion_tolerance"); ok {
+		payload["deletion_tolerance"] = v.(int)
+	}
+	if v, ok := d.GetOk("domain"); ok {
+		payload["domain"] = v.(string)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		payload["name"] = v.(string)
+	}
+	log.Println("Create cme OCI account - name = ", payload["name"])
+
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/oci"
+
+	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
+
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+
+	data := cmeAccountsRes.GetData()
+	if checkIfRequestFailed(data) {
+		errMessage := buildErrorMessage(data)
+		return fmt.Errorf(errMessage)
+	}
+	d.SetId("cme-oci-account-" + d.Get("name").(string) + "-" + acctest.RandString(10))
+
+	return readManagementCMEAccountsOCI(d, m)
+}
+
+func updateManagementCMEAccountsOCI(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	payload := make(map[string]interface{})
+
+	if d.HasChange("tenancy_id") {
+		payload["tenancy_id"] = d.Get("tenancy_id")
+	}
+	if d.HasChange("user_id") {
+		payload["user_id"] = d.Get("user_id")
+	}
+	if d.HasChange("fingerprint") {
+		payload["fingerprint"] = d.Get("fingerprint")
+	}
+	if d.HasChange("private_key_file") {
+		payload["private_key_file"] = d.Get("private_key_file")
+	}
+	if d.HasChange("private_key_data") {
+		payload["private_key_data"] = d.Get("private_key_data")
+	}
+	if d.HasChange("regions") {
+		payload["regions"] = d.Get("regions")
+	}
+	if d.HasChange("compartments") {
+		payload["compartments"] = d.Get("compartments")
+	}
+	if d.HasChange("deletion_tolerance") {
+		payload["deletion_tolerance"] = d.Get("deletion_tolerance")
+	}
+	if d.HasChange("domain") {
+		payload["domain"] = d.Get("domain")
+	}
+
+	var name string
+
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	}
+	log.Println("Set cme OCI account - name = ", name)
+
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/accounts/oci/" + name
+	cmeAccountsRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
+
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+
+	data := cmeAccountsRes.GetData()
+	if checkIfRequestFailed(data) {
+		errMessage := buildErrorMessage(data)
+		return fmt.Errorf(errMessage)
+	}
+
+	return readManagementCMEAccountsOCI(d, m)
+}
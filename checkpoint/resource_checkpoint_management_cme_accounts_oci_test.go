@@ -0,0 +1,205 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAccCheckpointManagementCMEAccountsOCI_basic(t *testing.T) {
+	var ociAccount map[string]interface{}
+	resourceName := "checkpoint_management_cme_accounts_oci.test"
+	accountName := "test-account"
+	tenancyId := "ocid1.tenancy.oc1..aaaaaaaaexample"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this test")
+	} else if context != "web_api" {
+		t.Skip("Skipping cme api test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckpointManagementCMEAccountOCIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementCMEAccountsOCIConfig(accountName, tenancyId, []string{"us-ashburn-1"}, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCheckpointManagementCMEAccountsOCIExists(resourceName, &ociAccount),
+					testAccCheckCheckpointManagementCMEAccountsOCIAttributes(&ociAccount, accountName, tenancyId, []interface{}{"us-ashburn-1"}, 0),
+				),
+			},
+			{
+				Config: testAccManagementCMEAccountsOCIConfig(accountName, tenancyId, []string{"us-ashburn-1", "eu-frankfurt-1"}, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCheckpointManagementCMEAccountsOCIExists(resourceName, &ociAccount),
+					testAccCheckCheckpointManagementCMEAccountsOCIAttributes(&ociAccount, accountName, tenancyId, []interface{}{"us-ashburn-1", "eu-frankfurt-1"}, 3),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointManagementCMEAccountsOCI_basic(t *testing.T) {
+	var ociAccount map[string]interface{}
+	resourceName := "checkpoint_management_cme_accounts_oci.test"
+	accountName := "tfTestCmeAccountOci"
+	tenancyId := "ocid1.tenancy.oc1..aaaaaaaaexample"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if mock.cmeObject("accounts", accountName) != nil {
+				return fmt.Errorf("OCI account %s still exists", accountName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testAccManagementCMEAccountsOCIConfig(accountName, tenancyId, []string{"us-ashburn-1"}, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "platform", "oci"),
+					resource.TestCheckResourceAttr(resourceName, "private_key_file", "oci_api_key.pem"),
+					testUnitCheckMockCmeObject(mock, "accounts", accountName, &ociAccount),
+					testAccCheckCheckpointManagementCMEAccountsOCIAttributes(&ociAccount, accountName, tenancyId, []interface{}{"us-ashburn-1"}, 0),
+				),
+			},
+			{
+				Config: mock.providerConfig() + testAccManagementCMEAccountsOCIConfig(accountName, tenancyId, []string{"us-ashburn-1", "eu-frankfurt-1"}, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					testUnitCheckMockCmeObject(mock, "accounts", accountName, &ociAccount),
+					testAccCheckCheckpointManagementCMEAccountsOCIAttributes(&ociAccount, accountName, tenancyId, []interface{}{"us-ashburn-1", "eu-frankfurt-1"}, 3),
+					testUnitCheckCallCount(mock, "cme-api/"+CmeApiVersion+"/accounts/oci/"+accountName, 1),
+				),
+			},
+			{
+				Config: mock.providerConfig() + testAccManagementCMEAccountsOCIConfig(accountName, tenancyId, []string{"us-ashburn-1", "eu-frankfurt-1"}, 3) + `
+data "checkpoint_management_cme_accounts_oci" "data_test" {
+  name = "${checkpoint_management_cme_accounts_oci.test.name}"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_accounts_oci.data_test", "tenancy_id", tenancyId),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_accounts_oci.data_test", "regions.#", "2"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_accounts_oci.data_test", "platform", "oci"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckpointManagementCMEAccountOCIDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*checkpoint.ApiClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "checkpoint_management_cme_accounts_oci" {
+			continue
+		}
+		if rs.Primary.ID != "" {
+			cmePath, err := cmeApiPath(client)
+			if err != nil {
+				return err
+			}
+			url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
+			response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+			if err != nil {
+				return err
+			}
+			res := response.GetData()
+			if !checkIfRequestFailed(res) {
+				return fmt.Errorf("OCI account (%s) still exists", rs.Primary.Attributes["name"])
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccManagementCMEAccountsOCIConfig(accountName string, tenancyId string, regions []string, deletionTolerance int) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_cme_accounts_oci" "test" {
+  name               = "%s"
+  tenancy_id         = "%s"
+  user_id            = "ocid1.user.oc1..aaaaaaaaexample"
+  fingerprint        = "12:34:56:78:9a:bc:de:f0:12:34:56:78:9a:bc:de:f0"
+  private_key_file   = "oci_api_key.pem"
+  regions            = ["%s"]
+  deletion_tolerance = %d
+}
+`, accountName, tenancyId, strings.Join(regions, `", "`), deletionTolerance)
+}
+
+func testAccCheckCheckpointManagementCMEAccountsOCIExists(resourceTfName string, res *map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceTfName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceTfName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		client := testAccProvider.Meta().(*checkpoint.ApiClient)
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/accounts/" + rs.Primary.Attributes["name"]
+		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+		if err != nil {
+			return err
+		}
+
+		*res = response.GetData()
+		if checkIfRequestFailed(*res) {
+			errMessage := buildErrorMessage(*res)
+			return fmt.Errorf(errMessage)
+		}
+		return nil
+	}
+}
+
+// testUnitCheckMockCmeObject reads a CME object of the mock server into res, in the form of a CME API reply.
+func testUnitCheckMockCmeObject(mock *mockApiServer, collection string, name string, res *map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		obj := mock.cmeObject(collection, name)
+		if obj == nil {
+			return fmt.Errorf("CME %s object (%s) not found", collection, name)
+		}
+		*res = map[string]interface{}{"status-code": float64(200), "result": obj}
+		return nil
+	}
+}
+
+func testAccCheckCheckpointManagementCMEAccountsOCIAttributes(ociAccount *map[string]interface{}, name string,
+	tenancyId string, regions []interface{}, expectedDeletionTolerance int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		account := (*ociAccount)["result"].(map[string]interface{})
+		if account["name"] != name {
+			return fmt.Errorf("name is %s, expected %s", account["name"], name)
+		}
+		if account["tenancy_id"] != tenancyId {
+			return fmt.Errorf("tenancy_id is %s, expected %s", account["tenancy_id"], tenancyId)
+		}
+		if !reflect.DeepEqual(account["regions"], regions) {
+			return fmt.Errorf("regions are %v, expected %v", account["regions"], regions)
+		}
+		deletionTolerance := 0
+		if v, ok := account["deletion_tolerance"].(float64); ok {
+			deletionTolerance = int(v)
+		}
+		if deletionTolerance != expectedDeletionTolerance {
+			return fmt.Errorf("deletion_tolerance is %d, expected %d", deletionTolerance, expectedDeletionTolerance)
+		}
+		return nil
+	}
+}
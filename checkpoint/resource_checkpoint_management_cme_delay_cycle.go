@@ -54,7 +54,11 @@ func createUpdateManagementCMEDelayCycle(d *schema.ResourceData, m interface{})
 
 	log.Println("Update cme delay cycle - payload = ", payload)
 
-	url := CmeApiPath + "/generalConfiguration/delayCycle"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/generalConfiguration/delayCycle"
 
 	cmeDelayCycleRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
@@ -74,7 +78,11 @@ func readManagementCMEDelayCycle(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 
 	log.Println("Read cme delay cycle")
-	url := CmeApiPath + "/generalConfiguration/delayCycle"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/generalConfiguration/delayCycle"
 
 	cmeDelayCycleRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -64,7 +64,11 @@ func testAccCheckCheckpointManagementCMEDelayCycleExists(resourceTfName string,
 		}
 
 		client := testAccProvider.Meta().(*checkpoint.ApiClient)
-		url := CmeApiPath + "/generalConfiguration/delayCycle"
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/generalConfiguration/delayCycle"
 		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 		if err != nil {
 			return err
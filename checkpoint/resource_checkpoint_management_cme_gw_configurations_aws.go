@@ -11,7 +11,7 @@ import (
 )
 
 func resourceManagementCMEGWConfigurationsAWS() *schema.Resource {
-	return &schema.Resource{
+	return requireCmeApiVersions(&schema.Resource{
 		Create: createManagementCMEGWConfigurationsAWS,
 		Update: updateManagementCMEGWConfigurationsAWS,
 		Read:   readManagementCMEGWConfigurationsAWS,
@@ -247,7 +247,13 @@ func resourceManagementCMEGWConfigurationsAWS() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, map[string]string{
+		"identity_awareness_settings": "v1.2",
+		"repository_gateway_scripts":  "v1.2",
+		"send_alerts_to_server":       "v1.2",
+		"send_logs_to_backup_server":  "v1.2",
+		"send_logs_to_server":         "v1.2",
+	})
 }
 
 func readManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{}) error {
@@ -260,7 +266,11 @@ func readManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{})
 	}
 	log.Println("Read cme AWS GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	AWSGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -513,7 +523,11 @@ func createManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{
 	}
 	log.Println("Create cme AWS GW configuration - name = ", payload["name"])
 
-	url := CmeApiPath + "/gwConfigurations/aws"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/aws"
 
 	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -666,7 +680,11 @@ func updateManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{
 	}
 	log.Println("Set cme AWS GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/aws/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/aws/" + name
 	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
@@ -690,7 +708,11 @@ func deleteManagementCMEGWConfigurationsAWS(d *schema.ResourceData, m interface{
 	if v, ok := d.GetOk("name"); ok {
 		name = v.(string)
 	}
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	log.Println("Delete cme AWS GW configuration - name = ", name)
 	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
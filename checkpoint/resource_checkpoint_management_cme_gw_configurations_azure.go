@@ -10,7 +10,7 @@ import (
 )
 
 func resourceManagementCMEGWConfigurationsAzure() *schema.Resource {
-	return &schema.Resource{
+	return requireCmeApiVersions(&schema.Resource{
 		Create: createManagementCMEGWConfigurationsAzure,
 		Update: updateManagementCMEGWConfigurationsAzure,
 		Read:   readManagementCMEGWConfigurationsAzure,
@@ -216,7 +216,14 @@ func resourceManagementCMEGWConfigurationsAzure() *schema.Resource {
 				Description: "Indicates if the GW is configured to support IPv6.",
 			},
 		},
-	}
+	}, map[string]string{
+		"identity_awareness_settings": "v1.2",
+		"repository_gateway_scripts":  "v1.2",
+		"send_alerts_to_server":       "v1.2",
+		"send_logs_to_backup_server":  "v1.2",
+		"send_logs_to_server":         "v1.2",
+		"ipv6":                        "v1.2.2",
+	})
 }
 
 func readManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interface{}) error {
@@ -229,7 +236,11 @@ func readManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interface{
 	}
 	log.Println("Read cme Azure GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	AzureGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -460,7 +471,11 @@ func createManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interfac
 	}
 	log.Println("Create cme Azure GW configuration - name = ", payload["name"])
 
-	url := CmeApiPath + "/gwConfigurations/azure"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/azure"
 
 	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -601,7 +616,11 @@ func updateManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interfac
 	}
 	log.Println("Set cme Azure GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/azure/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/azure/" + name
 	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
@@ -625,7 +644,11 @@ func deleteManagementCMEGWConfigurationsAzure(d *schema.ResourceData, m interfac
 	if v, ok := d.GetOk("name"); ok {
 		name = v.(string)
 	}
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	log.Println("Delete cme Azure GW configuration - name = ", name)
 	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
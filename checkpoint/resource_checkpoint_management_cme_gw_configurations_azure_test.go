@@ -57,7 +57,11 @@ func testAccCheckpointManagementCMEGWConfigurationsAzureDestroy(s *terraform.Sta
 			continue
 		}
 		if rs.Primary.ID != "" {
-			url := CmeApiPath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
+			cmePath, err := cmeApiPath(client)
+			if err != nil {
+				return err
+			}
+			url := cmePath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
 			response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 			if err != nil {
 				return err
@@ -128,7 +132,11 @@ func testAccCheckCheckpointManagementCMEGWConfigurationsAzureExists(resourceTfNa
 		}
 
 		client := testAccProvider.Meta().(*checkpoint.ApiClient)
-		url := CmeApiPath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
 		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 		if err != nil {
 			return err
@@ -10,7 +10,7 @@ import (
 )
 
 func resourceManagementCMEGWConfigurationsGCP() *schema.Resource {
-	return &schema.Resource{
+	return requireCmeApiVersions(&schema.Resource{
 		Create: createManagementCMEGWConfigurationsGCP,
 		Update: updateManagementCMEGWConfigurationsGCP,
 		Read:   readManagementCMEGWConfigurationsGCP,
@@ -211,7 +211,13 @@ func resourceManagementCMEGWConfigurationsGCP() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, map[string]string{
+		"identity_awareness_settings": "v1.2",
+		"repository_gateway_scripts":  "v1.2",
+		"send_alerts_to_server":       "v1.2",
+		"send_logs_to_backup_server":  "v1.2",
+		"send_logs_to_server":         "v1.2",
+	})
 }
 
 func readManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{}) error {
@@ -224,7 +230,11 @@ func readManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{})
 	}
 	log.Println("Read cme GCP GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	GCPGWConfigurationRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -450,7 +460,11 @@ func createManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{
 	}
 	log.Println("Create cme GCP GW configuration - name = ", payload["name"])
 
-	url := CmeApiPath + "/gwConfigurations/gcp"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/gcp"
 
 	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -588,7 +602,11 @@ func updateManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{
 	}
 	log.Println("Set cme GCP GW configuration - name = ", name)
 
-	url := CmeApiPath + "/gwConfigurations/gcp/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/gcp/" + name
 	cmeGWConfigurationRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
 	if err != nil {
@@ -612,7 +630,11 @@ func deleteManagementCMEGWConfigurationsGCP(d *schema.ResourceData, m interface{
 	if v, ok := d.GetOk("name"); ok {
 		name = v.(string)
 	}
-	url := CmeApiPath + "/gwConfigurations/" + name
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/gwConfigurations/" + name
 
 	log.Println("Delete cme GCP GW configuration - name = ", name)
 	res, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "DELETE")
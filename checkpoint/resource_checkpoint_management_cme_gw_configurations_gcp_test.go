@@ -55,7 +55,11 @@ func testAccCheckpointManagementCMEGWConfigurationsGCPDestroy(s *terraform.State
 			continue
 		}
 		if rs.Primary.ID != "" {
-			url := CmeApiPath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
+			cmePath, err := cmeApiPath(client)
+			if err != nil {
+				return err
+			}
+			url := cmePath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
 			response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 			if err != nil {
 				return err
@@ -123,7 +127,11 @@ func testAccCheckCheckpointManagementCMEGWConfigurationsGCPExists(resourceTfName
 		}
 
 		client := testAccProvider.Meta().(*checkpoint.ApiClient)
-		url := CmeApiPath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
 		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 		if err != nil {
 			return err
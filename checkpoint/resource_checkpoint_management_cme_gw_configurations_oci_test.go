@@ -0,0 +1,265 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccCheckpointManagementCMEGWConfigurationsOCI_basic(t *testing.T) {
+	var ociGWConfiguration map[string]interface{}
+	resourceName := "checkpoint_management_cme_gw_configurations_oci.gw_configuration_test"
+	accountName := "test-account"
+	gwConfigurationName := "test-gw-configuration"
+	gwConfigurationVersion := "R82"
+	gwConfigurationBase64SIC := "MTIzNDU2Nzg="
+	gwConfigurationPolicy := "Standard"
+	gwConfigurationColor := "blue"
+	gwConfigurationXForwardedFor := true
+	gwConfigurationCommunicationWithServersBehindNAT := "translated-ip-only"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this test")
+	} else if context != "web_api" {
+		t.Skip("Skipping cme api test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckpointManagementCMEGWConfigurationsOCIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementCMEGWConfigurationsOCIConfig(accountName, gwConfigurationName, gwConfigurationVersion,
+					gwConfigurationBase64SIC, gwConfigurationPolicy, gwConfigurationXForwardedFor,
+					gwConfigurationColor, gwConfigurationCommunicationWithServersBehindNAT),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCheckpointManagementCMEGWConfigurationsOCIExists(resourceName, &ociGWConfiguration),
+					testAccCheckCheckpointManagementCMEGWConfigurationsOCIAttributes(&ociGWConfiguration, gwConfigurationName, accountName, gwConfigurationVersion,
+						gwConfigurationPolicy, true, true, gwConfigurationXForwardedFor,
+						gwConfigurationColor, gwConfigurationCommunicationWithServersBehindNAT),
+				),
+			},
+		},
+	})
+}
+func TestUnitCheckpointManagementCMEGWConfigurationsOCI_basic(t *testing.T) {
+	var ociGWConfiguration map[string]interface{}
+	resourceName := "checkpoint_management_cme_gw_configurations_oci.gw_configuration_test"
+	accountName := "tfTestCmeAccountOci"
+	gwConfigurationName := "tfTestCmeGwConfigurationOci"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if mock.cmeObject("gwConfigurations", gwConfigurationName) != nil {
+				return fmt.Errorf("OCI gw configuration %s still exists", gwConfigurationName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testAccManagementCMEGWConfigurationsOCIConfig(accountName, gwConfigurationName, "R82",
+					"MTIzNDU2Nzg=", "Standard", true, "blue", "translated-ip-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "related_account", accountName),
+					resource.TestCheckResourceAttr(resourceName, "identity_awareness_settings.0.enable_cloudguard_controller", "true"),
+					testUnitCheckMockCmeObject(mock, "gwConfigurations", gwConfigurationName, &ociGWConfiguration),
+					testAccCheckCheckpointManagementCMEGWConfigurationsOCIAttributes(&ociGWConfiguration, gwConfigurationName, accountName, "R82",
+						"Standard", true, true, true, "blue", "translated-ip-only"),
+				),
+			},
+			{
+				Config: mock.providerConfig() + testAccManagementCMEGWConfigurationsOCIConfig(accountName, gwConfigurationName, "R82",
+					"MTIzNDU2Nzg=", "Standard", false, "red", "translated-ip-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "color", "red"),
+					testUnitCheckMockCmeObject(mock, "gwConfigurations", gwConfigurationName, &ociGWConfiguration),
+					testAccCheckCheckpointManagementCMEGWConfigurationsOCIAttributes(&ociGWConfiguration, gwConfigurationName, accountName, "R82",
+						"Standard", true, true, false, "red", "translated-ip-only"),
+				),
+			},
+			{
+				Config: mock.providerConfig() + testAccManagementCMEGWConfigurationsOCIConfig(accountName, gwConfigurationName, "R82",
+					"MTIzNDU2Nzg=", "Standard", false, "red", "translated-ip-only") + `
+data "checkpoint_management_cme_gw_configurations_oci" "data_test" {
+  name = "${checkpoint_management_cme_gw_configurations_oci.gw_configuration_test.name}"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.checkpoint_management_cme_gw_configurations_oci.data_test", "related_account", resourceName, "related_account"),
+					resource.TestCheckResourceAttrPair("data.checkpoint_management_cme_gw_configurations_oci.data_test", "color", resourceName, "color"),
+					resource.TestCheckResourceAttr("data.checkpoint_management_cme_gw_configurations_oci.data_test", "blades.0.identity_awareness", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointManagementCMEGWConfigurationsOCI_apiVersion(t *testing.T) {
+	mock := newMockApiServer(t)
+	providerConfig := mock.providerConfig(hclArgument("cme_api_version", "v1.1"))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccManagementCMEGWConfigurationsOCIConfig("tfTestCmeAccountOci", "tfTestCmeGwConfigurationOci", "R82",
+					"MTIzNDU2Nzg=", "Standard", true, "blue", "translated-ip-only"),
+				ExpectError: regexp.MustCompile(`attribute identity_awareness_settings requires CME API v1.2, provider cme_api_version is v1.1`),
+			},
+		},
+	})
+}
+
+func testAccCheckpointManagementCMEGWConfigurationsOCIDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*checkpoint.ApiClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "checkpoint_management_cme_gw_configurations_oci" {
+			continue
+		}
+		if rs.Primary.ID != "" {
+			cmePath, err := cmeApiPath(client)
+			if err != nil {
+				return err
+			}
+			url := cmePath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
+			response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+			if err != nil {
+				return err
+			}
+			res := response.GetData()
+			if !checkIfRequestFailed(res) {
+				return fmt.Errorf("OCI gw configuration (%s) still exists", rs.Primary.Attributes["name"])
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccManagementCMEGWConfigurationsOCIConfig(accountName string, gwConfigurationName string, gwConfigurationVersion string,
+	gwConfigurationBase64SIC string, gwConfigurationPolicy string, gwConfigurationXForwardedFor bool,
+	gwConfigurationColor string, gwConfigurationCommunicationWithServersBehindNAT string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_cme_accounts_oci" "account_test" {
+  name             = "%s"
+  tenancy_id       = "ocid1.tenancy.oc1..aaaaaaaaexample"
+  user_id          = "ocid1.user.oc1..aaaaaaaaexample"
+  fingerprint      = "12:34:56:78:9a:bc:de:f0:12:34:56:78:9a:bc:de:f0"
+  private_key_file = "oci_api_key.pem"
+  regions          = ["us-ashburn-1"]
+}
+
+resource "checkpoint_management_cme_gw_configurations_oci" "gw_configuration_test" {
+  name            = "%s"
+  related_account = checkpoint_management_cme_accounts_oci.account_test.name
+  version         = "%s"
+  base64_sic_key  = "%s"
+  policy          = "%s"
+  x_forwarded_for =  %t
+  color           = "%s"
+  communication_with_servers_behind_nat = "%s"
+  blades {
+    content_awareness = true
+    identity_awareness = true
+	https_inspection = false
+    application_control = false
+    ips      = false
+    anti_bot = false
+	anti_virus = false
+	autonomous_threat_prevention = false
+	ipsec_vpn = false
+	threat_emulation = false
+	url_filtering = false
+	vpn = false
+  }
+  identity_awareness_settings {
+    enable_cloudguard_controller = true
+  }
+}
+`, accountName, gwConfigurationName, gwConfigurationVersion, gwConfigurationBase64SIC, gwConfigurationPolicy, gwConfigurationXForwardedFor,
+		gwConfigurationColor, gwConfigurationCommunicationWithServersBehindNAT)
+}
+
+func testAccCheckCheckpointManagementCMEGWConfigurationsOCIExists(resourceTfName string, res *map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceTfName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceTfName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID is not set")
+		}
+
+		client := testAccProvider.Meta().(*checkpoint.ApiClient)
+		cmePath, err := cmeApiPath(client)
+		if err != nil {
+			return err
+		}
+		url := cmePath + "/gwConfigurations/" + rs.Primary.Attributes["name"]
+		response, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
+		if err != nil {
+			return err
+		}
+
+		*res = response.GetData()
+		if checkIfRequestFailed(*res) {
+			errMessage := buildErrorMessage(*res)
+			return fmt.Errorf(errMessage)
+		}
+		return nil
+	}
+}
+
+func testAccCheckCheckpointManagementCMEGWConfigurationsOCIAttributes(ociGWConfiguration *map[string]interface{}, gwConfigurationName string,
+	accountName string, gwConfigurationVersion string, gwConfigurationPolicyName string, contentAwarenessFlag bool,
+	identityAwarenessFlag bool, gwConfigurationXForwardedFor bool, gwConfigurationColor string,
+	gwConfigurationCommunicationWithServersBehindNAT string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		gwConfiguration := (*ociGWConfiguration)["result"].(map[string]interface{})
+		if gwConfiguration["name"] != gwConfigurationName {
+			return fmt.Errorf("name is %s, expected %s", gwConfiguration["name"], gwConfigurationName)
+		}
+		if gwConfiguration["related_account"] != accountName {
+			return fmt.Errorf("related account name is %s, expected %s", gwConfiguration["related_account"], accountName)
+		}
+		if gwConfiguration["version"] != gwConfigurationVersion {
+			return fmt.Errorf("version is %s, expected %s", gwConfiguration["version"], gwConfigurationVersion)
+		}
+		if gwConfiguration["policy"] != gwConfigurationPolicyName {
+			return fmt.Errorf("policy is %s, expected %s", gwConfiguration["policy"], gwConfigurationPolicyName)
+		}
+		blades := gwConfiguration["blades"].(map[string]interface{})
+		contentAwareness := blades["content-awareness"]
+		identityAwareness := blades["identity-awareness"]
+		if contentAwareness != contentAwarenessFlag {
+			return fmt.Errorf("content awareness is %t, expected %t", contentAwareness, contentAwarenessFlag)
+		}
+		if identityAwareness != identityAwarenessFlag {
+			return fmt.Errorf("identity awareness is %t, expected %t", identityAwareness, identityAwarenessFlag)
+		}
+		IDASettings := gwConfiguration["identity-awareness-settings"].(map[string]interface{})
+		enableCgController := IDASettings["enable-cloudguard-controller"]
+		if enableCgController != identityAwarenessFlag {
+			return fmt.Errorf("enable-cloudguard-controller identity source is %t, expected %t", enableCgController, identityAwarenessFlag)
+		}
+		if gwConfiguration["x_forwarded_for"] != gwConfigurationXForwardedFor {
+			return fmt.Errorf("x_forwarded_for is %t, expected %t", gwConfiguration["x_forwarded_for"], gwConfigurationXForwardedFor)
+		}
+		if gwConfiguration["color"] != gwConfigurationColor {
+			return fmt.Errorf("color is %s, expected %s", gwConfiguration["color"], gwConfigurationColor)
+		}
+		if gwConfiguration["communication-with-servers-behind-nat"] != gwConfigurationCommunicationWithServersBehindNAT {
+			return fmt.Errorf("communication_with_servers_behind_nat is %s, expected %s", gwConfiguration["communication_with_servers_behind_nat"], gwConfigurationCommunicationWithServersBehindNAT)
+		}
+		return nil
+	}
+}
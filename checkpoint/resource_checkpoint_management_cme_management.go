@@ -67,7 +67,11 @@ func createUpdateManagementCMEManagement(d *schema.ResourceData, m interface{})
 
 	log.Println("Update cme management - payload = ", payload)
 
-	url := CmeApiPath + "/management"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/management"
 
 	cmeManagementRes, err := client.ApiCall(url, payload, client.GetSessionID(), true, client.IsProxyUsed(), "PUT")
 
@@ -87,7 +91,11 @@ func readManagementCMEManagement(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 
 	log.Println("Read cme management")
-	url := CmeApiPath + "/management"
+	cmePath, err := cmeApiPath(client)
+	if err != nil {
+		return err
+	}
+	url := cmePath + "/management"
 
 	cmeManagementRes, err := client.ApiCall(url, nil, client.GetSessionID(), true, client.IsProxyUsed(), "GET")
 
@@ -73,7 +73,7 @@ func createManagementConnectCloudServices(d *schema.ResourceData, m interface{})
 
     connectCloudServicesRes := ConnectCloudServicesRes.GetData()
 
-    log.Println("Connect Cloud Services - JSON = ", connectCloudServicesRes)
+    log.Println("Connect Cloud Services - JSON = ", redactPayload(d, connectCloudServicesRes))
 
     d.SetId("connect-cloud-services" + acctest.RandString(5))
 
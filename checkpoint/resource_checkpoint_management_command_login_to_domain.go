@@ -136,7 +136,7 @@ func createManagementLoginToDomain(d *schema.ResourceData, m interface{}) error
 
 	loginToDomain := LoginToDomainRes.GetData()
 
-	log.Println("Read Login To Domain - Show JSON = ", loginToDomain)
+	log.Println("Read Login To Domain - Show JSON = ", redactPayload(d, loginToDomain))
 
 	if v := loginToDomain["sid"]; v != nil {
 		_ = d.Set("sid", v)
@@ -52,7 +52,7 @@ func createManagementResetSic(d *schema.ResourceData, m interface{}) error {
 	_ = d.Set("task_id", resolveTaskId(ResetSicRes.GetData()))
 	resetSicStatusProfile := ResetSicRes.GetData()
 
-	log.Println("Read ResetSicStatus - Show JSON = ", resetSicStatusProfile)
+	log.Println("Read ResetSicStatus - Show JSON = ", redactPayload(d, resetSicStatusProfile))
 
 	if v := resetSicStatusProfile["message"]; v != nil {
 		_ = d.Set("message", v)
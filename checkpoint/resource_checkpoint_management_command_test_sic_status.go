@@ -66,7 +66,7 @@ func readManagementTestSicStatus(d *schema.ResourceData, m interface{}) error {
 	_ = d.Set("task_id", resolveTaskId(TestSicStatusRes.GetData()))
 	testSicStatusProfile := TestSicStatusRes.GetData()
 
-	log.Println("Read TestSicStatus - Show JSON = ", testSicStatusProfile)
+	log.Println("Read TestSicStatus - Show JSON = ", redactPayload(d, testSicStatusProfile))
 
 	if v := testSicStatusProfile["sic-message"]; v != nil {
 		_ = d.Set("sic_message", v)
@@ -15,6 +15,9 @@ func resourceDataCenterObject() *schema.Resource {
 		Read:   readManagementDataCenterObject,
 		Update: updateManagementDataCenterObject,
 		Delete: deleteManagementDataCenterObject,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"data_center_name": {
 				Type:        schema.TypeString,
@@ -305,7 +308,7 @@ func readManagementDataCenterObject(d *schema.ResourceData, m interface{}) error
 
 	dataCenterObj := showDataCenterObjRes.GetData()
 
-	log.Println("Read Data Center Object - Show JSON = ", dataCenterObj)
+	log.Println("Read Data Center Object - Show JSON = ", redactPayload(d, dataCenterObj))
 
 	if v := dataCenterObj["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -504,7 +507,7 @@ func updateManagementDataCenterObject(d *schema.ResourceData, m interface{}) err
 		dataCenter["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Data Center - Map = ", dataCenter)
+	log.Println("Update Data Center - Map = ", redactPayload(d, dataCenter))
 
 	updateDataCenterRes, err := client.ApiCall("set-data-center-object", dataCenter, client.GetSessionID(), true, false)
 	if err != nil || !updateDataCenterRes.Success {
@@ -10,11 +10,14 @@ import (
 )
 
 func resourceManagementDataCenterQuery() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementDataCenterQuery,
 		Read:   readManagementDataCenterQuery,
 		Update: updateManagementDataCenterQuery,
 		Delete: deleteManagementDataCenterQuery,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -88,7 +91,7 @@ func resourceManagementDataCenterQuery() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "1.7", nil)
 }
 
 func createManagementDataCenterQuery(d *schema.ResourceData, m interface{}) error {
@@ -156,7 +159,7 @@ func createManagementDataCenterQuery(d *schema.ResourceData, m interface{}) erro
 		dataCenterQuery["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataCenterQuery - Map = ", dataCenterQuery)
+	log.Println("Create DataCenterQuery - Map = ", redactPayload(d, dataCenterQuery))
 
 	addDataCenterQueryRes, err := client.ApiCall("add-data-center-query", dataCenterQuery, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDataCenterQueryRes.Success {
@@ -195,7 +198,7 @@ func readManagementDataCenterQuery(d *schema.ResourceData, m interface{}) error
 
 	KeysToFixedKeys := getKeysToFixedKeys()
 
-	log.Println("Read DataCenterQuery - Show JSON = ", dataCenterQuery)
+	log.Println("Read DataCenterQuery - Show JSON = ", redactPayload(d, dataCenterQuery))
 
 	if v := dataCenterQuery["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -366,7 +369,7 @@ func updateManagementDataCenterQuery(d *schema.ResourceData, m interface{}) erro
 		dataCenterQuery["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DataCenterQuery - Map = ", dataCenterQuery)
+	log.Println("Update DataCenterQuery - Map = ", redactPayload(d, dataCenterQuery))
 
 	updateDataCenterQueryRes, err := client.ApiCall("set-data-center-query", dataCenterQuery, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDataCenterQueryRes.Success {
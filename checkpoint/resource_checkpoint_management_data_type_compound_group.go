@@ -13,6 +13,9 @@ func resourceManagementDataTypeCompoundGroup() *schema.Resource {
 		Read:   readManagementDataTypeCompoundGroup,
 		Update: updateManagementDataTypeCompoundGroup,
 		Delete: deleteManagementDataTypeCompoundGroup,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -116,7 +119,7 @@ func createManagementDataTypeCompoundGroup(d *schema.ResourceData, m interface{}
 		dataTypeCompoundGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataTypeCompoundGroup - Map = ", dataTypeCompoundGroup)
+	log.Println("Create DataTypeCompoundGroup - Map = ", redactPayload(d, dataTypeCompoundGroup))
 
 	addDataTypeCompoundGroupRes, err := client.ApiCall("add-data-type-compound-group", dataTypeCompoundGroup, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeCompoundGroupRes.Success {
@@ -153,7 +156,7 @@ func readManagementDataTypeCompoundGroup(d *schema.ResourceData, m interface{})
 
 	dataTypeCompoundGroup := showDataTypeCompoundGroupRes.GetData()
 
-	log.Println("Read DataTypeCompoundGroup - Show JSON = ", dataTypeCompoundGroup)
+	log.Println("Read DataTypeCompoundGroup - Show JSON = ", redactPayload(d, dataTypeCompoundGroup))
 
 	if v := dataTypeCompoundGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -291,7 +294,7 @@ func updateManagementDataTypeCompoundGroup(d *schema.ResourceData, m interface{}
 		dataTypeCompoundGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DataTypeCompoundGroup - Map = ", dataTypeCompoundGroup)
+	log.Println("Update DataTypeCompoundGroup - Map = ", redactPayload(d, dataTypeCompoundGroup))
 
 	updateDataTypeCompoundGroupRes, err := client.ApiCall("set-data-type-compound-group", dataTypeCompoundGroup, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeCompoundGroupRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementDataTypeFileAttributes() *schema.Resource {
 		Read:   readManagementDataTypeFileAttributes,
 		Update: updateManagementDataTypeFileAttributes,
 		Delete: deleteManagementDataTypeFileAttributes,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -152,7 +155,7 @@ func createManagementDataTypeFileAttributes(d *schema.ResourceData, m interface{
 		dataTypeFileAttributes["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataTypeFileAttributes - Map = ", dataTypeFileAttributes)
+	log.Println("Create DataTypeFileAttributes - Map = ", redactPayload(d, dataTypeFileAttributes))
 
 	addDataTypeFileAttributesRes, err := client.ApiCall("add-data-type-file-attributes", dataTypeFileAttributes, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeFileAttributesRes.Success {
@@ -189,7 +192,7 @@ func readManagementDataTypeFileAttributes(d *schema.ResourceData, m interface{})
 
 	dataTypeFileAttributes := showDataTypeFileAttributesRes.GetData()
 
-	log.Println("Read DataTypeFileAttributes - Show JSON = ", dataTypeFileAttributes)
+	log.Println("Read DataTypeFileAttributes - Show JSON = ", redactPayload(d, dataTypeFileAttributes))
 
 	if v := dataTypeFileAttributes["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -341,7 +344,7 @@ func updateManagementDataTypeFileAttributes(d *schema.ResourceData, m interface{
 		dataTypeFileAttributes["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DataTypeFileAttributes - Map = ", dataTypeFileAttributes)
+	log.Println("Update DataTypeFileAttributes - Map = ", redactPayload(d, dataTypeFileAttributes))
 
 	updateDataTypeFileAttributesRes, err := client.ApiCall("set-data-type-file-attributes", dataTypeFileAttributes, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeFileAttributesRes.Success {
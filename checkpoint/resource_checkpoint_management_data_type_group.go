@@ -13,6 +13,9 @@ func resourceManagementDataTypeGroup() *schema.Resource {
 		Read:   readManagementDataTypeGroup,
 		Update: updateManagementDataTypeGroup,
 		Delete: deleteManagementDataTypeGroup,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -116,7 +119,7 @@ func createManagementDataTypeGroup(d *schema.ResourceData, m interface{}) error
 		dataTypeGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataTypeGroup - Map = ", dataTypeGroup)
+	log.Println("Create DataTypeGroup - Map = ", redactPayload(d, dataTypeGroup))
 
 	addDataTypeGroupRes, err := client.ApiCall("add-data-type-group", dataTypeGroup, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeGroupRes.Success {
@@ -153,7 +156,7 @@ func readManagementDataTypeGroup(d *schema.ResourceData, m interface{}) error {
 
 	dataTypeGroup := showDataTypeGroupRes.GetData()
 
-	log.Println("Read DataTypeGroup - Show JSON = ", dataTypeGroup)
+	log.Println("Read DataTypeGroup - Show JSON = ", redactPayload(d, dataTypeGroup))
 
 	if v := dataTypeGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -291,7 +294,7 @@ func updateManagementDataTypeGroup(d *schema.ResourceData, m interface{}) error
 		dataTypeGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DataTypeGroup - Map = ", dataTypeGroup)
+	log.Println("Update DataTypeGroup - Map = ", redactPayload(d, dataTypeGroup))
 
 	updateDataTypeGroupRes, err := client.ApiCall("set-data-type-group", dataTypeGroup, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeGroupRes.Success {
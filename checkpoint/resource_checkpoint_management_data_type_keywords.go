@@ -13,6 +13,9 @@ func resourceManagementDataTypeKeywords() *schema.Resource {
 		Read:   readManagementDataTypeKeywords,
 		Update: updateManagementDataTypeKeywords,
 		Delete: deleteManagementDataTypeKeywords,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -124,7 +127,7 @@ func createManagementDataTypeKeywords(d *schema.ResourceData, m interface{}) err
 		dataTypeKeywords["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataTypeKeywords - Map = ", dataTypeKeywords)
+	log.Println("Create DataTypeKeywords - Map = ", redactPayload(d, dataTypeKeywords))
 
 	addDataTypeKeywordsRes, err := client.ApiCall("add-data-type-keywords", dataTypeKeywords, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeKeywordsRes.Success {
@@ -161,7 +164,7 @@ func readManagementDataTypeKeywords(d *schema.ResourceData, m interface{}) error
 
 	dataTypeKeywords := showDataTypeKeywordsRes.GetData()
 
-	log.Println("Read DataTypeKeywords - Show JSON = ", dataTypeKeywords)
+	log.Println("Read DataTypeKeywords - Show JSON = ", redactPayload(d, dataTypeKeywords))
 
 	if v := dataTypeKeywords["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -280,7 +283,7 @@ func updateManagementDataTypeKeywords(d *schema.ResourceData, m interface{}) err
 		dataTypeKeywords["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DataTypeKeywords - Map = ", dataTypeKeywords)
+	log.Println("Update DataTypeKeywords - Map = ", redactPayload(d, dataTypeKeywords))
 
 	updateDataTypeKeywordsRes, err := client.ApiCall("set-data-type-keywords", dataTypeKeywords, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeKeywordsRes.Success {
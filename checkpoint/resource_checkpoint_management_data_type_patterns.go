@@ -13,6 +13,9 @@ func resourceManagementDataTypePatterns() *schema.Resource {
 		Read:   readManagementDataTypePatterns,
 		Update: updateManagementDataTypePatterns,
 		Delete: deleteManagementDataTypePatterns,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -114,7 +117,7 @@ func createManagementDataTypePatterns(d *schema.ResourceData, m interface{}) err
 		dataTypePatterns["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataTypePatterns - Map = ", dataTypePatterns)
+	log.Println("Create DataTypePatterns - Map = ", redactPayload(d, dataTypePatterns))
 
 	addDataTypePatternsRes, err := client.ApiCall("add-data-type-patterns", dataTypePatterns, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypePatternsRes.Success {
@@ -151,7 +154,7 @@ func readManagementDataTypePatterns(d *schema.ResourceData, m interface{}) error
 
 	dataTypePatterns := showDataTypePatternsRes.GetData()
 
-	log.Println("Read DataTypePatterns - Show JSON = ", dataTypePatterns)
+	log.Println("Read DataTypePatterns - Show JSON = ", redactPayload(d, dataTypePatterns))
 
 	if v := dataTypePatterns["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -262,7 +265,7 @@ func updateManagementDataTypePatterns(d *schema.ResourceData, m interface{}) err
 		dataTypePatterns["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DataTypePatterns - Map = ", dataTypePatterns)
+	log.Println("Update DataTypePatterns - Map = ", redactPayload(d, dataTypePatterns))
 
 	updateDataTypePatternsRes, err := client.ApiCall("set-data-type-patterns", dataTypePatterns, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypePatternsRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementDataTypeTraditionalGroup() *schema.Resource {
 		Read:   readManagementDataTypeTraditionalGroup,
 		Update: updateManagementDataTypeTraditionalGroup,
 		Delete: deleteManagementDataTypeTraditionalGroup,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -103,7 +106,7 @@ func createManagementDataTypeTraditionalGroup(d *schema.ResourceData, m interfac
 		dataTypeTraditionalGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataTypeTraditionalGroup - Map = ", dataTypeTraditionalGroup)
+	log.Println("Create DataTypeTraditionalGroup - Map = ", redactPayload(d, dataTypeTraditionalGroup))
 
 	addDataTypeTraditionalGroupRes, err := client.ApiCall("add-data-type-traditional-group", dataTypeTraditionalGroup, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeTraditionalGroupRes.Success {
@@ -140,7 +143,7 @@ func readManagementDataTypeTraditionalGroup(d *schema.ResourceData, m interface{
 
 	dataTypeTraditionalGroup := showDataTypeTraditionalGroupRes.GetData()
 
-	log.Println("Read DataTypeTraditionalGroup - Show JSON = ", dataTypeTraditionalGroup)
+	log.Println("Read DataTypeTraditionalGroup - Show JSON = ", redactPayload(d, dataTypeTraditionalGroup))
 
 	if v := dataTypeTraditionalGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -249,7 +252,7 @@ func updateManagementDataTypeTraditionalGroup(d *schema.ResourceData, m interfac
 	if v, ok := d.GetOkExists("ignore_errors"); ok {
 		dataTypeTraditionalGroup["ignore-errors"] = v.(bool)
 	}
-	log.Println("Update DataTypeTraditionalGroup - Map = ", dataTypeTraditionalGroup)
+	log.Println("Update DataTypeTraditionalGroup - Map = ", redactPayload(d, dataTypeTraditionalGroup))
 
 	updateDataTypeTraditionalGroupRes, err := client.ApiCall("set-data-type-traditional-group", dataTypeTraditionalGroup, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeTraditionalGroupRes.Success {
@@ -15,6 +15,9 @@ func resourceManagementDataTypeWeightedKeywords() *schema.Resource {
 		Read:   readManagementDataTypeWeightedKeywords,
 		Update: updateManagementDataTypeWeightedKeywords,
 		Delete: deleteManagementDataTypeWeightedKeywords,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -165,7 +168,7 @@ func createManagementDataTypeWeightedKeywords(d *schema.ResourceData, m interfac
 		dataTypeWeightedKeywords["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DataTypeWeightedKeywords - Map = ", dataTypeWeightedKeywords)
+	log.Println("Create DataTypeWeightedKeywords - Map = ", redactPayload(d, dataTypeWeightedKeywords))
 
 	addDataTypeWeightedKeywordsRes, err := client.ApiCall("add-data-type-weighted-keywords", dataTypeWeightedKeywords, client.GetSessionID(), true, false)
 	if err != nil || !addDataTypeWeightedKeywordsRes.Success {
@@ -202,7 +205,7 @@ func readManagementDataTypeWeightedKeywords(d *schema.ResourceData, m interface{
 
 	dataTypeWeightedKeywords := showDataTypeWeightedKeywordsRes.GetData()
 
-	log.Println("Read DataTypeWeightedKeywords - Show JSON = ", dataTypeWeightedKeywords)
+	log.Println("Read DataTypeWeightedKeywords - Show JSON = ", redactPayload(d, dataTypeWeightedKeywords))
 
 	if v := dataTypeWeightedKeywords["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -372,7 +375,7 @@ func updateManagementDataTypeWeightedKeywords(d *schema.ResourceData, m interfac
 		dataTypeWeightedKeywords["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DataTypeWeightedKeywords - Map = ", dataTypeWeightedKeywords)
+	log.Println("Update DataTypeWeightedKeywords - Map = ", redactPayload(d, dataTypeWeightedKeywords))
 
 	updateDataTypeWeightedKeywordsRes, err := client.ApiCall("set-data-type-weighted-keywords", dataTypeWeightedKeywords, client.GetSessionID(), true, false)
 	if err != nil || !updateDataTypeWeightedKeywordsRes.Success {
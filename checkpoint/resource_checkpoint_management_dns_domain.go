@@ -95,7 +95,7 @@ func createManagementDnsDomain(d *schema.ResourceData, m interface{}) error {
 		dnsDomain["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DnsDomain - Map = ", dnsDomain)
+	log.Println("Create DnsDomain - Map = ", redactPayload(d, dnsDomain))
 
 	addDnsDomainRes, err := client.ApiCall("add-dns-domain", dnsDomain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDnsDomainRes.Success {
@@ -132,7 +132,7 @@ func readManagementDnsDomain(d *schema.ResourceData, m interface{}) error {
 
 	dnsDomain := showDnsDomainRes.GetData()
 
-	log.Println("Read DnsDomain - Show JSON = ", dnsDomain)
+	log.Println("Read DnsDomain - Show JSON = ", redactPayload(d, dnsDomain))
 
 	if v := dnsDomain["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -220,7 +220,7 @@ func updateManagementDnsDomain(d *schema.ResourceData, m interface{}) error {
 		dnsDomain["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DnsDomain - Map = ", dnsDomain)
+	log.Println("Update DnsDomain - Map = ", redactPayload(d, dnsDomain))
 
 	updateDnsDomainRes, err := client.ApiCall("set-dns-domain", dnsDomain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDnsDomainRes.Success {
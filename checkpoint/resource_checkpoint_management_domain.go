@@ -14,6 +14,9 @@ func resourceManagementDomain() *schema.Resource {
 		Read:   readManagementDomain,
 		Update: updateManagementDomain,
 		Delete: deleteManagementDomain,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -154,7 +157,7 @@ func createManagementDomain(d *schema.ResourceData, m interface{}) error {
 		domain["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Domain - Map = ", domain)
+	log.Println("Create Domain - Map = ", redactPayload(d, domain))
 
 	addDomainRes, err := client.ApiCall("add-domain", domain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -204,7 +207,7 @@ func readManagementDomain(d *schema.ResourceData, m interface{}) error {
 
 	domain := showDomainRes.GetData()
 
-	log.Println("Read Domain - Show JSON = ", domain)
+	log.Println("Read Domain - Show JSON = ", redactPayload(d, domain))
 
 	if v := domain["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -333,7 +336,7 @@ func updateManagementDomain(d *schema.ResourceData, m interface{}) error {
 		domain["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Domain - Map = ", domain)
+	log.Println("Update Domain - Map = ", redactPayload(d, domain))
 
 	updateDomainRes, err := client.ApiCall("set-domain", domain, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDomainRes.Success {
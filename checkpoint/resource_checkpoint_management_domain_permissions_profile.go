@@ -15,6 +15,9 @@ func resourceManagementDomainPermissionsProfile() *schema.Resource {
 		Read:   readManagementDomainPermissionsProfile,
 		Update: updateManagementDomainPermissionsProfile,
 		Delete: deleteManagementDomainPermissionsProfile,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -813,7 +816,7 @@ func createManagementDomainPermissionsProfile(d *schema.ResourceData, m interfac
 		domainPermissionsProfile["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DomainPermissionsProfile - Map = ", domainPermissionsProfile)
+	log.Println("Create DomainPermissionsProfile - Map = ", redactPayload(d, domainPermissionsProfile))
 
 	addDomainPermissionsProfileRes, err := client.ApiCall("add-domain-permissions-profile", domainPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDomainPermissionsProfileRes.Success {
@@ -850,7 +853,7 @@ func readManagementDomainPermissionsProfile(d *schema.ResourceData, m interface{
 
 	domainPermissionsProfile := showDomainPermissionsProfileRes.GetData()
 
-	log.Println("Read DomainPermissionsProfile - Show JSON = ", domainPermissionsProfile)
+	log.Println("Read DomainPermissionsProfile - Show JSON = ", redactPayload(d, domainPermissionsProfile))
 
 	if v := domainPermissionsProfile["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1630,7 +1633,7 @@ func updateManagementDomainPermissionsProfile(d *schema.ResourceData, m interfac
 		domainPermissionsProfile["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DomainPermissionsProfile - Map = ", domainPermissionsProfile)
+	log.Println("Update DomainPermissionsProfile - Map = ", redactPayload(d, domainPermissionsProfile))
 
 	updateDomainPermissionsProfileRes, err := client.ApiCall("set-domain-permissions-profile", domainPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDomainPermissionsProfileRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementDynamicGlobalNetworkObject() *schema.Resource {
 		Read:   readManagementDynamicGlobalNetworkObject,
 		Update: updateManagementDynamicGlobalNetworkObject,
 		Delete: deleteManagementDynamicGlobalNetworkObject,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -83,7 +86,7 @@ func createManagementDynamicGlobalNetworkObject(d *schema.ResourceData, m interf
 		dynamicGlobalNetworkObject["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DynamicGlobalNetworkObject - Map = ", dynamicGlobalNetworkObject)
+	log.Println("Create DynamicGlobalNetworkObject - Map = ", redactPayload(d, dynamicGlobalNetworkObject))
 
 	addDynamicGlobalNetworkObjectRes, err := client.ApiCall("add-dynamic-global-network-object", dynamicGlobalNetworkObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDynamicGlobalNetworkObjectRes.Success {
@@ -120,7 +123,7 @@ func readManagementDynamicGlobalNetworkObject(d *schema.ResourceData, m interfac
 
 	dynamicGlobalNetworkObject := showDynamicGlobalNetworkObjectRes.GetData()
 
-	log.Println("Read DynamicGlobalNetworkObject - Show JSON = ", dynamicGlobalNetworkObject)
+	log.Println("Read DynamicGlobalNetworkObject - Show JSON = ", redactPayload(d, dynamicGlobalNetworkObject))
 
 	if v := dynamicGlobalNetworkObject["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -200,7 +203,7 @@ func updateManagementDynamicGlobalNetworkObject(d *schema.ResourceData, m interf
 		dynamicGlobalNetworkObject["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DynamicGlobalNetworkObject - Map = ", dynamicGlobalNetworkObject)
+	log.Println("Update DynamicGlobalNetworkObject - Map = ", redactPayload(d, dynamicGlobalNetworkObject))
 
 	updateDynamicGlobalNetworkObjectRes, err := client.ApiCall("set-dynamic-global-network-object", dynamicGlobalNetworkObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDynamicGlobalNetworkObjectRes.Success {
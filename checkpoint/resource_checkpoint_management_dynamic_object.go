@@ -86,7 +86,7 @@ func createManagementDynamicObject(d *schema.ResourceData, m interface{}) error
 		dynamicObject["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create DynamicObject - Map = ", dynamicObject)
+	log.Println("Create DynamicObject - Map = ", redactPayload(d, dynamicObject))
 
 	addDynamicObjectRes, err := client.ApiCall("add-dynamic-object", dynamicObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addDynamicObjectRes.Success {
@@ -123,7 +123,7 @@ func readManagementDynamicObject(d *schema.ResourceData, m interface{}) error {
 
 	dynamicObject := showDynamicObjectRes.GetData()
 
-	log.Println("Read DynamicObject - Show JSON = ", dynamicObject)
+	log.Println("Read DynamicObject - Show JSON = ", redactPayload(d, dynamicObject))
 
 	if v := dynamicObject["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -203,7 +203,7 @@ func updateManagementDynamicObject(d *schema.ResourceData, m interface{}) error
 		dynamicObject["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update DynamicObject - Map = ", dynamicObject)
+	log.Println("Update DynamicObject - Map = ", redactPayload(d, dynamicObject))
 
 	updateDynamicObjectRes, err := client.ApiCall("set-dynamic-object", dynamicObject, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateDynamicObjectRes.Success {
@@ -206,7 +206,7 @@ func createManagementExceptionGroup(d *schema.ResourceData, m interface{}) error
 		exceptionGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ExceptionGroup - Map = ", exceptionGroup)
+	log.Println("Create ExceptionGroup - Map = ", redactPayload(d, exceptionGroup))
 
 	addExceptionGroupRes, err := client.ApiCall("add-exception-group", exceptionGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addExceptionGroupRes.Success {
@@ -243,7 +243,7 @@ func readManagementExceptionGroup(d *schema.ResourceData, m interface{}) error {
 
 	exceptionGroup := showExceptionGroupRes.GetData()
 
-	log.Println("Read ExceptionGroup - Show JSON = ", exceptionGroup)
+	log.Println("Read ExceptionGroup - Show JSON = ", redactPayload(d, exceptionGroup))
 
 	if v := exceptionGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -413,7 +413,7 @@ func updateManagementExceptionGroup(d *schema.ResourceData, m interface{}) error
 		exceptionGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ExceptionGroup - Map = ", exceptionGroup)
+	log.Println("Update ExceptionGroup - Map = ", redactPayload(d, exceptionGroup))
 
 	updateExceptionGroupRes, err := client.ApiCall("set-exception-group", exceptionGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateExceptionGroupRes.Success {
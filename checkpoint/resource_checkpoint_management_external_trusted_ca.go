@@ -13,6 +13,9 @@ func resourceManagementExternalTrustedCa() *schema.Resource {
 		Read:   readManagementExternalTrustedCa,
 		Update: updateManagementExternalTrustedCa,
 		Delete: deleteManagementExternalTrustedCa,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -144,7 +147,7 @@ func createManagementExternalTrustedCa(d *schema.ResourceData, m interface{}) er
 		externalTrustedCa["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ExternalTrustedCa - Map = ", externalTrustedCa)
+	log.Println("Create ExternalTrustedCa - Map = ", redactPayload(d, externalTrustedCa))
 
 	addExternalTrustedCaRes, err := client.ApiCall("add-external-trusted-ca", externalTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !addExternalTrustedCaRes.Success {
@@ -181,7 +184,7 @@ func readManagementExternalTrustedCa(d *schema.ResourceData, m interface{}) erro
 
 	externalTrustedCa := showExternalTrustedCaRes.GetData()
 
-	log.Println("Read ExternalTrustedCa - Show JSON = ", externalTrustedCa)
+	log.Println("Read ExternalTrustedCa - Show JSON = ", redactPayload(d, externalTrustedCa))
 
 	if v := externalTrustedCa["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -326,7 +329,7 @@ func updateManagementExternalTrustedCa(d *schema.ResourceData, m interface{}) er
 		externalTrustedCa["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ExternalTrustedCa - Map = ", externalTrustedCa)
+	log.Println("Update ExternalTrustedCa - Map = ", redactPayload(d, externalTrustedCa))
 
 	updateExternalTrustedCaRes, err := client.ApiCall("set-external-trusted-ca", externalTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !updateExternalTrustedCaRes.Success {
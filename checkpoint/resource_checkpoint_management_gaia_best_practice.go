@@ -13,6 +13,9 @@ func resourceManagementGaiaBestPractice() *schema.Resource {
 		Read:   readManagementGaiaBestPractice,
 		Update: updateManagementGaiaBestPractice,
 		Delete: deleteManagementGaiaBestPractice,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"best_practice_id": {
 				Type:        schema.TypeString,
@@ -183,7 +186,7 @@ func createManagementGaiaBestPractice(d *schema.ResourceData, m interface{}) err
 		gaiaBestPractice["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create GaiaBestPractice - Map = ", gaiaBestPractice)
+	log.Println("Create GaiaBestPractice - Map = ", redactPayload(d, gaiaBestPractice))
 
 	addGaiaBestPracticeRes, err := client.ApiCall("add-gaia-best-practice", gaiaBestPractice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGaiaBestPracticeRes.Success {
@@ -220,7 +223,7 @@ func readManagementGaiaBestPractice(d *schema.ResourceData, m interface{}) error
 
 	gaiaBestPractice := showGaiaBestPracticeRes.GetData()
 
-	log.Println("Read GaiaBestPractice - Show JSON = ", gaiaBestPractice)
+	log.Println("Read GaiaBestPractice - Show JSON = ", redactPayload(d, gaiaBestPractice))
 
 	if v := gaiaBestPractice["best-practice-id"]; v != nil {
 		_ = d.Set("best_practice_id", v)
@@ -381,7 +384,7 @@ func updateManagementGaiaBestPractice(d *schema.ResourceData, m interface{}) err
 		gaiaBestPractice["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update GaiaBestPractice - Map = ", gaiaBestPractice)
+	log.Println("Update GaiaBestPractice - Map = ", redactPayload(d, gaiaBestPractice))
 
 	updateGaiaBestPracticeRes, err := client.ApiCall("set-gaia-best-practice", gaiaBestPractice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateGaiaBestPracticeRes.Success {
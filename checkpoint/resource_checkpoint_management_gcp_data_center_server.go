@@ -108,7 +108,7 @@ func createManagementGcpDataCenterServer(d *schema.ResourceData, m interface{})
 		gcpDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create gcpDataCenterServer - Map = ", gcpDataCenterServer)
+	log.Println("Create gcpDataCenterServer - Map = ", redactPayload(d, gcpDataCenterServer))
 
 	addGcpDataCenterServerRes, err := client.ApiCall("add-data-center-server", gcpDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -255,7 +255,7 @@ func updateManagementGcpDataCenterServer(d *schema.ResourceData, m interface{})
 		gcpDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update gcpDataCenterServer - Map = ", gcpDataCenterServer)
+	log.Println("Update gcpDataCenterServer - Map = ", redactPayload(d, gcpDataCenterServer))
 
 	updateGcpDataCenterServerRes, err := client.ApiCall("set-data-center-server", gcpDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -136,7 +136,7 @@ func createManagementGenericDataCenterServer(d *schema.ResourceData, m interface
 		genericDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create genericDataCenterServer - Map = ", genericDataCenterServer)
+	log.Println("Create genericDataCenterServer - Map = ", redactPayload(d, genericDataCenterServer))
 
 	addGenericDataCenterServerRes, err := client.ApiCall("add-data-center-server", genericDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -299,7 +299,7 @@ func updateManagementGenericDataCenterServer(d *schema.ResourceData, m interface
 		genericDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update genericDataCenterServer - Map = ", genericDataCenterServer)
+	log.Println("Update genericDataCenterServer - Map = ", redactPayload(d, genericDataCenterServer))
 
 	updateGenericDataCenterServerRes, err := client.ApiCall("set-data-center-server", genericDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
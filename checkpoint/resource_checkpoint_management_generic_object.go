@@ -0,0 +1,297 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"reflect"
+	"strings"
+)
+
+// resourceManagementGenericObject manages an object of any type by the add-, show-, set- and delete- commands of its
+// type, so that object types without a resource of their own can be managed. The object is identified by the UID that
+// the add- command returns.
+func resourceManagementGenericObject() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementGenericObject,
+		Read:   readManagementGenericObject,
+		Update: updateManagementGenericObject,
+		Delete: deleteManagementGenericObject,
+		Importer: &schema.ResourceImporter{
+			State: importManagementGenericObject,
+		},
+		Schema: map[string]*schema.Schema{
+			"add_command": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "API command that adds the object, e.g. add-host.",
+			},
+			"show_command": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API command that shows the object by its UID, e.g. show-host.",
+			},
+			"set_command": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API command that changes the object by its UID, e.g. set-host.",
+			},
+			"delete_command": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API command that deletes the object by its UID, e.g. delete-host.",
+			},
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Fields of the object in JSON format, that are sent to the add and set commands.",
+				ValidateFunc:     validateJsonObject,
+				DiffSuppressFunc: suppressEquivalentJson,
+			},
+			"tracked_fields": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Fields of body that are read back by the show command, so that changes outside of Terraform are detected.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"object": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The object as the show command returns it, in JSON format.",
+			},
+		},
+	}
+}
+
+func createManagementGenericObject(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload, err := genericObjectBody(d.Get("body").(string))
+	if err != nil {
+		return err
+	}
+
+	addCommand := d.Get("add_command").(string)
+	log.Println("Create Generic Object - Map = ", redactPayload(d, payload))
+
+	addGenericObjectRes, err := client.ApiCall(addCommand, payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !addGenericObjectRes.Success {
+		if addGenericObjectRes.ErrorMsg != "" {
+			return fmt.Errorf(addGenericObjectRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	uid, _ := addGenericObjectRes.GetData()["uid"].(string)
+	if uid == "" {
+		return fmt.Errorf("the reply of %s has no uid", addCommand)
+	}
+	d.SetId(uid)
+
+	return readManagementGenericObject(d, m)
+}
+
+func readManagementGenericObject(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"uid": d.Id(),
+	}
+
+	showGenericObjectRes, err := client.ApiCall(d.Get("show_command").(string), payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showGenericObjectRes.Success {
+		if code, _ := showGenericObjectRes.GetData()["code"].(string); objectNotFound(code) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(showGenericObjectRes.ErrorMsg)
+	}
+
+	genericObject := showGenericObjectRes.GetData()
+
+	log.Println("Read Generic Object - Show JSON = ", redactPayload(d, genericObject))
+
+	objectJson, err := json.Marshal(genericObject)
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	_ = d.Set("object", string(objectJson))
+
+	// Only the tracked fields are taken from the object, the other fields are kept as configured
+	body, err := genericObjectBody(d.Get("body").(string))
+	if err != nil {
+		return err
+	}
+	for _, field := range d.Get("tracked_fields").(*schema.Set).List() {
+		field := field.(string)
+		if v, ok := genericObject[field]; ok {
+			body[field] = genericObjectFieldValue(body[field], v)
+		} else {
+			delete(body, field)
+		}
+	}
+	bodyJson, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	_ = d.Set("body", string(bodyJson))
+
+	return nil
+}
+
+func updateManagementGenericObject(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	if d.HasChange("body") {
+		payload, err := genericObjectBody(d.Get("body").(string))
+		if err != nil {
+			return err
+		}
+
+		// The object is identified by its UID, so a name in the body renames it
+		if name, ok := payload["name"]; ok {
+			delete(payload, "name")
+			payload["new-name"] = name
+		}
+		payload["uid"] = d.Id()
+
+		log.Println("Update Generic Object - Map = ", redactPayload(d, payload))
+
+		setGenericObjectRes, err := client.ApiCall(d.Get("set_command").(string), payload, client.GetSessionID(), true, client.IsProxyUsed())
+		if err != nil || !setGenericObjectRes.Success {
+			if setGenericObjectRes.ErrorMsg != "" {
+				return fmt.Errorf(setGenericObjectRes.ErrorMsg)
+			}
+			return fmt.Errorf(err.Error())
+		}
+	}
+
+	return readManagementGenericObject(d, m)
+}
+
+func deleteManagementGenericObject(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"uid": d.Id(),
+	}
+
+	deleteGenericObjectRes, err := client.ApiCall(d.Get("delete_command").(string), payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !deleteGenericObjectRes.Success {
+		if code, _ := deleteGenericObjectRes.GetData()["code"].(string); objectNotFound(code) {
+			d.SetId("")
+			return nil
+		}
+		if deleteGenericObjectRes.ErrorMsg != "" {
+			return fmt.Errorf(deleteGenericObjectRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+	d.SetId("")
+
+	return nil
+}
+
+// importManagementGenericObject imports an object by <SHOW_COMMAND>;<UID>. The other commands are named after the show
+// command, and the body is left empty until the next apply.
+func importManagementGenericObject(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, uid, err := parseCompositeId(d.Id(), "<SHOW_COMMAND>;<UID>", 1)
+	if err != nil {
+		return nil, err
+	}
+	showCommand := values[0]
+	if !strings.HasPrefix(showCommand, "show-") {
+		return nil, fmt.Errorf("%s is not a show command", showCommand)
+	}
+	objectType := strings.TrimPrefix(showCommand, "show-")
+
+	_ = d.Set("add_command", "add-"+objectType)
+	_ = d.Set("show_command", showCommand)
+	_ = d.Set("set_command", "set-"+objectType)
+	_ = d.Set("delete_command", "delete-"+objectType)
+	_ = d.Set("body", "{}")
+	d.SetId(uid)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func genericObjectBody(body string) (map[string]interface{}, error) {
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(body), &payload); err != nil {
+		return nil, fmt.Errorf("invalid body: %s", err.Error())
+	}
+	return payload, nil
+}
+
+// genericObjectFieldValue returns the value of a field of the object in the form of the configured value. The API
+// returns references to other objects as objects, that are taken as the name or the UID that was configured. Lists
+// are compared as sets, since the API does not keep the order of e.g. members. A list that holds the configured
+// items in another order is returned in the configured order.
+func genericObjectFieldValue(configured interface{}, value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := configured.(string); ok {
+			if v["uid"] == ref {
+				return ref
+			}
+			if name, ok := v["name"].(string); ok {
+				return name
+			}
+		}
+	case []interface{}:
+		configuredList, ok := configured.([]interface{})
+		if !ok {
+			return value
+		}
+		list := make([]interface{}, len(v))
+		used := make([]bool, len(configuredList))
+		matched := 0
+		for i := range v {
+			found := false
+			for j, c := range configuredList {
+				if used[j] {
+					continue
+				}
+				if item := genericObjectFieldValue(c, v[i]); reflect.DeepEqual(item, c) {
+					list[i], used[j], found = item, true, true
+					matched++
+					break
+				}
+			}
+			if found {
+				continue
+			}
+			var c interface{}
+			if i < len(configuredList) {
+				c = configuredList[i]
+			}
+			list[i] = genericObjectFieldValue(c, v[i])
+		}
+		if matched == len(v) && matched == len(configuredList) {
+			return configuredList
+		}
+		return list
+	}
+	return value
+}
+
+// suppressEquivalentJson suppresses the diff of JSON values that differ only in formatting, e.g. in white space or in
+// the order of fields.
+func suppressEquivalentJson(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
@@ -0,0 +1,163 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestAccCheckpointManagementGenericObject_basic(t *testing.T) {
+	resourceName := "checkpoint_management_generic_object.test"
+	objName := "tfTestManagementGenericObject_" + acctest.RandString(6)
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementGenericObjectConfig(objName, "blue", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "object"),
+				),
+			},
+			{
+				Config: testAccManagementGenericObjectConfig(objName, "red", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "object"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointManagementGenericObject_basic(t *testing.T) {
+	resourceName := "checkpoint_management_generic_object.test"
+	objName := "tfTestManagementGenericObject_" + acctest.RandString(6)
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if mock.object("host", objName) != nil {
+				return fmt.Errorf("host %s still exists", objName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testAccManagementGenericObjectConfig(objName, "blue", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "object"),
+					testUnitCheckMockObject(mock, "host", objName, "color", "blue"),
+					testUnitCheckMockObject(mock, "host", objName, "comments", "first"),
+				),
+			},
+			{
+				// A change of a tracked field outside of Terraform is detected
+				PreConfig: func() {
+					mock.setField("host", objName, "color", "red")
+				},
+				Config:             mock.providerConfig() + testAccManagementGenericObjectConfig(objName, "blue", "first"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The set command restores the tracked field
+				Config: mock.providerConfig() + testAccManagementGenericObjectConfig(objName, "blue", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckMockObject(mock, "host", objName, "color", "blue"),
+					testUnitCheckCallCount(mock, "set-host", 1),
+				),
+			},
+			{
+				// A change of a field that is not tracked is not detected
+				PreConfig: func() {
+					mock.setField("host", objName, "comments", "changed")
+				},
+				Config:   mock.providerConfig() + testAccManagementGenericObjectConfig(objName, "blue", "first"),
+				PlanOnly: true,
+			},
+			{
+				Config: mock.providerConfig() + testAccManagementGenericObjectConfig(objName, "green", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckMockObject(mock, "host", objName, "color", "green"),
+					testUnitCheckMockObject(mock, "host", objName, "comments", "second"),
+					testUnitCheckCallCount(mock, "set-host", 2),
+				),
+			},
+			{
+				Config:                  mock.providerConfig() + testAccManagementGenericObjectConfig(objName, "green", "second"),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testUnitGenericObjectImportId(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body", "tracked_fields"},
+			},
+		},
+	})
+}
+
+func testUnitGenericObjectImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return "show-host;" + rs.Primary.ID, nil
+	}
+}
+
+func testAccManagementGenericObjectConfig(name string, color string, comments string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_generic_object" "test" {
+  add_command = "add-host"
+  show_command = "show-host"
+  set_command = "set-host"
+  delete_command = "delete-host"
+  body = <<EOT
+  {
+    "name": "%s",
+    "ipv4-address": "192.0.2.10",
+    "color": "%s",
+    "comments": "%s",
+    "tags": ["%s_tag"]
+  }
+  EOT
+  tracked_fields = ["ipv4-address", "color", "tags"]
+}
+`, name, color, comments, name)
+}
+
+func TestUnitGenericObjectFieldValue(t *testing.T) {
+	members := []interface{}{
+		map[string]interface{}{"name": "host1", "uid": "a0ae5bd4-c9a5-4b12-9d58-4a4fb4e14a01"},
+		map[string]interface{}{"name": "host2", "uid": "a0ae5bd4-c9a5-4b12-9d58-4a4fb4e14a02"},
+	}
+	tests := []struct {
+		configured interface{}
+		expected   interface{}
+	}{
+		{[]interface{}{"host1", "host2"}, []interface{}{"host1", "host2"}},
+		// The order of the reply does not matter
+		{[]interface{}{"host2", "a0ae5bd4-c9a5-4b12-9d58-4a4fb4e14a01"}, []interface{}{"host2", "a0ae5bd4-c9a5-4b12-9d58-4a4fb4e14a01"}},
+		{[]interface{}{"host2"}, []interface{}{"host1", "host2"}},
+		{[]interface{}{"host2", "host3"}, []interface{}{"host1", "host2"}},
+	}
+	for _, test := range tests {
+		if v := genericObjectFieldValue(test.configured, members); !reflect.DeepEqual(v, test.expected) {
+			t.Errorf("value of %v is %v, expected %v", test.configured, v, test.expected)
+		}
+	}
+}
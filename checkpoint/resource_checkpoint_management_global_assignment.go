@@ -13,6 +13,9 @@ func resourceManagementGlobalAssignment() *schema.Resource {
 		Read:   readManagementGlobalAssignment,
 		Update: updateManagementGlobalAssignment,
 		Delete: deleteManagementGlobalAssignment,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"dependent_domain": {
 				Type:        schema.TypeString,
@@ -109,7 +112,7 @@ func createManagementGlobalAssignment(d *schema.ResourceData, m interface{}) err
 		globalAssignment["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create GlobalAssignment - Map = ", globalAssignment)
+	log.Println("Create GlobalAssignment - Map = ", redactPayload(d, globalAssignment))
 
 	addGlobalAssignmentRes, err := client.ApiCall("add-global-assignment", globalAssignment, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGlobalAssignmentRes.Success {
@@ -146,7 +149,7 @@ func readManagementGlobalAssignment(d *schema.ResourceData, m interface{}) error
 
 	globalAssignment := showGlobalAssignmentRes.GetData()
 
-	log.Println("Read GlobalAssignment - Show JSON = ", globalAssignment)
+	log.Println("Read GlobalAssignment - Show JSON = ", redactPayload(d, globalAssignment))
 
 	if v := globalAssignment["dependent-domain"]; v != nil {
 		_ = d.Set("dependent_domain", v)
@@ -225,7 +228,7 @@ func updateManagementGlobalAssignment(d *schema.ResourceData, m interface{}) err
 		globalAssignment["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update GlobalAssignment - Map = ", globalAssignment)
+	log.Println("Update GlobalAssignment - Map = ", redactPayload(d, globalAssignment))
 
 	updateGlobalAssignmentRes, err := client.ApiCall("set-global-assignment", globalAssignment, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateGlobalAssignmentRes.Success {
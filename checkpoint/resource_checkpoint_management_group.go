@@ -93,7 +93,7 @@ func createManagementGroup(d *schema.ResourceData, m interface{}) error {
 		group["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Group - Map = ", group)
+	log.Println("Create Group - Map = ", redactPayload(d, group))
 
 	addGroupRes, err := client.ApiCall("add-group", group, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGroupRes.Success {
@@ -220,7 +220,7 @@ func updateManagementGroup(d *schema.ResourceData, m interface{}) error {
 		group["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Group - Map = ", group)
+	log.Println("Update Group - Map = ", redactPayload(d, group))
 	setGroupRes, _ := client.ApiCall("set-group", group, client.GetSessionID(), true, client.IsProxyUsed())
 	if !setGroupRes.Success {
 		return fmt.Errorf(setGroupRes.ErrorMsg)
@@ -118,3 +118,58 @@ resource "checkpoint_management_group" "test" {
 }
 `, name, member1)
 }
+
+// Resource group unit test against the mock API server. Members are added and removed by name.
+func TestUnitCheckpointManagementGroup_basic(t *testing.T) {
+
+	var groupMap map[string]interface{}
+	objName := "tfTestManagementGroup_" + acctest.RandString(6)
+
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testUnitManagementGroupConfig(objName, "member1"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						groupMap = mock.object("group", objName)
+						return nil
+					},
+					testAccCheckCheckpointGroupAttributes(&groupMap, objName, objName+"_member1"),
+				),
+			},
+			{
+				Config: mock.providerConfig() + testUnitManagementGroupConfig(objName, "member2"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						groupMap = mock.object("group", objName)
+						return nil
+					},
+					testAccCheckCheckpointGroupAttributes(&groupMap, objName, objName+"_member2"),
+					resource.TestCheckResourceAttr("checkpoint_management_group.test", "members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitManagementGroupConfig(name string, member string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_host" "member1" {
+    name = "%[1]s_member1"
+    ipv4_address = "192.0.2.1"
+}
+
+resource "checkpoint_management_host" "member2" {
+    name = "%[1]s_member2"
+    ipv4_address = "192.0.2.2"
+}
+
+resource "checkpoint_management_group" "test" {
+    name = "%[1]s"
+    members = [checkpoint_management_host.%[2]s.name]
+}
+`, name, member)
+}
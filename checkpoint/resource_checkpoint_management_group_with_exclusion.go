@@ -104,7 +104,7 @@ func createManagementGroupWithExclusion(d *schema.ResourceData, m interface{}) e
 		groupWithExclusion["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create GroupWithExclusion - Map = ", groupWithExclusion)
+	log.Println("Create GroupWithExclusion - Map = ", redactPayload(d, groupWithExclusion))
 
 	addGroupWithExclusionRes, err := client.ApiCall("add-group-with-exclusion", groupWithExclusion, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGroupWithExclusionRes.Success {
@@ -141,7 +141,7 @@ func readManagementGroupWithExclusion(d *schema.ResourceData, m interface{}) err
 
 	groupWithExclusion := showGroupWithExclusionRes.GetData()
 
-	log.Println("Read GroupWithExclusion - Show JSON = ", groupWithExclusion)
+	log.Println("Read GroupWithExclusion - Show JSON = ", redactPayload(d, groupWithExclusion))
 
 	if v := groupWithExclusion["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -237,7 +237,7 @@ func updateManagementGroupWithExclusion(d *schema.ResourceData, m interface{}) e
 		groupWithExclusion["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update GroupWithExclusion - Map = ", groupWithExclusion)
+	log.Println("Update GroupWithExclusion - Map = ", redactPayload(d, groupWithExclusion))
 
 	updateGroupWithExclusionRes, err := client.ApiCall("set-group-with-exclusion", groupWithExclusion, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateGroupWithExclusionRes.Success {
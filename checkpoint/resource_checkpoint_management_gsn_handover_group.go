@@ -13,6 +13,9 @@ func resourceManagementGsnHandoverGroup() *schema.Resource {
 		Read:   readManagementGsnHandoverGroup,
 		Update: updateManagementGsnHandoverGroup,
 		Delete: deleteManagementGsnHandoverGroup,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -113,7 +116,7 @@ func createManagementGsnHandoverGroup(d *schema.ResourceData, m interface{}) err
 		gsnHandoverGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create GsnHandoverGroup - Map = ", gsnHandoverGroup)
+	log.Println("Create GsnHandoverGroup - Map = ", redactPayload(d, gsnHandoverGroup))
 
 	addGsnHandoverGroupRes, err := client.ApiCall("add-gsn-handover-group", gsnHandoverGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGsnHandoverGroupRes.Success {
@@ -150,7 +153,7 @@ func readManagementGsnHandoverGroup(d *schema.ResourceData, m interface{}) error
 
 	gsnHandoverGroup := showGsnHandoverGroupRes.GetData()
 
-	log.Println("Read GsnHandoverGroup - Show JSON = ", gsnHandoverGroup)
+	log.Println("Read GsnHandoverGroup - Show JSON = ", redactPayload(d, gsnHandoverGroup))
 
 	if v := gsnHandoverGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -262,7 +265,7 @@ func updateManagementGsnHandoverGroup(d *schema.ResourceData, m interface{}) err
 		gsnHandoverGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update GsnHandoverGroup - Map = ", gsnHandoverGroup)
+	log.Println("Update GsnHandoverGroup - Map = ", redactPayload(d, gsnHandoverGroup))
 
 	updateGsnHandoverGroupRes, err := client.ApiCall("set-gsn-handover-group", gsnHandoverGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateGsnHandoverGroupRes.Success {
@@ -381,7 +381,7 @@ func createManagementHost(d *schema.ResourceData, m interface{}) error {
 		host["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Host - Map = ", host)
+	log.Println("Create Host - Map = ", redactPayload(d, host))
 
 	addHostRes, err := client.ApiCall("add-host", host, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addHostRes.Success {
@@ -419,7 +419,7 @@ func readManagementHost(d *schema.ResourceData, m interface{}) error {
 
 	host := showHostRes.GetData()
 
-	log.Println("Read Host - Show JSON = ", host)
+	log.Println("Read Host - Show JSON = ", redactPayload(d, host))
 
 	if v := host["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -800,7 +800,7 @@ func updateManagementHost(d *schema.ResourceData, m interface{}) error {
 		host["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Host - Map = ", host)
+	log.Println("Update Host - Map = ", redactPayload(d, host))
 	if len(host) != 3 {
 		updateHostRes, err := client.ApiCall("set-host", host, client.GetSessionID(), true, client.IsProxyUsed())
 		if err != nil || !updateHostRes.Success {
@@ -124,3 +124,71 @@ resource "checkpoint_management_host" "test" {
 }
 `, name, ipv4address, color)
 }
+
+// Resource host unit test against the mock API server:
+// 1. Create and update resource
+// 2. Import resource
+// 3. Revert a change that was made outside of Terraform
+// 4. Check resource destroy
+func TestUnitCheckpointManagementHost_basic(t *testing.T) {
+
+	mock := newMockApiServer(t)
+	resourceName := "checkpoint_management_host.test"
+	objName := "tfTestManagementHost_" + acctest.RandString(6)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if mock.object("host", objName) != nil {
+				return fmt.Errorf("host object (%s) still exists", objName)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testAccManagementHostConfig(objName, "192.167.2.3", "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					testUnitCheckMockObject(mock, "host", objName, "ipv4-address", "192.167.2.3"),
+					testUnitCheckMockObject(mock, "host", objName, "color", "blue"),
+				),
+			},
+			{
+				Config: mock.providerConfig() + testAccManagementHostConfig(objName, "192.167.2.4", "red"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4_address", "192.167.2.4"),
+					testUnitCheckMockObject(mock, "host", objName, "ipv4-address", "192.167.2.4"),
+					testUnitCheckMockObject(mock, "host", objName, "color", "red"),
+				),
+			},
+			{
+				Config:                  mock.providerConfig() + testAccManagementHostConfig(objName, "192.167.2.4", "red"),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_errors", "ignore_warnings"},
+			},
+			{
+				PreConfig: func() {
+					mock.setField("host", objName, "color", "green")
+				},
+				Config: mock.providerConfig() + testAccManagementHostConfig(objName, "192.167.2.4", "red"),
+				Check:  testUnitCheckMockObject(mock, "host", objName, "color", "red"),
+			},
+		},
+	})
+}
+
+// verifies a field of an object of the mock API server
+func testUnitCheckMockObject(mock *mockApiServer, objectType string, name string, field string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		obj := mock.object(objectType, name)
+		if obj == nil {
+			return fmt.Errorf("%s object (%s) not found", objectType, name)
+		}
+		if fmt.Sprint(obj[field]) != fmt.Sprint(value) {
+			return fmt.Errorf("%s is %v, expected %v", field, obj[field], value)
+		}
+		return nil
+	}
+}
@@ -9,7 +9,7 @@ import (
 )
 
 func resourceManagementHttpsLayer() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementHttpsLayer,
 		Read:   readManagementHttpsLayer,
 		Update: updateManagementHttpsLayer,
@@ -60,7 +60,7 @@ func resourceManagementHttpsLayer() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "1.6", nil)
 }
 
 func createManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
@@ -96,7 +96,7 @@ func createManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
 		httpsLayer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create HttpsLayer - Map = ", httpsLayer)
+	log.Println("Create HttpsLayer - Map = ", redactPayload(d, httpsLayer))
 
 	addHttpsLayerRes, err := client.ApiCall("add-https-layer", httpsLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addHttpsLayerRes.Success {
@@ -133,7 +133,7 @@ func readManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
 
 	httpsLayer := showHttpsLayerRes.GetData()
 
-	log.Println("Read HttpsLayer - Show JSON = ", httpsLayer)
+	log.Println("Read HttpsLayer - Show JSON = ", redactPayload(d, httpsLayer))
 
 	if v := httpsLayer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -221,7 +221,7 @@ func updateManagementHttpsLayer(d *schema.ResourceData, m interface{}) error {
 		httpsLayer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update HttpsLayer - Map = ", httpsLayer)
+	log.Println("Update HttpsLayer - Map = ", redactPayload(d, httpsLayer))
 
 	updateHttpsLayerRes, err := client.ApiCall("set-https-layer", httpsLayer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateHttpsLayerRes.Success {
@@ -8,13 +8,13 @@ import (
 )
 
 func resourceManagementHttpsRule() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementHttpsRule,
 		Read:   readManagementHttpsRule,
 		Update: updateManagementHttpsRule,
 		Delete: deleteManagementHttpsRule,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateCompositeId("<LAYER_IDENTIFIER>;<RULE_UID>", "layer"),
 		},
 		Schema: map[string]*schema.Schema{
 			"layer": {
@@ -141,28 +141,28 @@ func resourceManagementHttpsRule() *schema.Resource {
 						"top": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule on top of specific section identified by uid or name. Select value 'top' for entire rule base.",
 						},
 						"above": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule above specific section/rule identified by uid or name.",
 						},
 						"below": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule below specific section/rule identified by uid or name.",
 						},
 						"bottom": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							Description: "N/A",
+							Description: "Add rule in the bottom of specific section identified by uid or name. Select value 'bottom' for entire rule base.",
 						},
 					},
 				},
 			},
 		},
-	}
+	}, "1.6", nil)
 }
 
 func createManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
@@ -251,20 +251,28 @@ func createManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if _, ok := d.GetOk("position"); ok {
-		if _, ok := d.GetOk("position.top"); ok {
-			httpsRule["position"] = "top"
+		if v, ok := d.GetOk("position.top"); ok {
+			if v.(string) == "top" {
+				httpsRule["position"] = "top" // entire rule-base
+			} else {
+				httpsRule["position"] = map[string]interface{}{"top": v.(string)} // section-name
+			}
 		}
 		if v, ok := d.GetOk("position.above"); ok {
 			httpsRule["position"] = map[string]interface{}{"above": v.(string)}
 		}
-		if v, ok := d.GetOk("position.bottom"); ok {
-			httpsRule["position"] = map[string]interface{}{"bottom": v.(string)}
+		if v, ok := d.GetOk("position.below"); ok {
+			httpsRule["position"] = map[string]interface{}{"below": v.(string)}
 		}
-		if _, ok := d.GetOk("position.bottom"); ok {
-			httpsRule["position"] = "bottom"
+		if v, ok := d.GetOk("position.bottom"); ok {
+			if v.(string) == "bottom" {
+				httpsRule["position"] = "bottom" // entire rule-base
+			} else {
+				httpsRule["position"] = map[string]interface{}{"bottom": v.(string)} // section-name
+			}
 		}
 	}
-	log.Println("Create HttpsRule - Map = ", httpsRule)
+	log.Println("Create HttpsRule - Map = ", redactPayload(d, httpsRule))
 
 	addHttpsRuleRes, err := client.ApiCall("add-https-rule", httpsRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addHttpsRuleRes.Success {
@@ -276,6 +284,8 @@ func createManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(addHttpsRuleRes.GetData()["uid"].(string))
 
+	invalidateRulebaseCache(client, "show-https-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
 	return readManagementHttpsRule(d, m)
 }
 
@@ -302,7 +312,11 @@ func readManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 
 	httpsRule := showHttpsRuleRes.GetData()
 
-	log.Println("Read HttpsRule - Show JSON = ", httpsRule)
+	log.Println("Read HttpsRule - Show JSON = ", redactPayload(d, httpsRule))
+
+	if err := readRulePosition(client, "show-https-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)), d); err != nil {
+		return err
+	}
 
 	if v := httpsRule["rule-number"]; v != nil {
 		_ = d.Set("rule_number", v)
@@ -572,8 +586,12 @@ func updateManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 
 	if ok := d.HasChange("position"); ok {
 		if _, ok := d.GetOk("position"); ok {
-			if _, ok := d.GetOk("position.top"); ok {
-				httpsRule["new-position"] = "top"
+			if v, ok := d.GetOk("position.top"); ok {
+				if v.(string) == "top" {
+					httpsRule["new-position"] = "top" // entire rule-base
+				} else {
+					httpsRule["new-position"] = map[string]interface{}{"top": v.(string)} // specific section-name
+				}
 			}
 			if v, ok := d.GetOk("position.above"); ok {
 				httpsRule["new-position"] = map[string]interface{}{"above": v.(string)}
@@ -581,13 +599,17 @@ func updateManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 			if v, ok := d.GetOk("position.below"); ok {
 				httpsRule["new-position"] = map[string]interface{}{"below": v.(string)}
 			}
-			if _, ok := d.GetOk("position.bottom"); ok {
-				httpsRule["new-position"] = "bottom"
+			if v, ok := d.GetOk("position.bottom"); ok {
+				if v.(string) == "bottom" {
+					httpsRule["new-position"] = "bottom" // entire rule-base
+				} else {
+					httpsRule["new-position"] = map[string]interface{}{"bottom": v.(string)} // specific section-name
+				}
 			}
 		}
 	}
 
-	log.Println("Update HttpsRule - Map = ", httpsRule)
+	log.Println("Update HttpsRule - Map = ", redactPayload(d, httpsRule))
 
 	updateHttpsRuleRes, err := client.ApiCall("set-https-rule", httpsRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateHttpsRuleRes.Success {
@@ -597,6 +619,8 @@ func updateManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(err.Error())
 	}
 
+	invalidateRulebaseCache(client, "show-https-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
 	return readManagementHttpsRule(d, m)
 }
 
@@ -616,6 +640,7 @@ func deleteManagementHttpsRule(d *schema.ResourceData, m interface{}) error {
 		}
 		return fmt.Errorf(err.Error())
 	}
+	invalidateRulebaseCache(client, "show-https-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
 	d.SetId("")
 
 	return nil
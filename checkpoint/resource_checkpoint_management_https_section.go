@@ -8,13 +8,13 @@ import (
 )
 
 func resourceManagementHttpsSection() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementHttpsSection,
 		Read:   readManagementHttpsSection,
 		Update: updateManagementHttpsSection,
 		Delete: deleteManagementHttpsSection,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateCompositeId("<LAYER_IDENTIFIER>;<SECTION_UID>", "layer"),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -69,7 +69,7 @@ func resourceManagementHttpsSection() *schema.Resource {
 				},
 			},
 		},
-	}
+	}, "1.6", nil)
 }
 
 func createManagementHttpsSection(d *schema.ResourceData, m interface{}) error {
@@ -107,7 +107,7 @@ func createManagementHttpsSection(d *schema.ResourceData, m interface{}) error {
 			httpsSection["position"] = "bottom"
 		}
 	}
-	log.Println("Create HttpsSection - Map = ", httpsSection)
+	log.Println("Create HttpsSection - Map = ", redactPayload(d, httpsSection))
 
 	addHttpsSectionRes, err := client.ApiCall("add-https-section", httpsSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addHttpsSectionRes.Success {
@@ -145,7 +145,7 @@ func readManagementHttpsSection(d *schema.ResourceData, m interface{}) error {
 
 	httpsSection := showHttpsSectionRes.GetData()
 
-	log.Println("Read HttpsSection - Show JSON = ", httpsSection)
+	log.Println("Read HttpsSection - Show JSON = ", redactPayload(d, httpsSection))
 
 	if v := httpsSection["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -184,7 +184,7 @@ func updateManagementHttpsSection(d *schema.ResourceData, m interface{}) error {
 		httpsSection["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update HttpsSection - Map = ", httpsSection)
+	log.Println("Update HttpsSection - Map = ", redactPayload(d, httpsSection))
 
 	updateHttpsSectionRes, err := client.ApiCall("set-https-section", httpsSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateHttpsSectionRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementIdentityProvider() *schema.Resource {
 		Read:   readManagementIdentityProvider,
 		Update: updateManagementIdentityProvider,
 		Delete: deleteManagementIdentityProvider,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -155,7 +158,7 @@ func createManagementIdentityProvider(d *schema.ResourceData, m interface{}) err
 		identityProvider["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create IdentityProvider - Map = ", identityProvider)
+	log.Println("Create IdentityProvider - Map = ", redactPayload(d, identityProvider))
 
 	addIdentityProviderRes, err := client.ApiCallSimple("add-identity-provider", identityProvider)
 	if err != nil || !addIdentityProviderRes.Success {
@@ -192,7 +195,7 @@ func readManagementIdentityProvider(d *schema.ResourceData, m interface{}) error
 
 	identityProvider := showIdentityProviderRes.GetData()
 
-	log.Println("Read IdentityProvider - Show JSON = ", identityProvider)
+	log.Println("Read IdentityProvider - Show JSON = ", redactPayload(d, identityProvider))
 
 	if v := identityProvider["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -335,7 +338,7 @@ func updateManagementIdentityProvider(d *schema.ResourceData, m interface{}) err
 		identityProvider["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update IdentityProvider - Map = ", identityProvider)
+	log.Println("Update IdentityProvider - Map = ", redactPayload(d, identityProvider))
 
 	updateIdentityProviderRes, err := client.ApiCallSimple("set-identity-provider", identityProvider)
 	if err != nil || !updateIdentityProviderRes.Success {
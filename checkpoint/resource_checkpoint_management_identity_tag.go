@@ -13,6 +13,9 @@ func resourceManagementIdentityTag() *schema.Resource {
 		Read:   readManagementIdentityTag,
 		Update: updateManagementIdentityTag,
 		Delete: deleteManagementIdentityTag,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -92,7 +95,7 @@ func createManagementIdentityTag(d *schema.ResourceData, m interface{}) error {
 		identityTag["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create IdentityTag - Map = ", identityTag)
+	log.Println("Create IdentityTag - Map = ", redactPayload(d, identityTag))
 
 	addIdentityTagRes, err := client.ApiCall("add-identity-tag", identityTag, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addIdentityTagRes.Success {
@@ -129,7 +132,7 @@ func readManagementIdentityTag(d *schema.ResourceData, m interface{}) error {
 
 	identityTag := showIdentityTagRes.GetData()
 
-	log.Println("Read IdentityTag - Show JSON = ", identityTag)
+	log.Println("Read IdentityTag - Show JSON = ", redactPayload(d, identityTag))
 
 	if v := identityTag["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -208,7 +211,7 @@ func updateManagementIdentityTag(d *schema.ResourceData, m interface{}) error {
 		identityTag["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update IdentityTag - Map = ", identityTag)
+	log.Println("Update IdentityTag - Map = ", redactPayload(d, identityTag))
 
 	updateIdentityTagRes, err := client.ApiCall("set-identity-tag", identityTag, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateIdentityTagRes.Success {
@@ -15,6 +15,9 @@ func resourceManagementIdpAdministratorGroup() *schema.Resource {
 		Read:   readManagementIdpAdministratorGroup,
 		Update: updateManagementIdpAdministratorGroup,
 		Delete: deleteManagementIdpAdministratorGroup,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -146,7 +149,7 @@ func createManagementIdpAdministratorGroup(d *schema.ResourceData, m interface{}
 		idpAdministratorGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create IdpAdministratorGroup - Map = ", idpAdministratorGroup)
+	log.Println("Create IdpAdministratorGroup - Map = ", redactPayload(d, idpAdministratorGroup))
 
 	addIdpAdministratorGroupRes, err := client.ApiCall("add-idp-administrator-group", idpAdministratorGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addIdpAdministratorGroupRes.Success {
@@ -183,7 +186,7 @@ func readManagementIdpAdministratorGroup(d *schema.ResourceData, m interface{})
 
 	idpAdministratorGroup := showIdpAdministratorGroupRes.GetData()
 
-	log.Println("Read IdpAdministratorGroup - Show JSON = ", idpAdministratorGroup)
+	log.Println("Read IdpAdministratorGroup - Show JSON = ", redactPayload(d, idpAdministratorGroup))
 
 	if v := idpAdministratorGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -338,7 +341,7 @@ func updateManagementIdpAdministratorGroup(d *schema.ResourceData, m interface{}
 		idpAdministratorGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update IdpAdministratorGroup - Map = ", idpAdministratorGroup)
+	log.Println("Update IdpAdministratorGroup - Map = ", redactPayload(d, idpAdministratorGroup))
 
 	updateIdpAdministratorGroupRes, err := client.ApiCall("set-idp-administrator-group", idpAdministratorGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateIdpAdministratorGroupRes.Success {
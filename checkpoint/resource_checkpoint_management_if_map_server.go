@@ -14,6 +14,9 @@ func resourceManagementIfMapServer() *schema.Resource {
 		Read:   readManagementIfMapServer,
 		Update: updateManagementIfMapServer,
 		Delete: deleteManagementIfMapServer,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -218,7 +221,7 @@ func createManagementIfMapServer(d *schema.ResourceData, m interface{}) error {
 		ifMapServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create IfMapServer - Map = ", ifMapServer)
+	log.Println("Create IfMapServer - Map = ", redactPayload(d, ifMapServer))
 
 	addIfMapServerRes, err := client.ApiCallSimple("add-if-map-server", ifMapServer)
 	if err != nil || !addIfMapServerRes.Success {
@@ -255,7 +258,7 @@ func readManagementIfMapServer(d *schema.ResourceData, m interface{}) error {
 
 	ifMapServer := showIfMapServerRes.GetData()
 
-	log.Println("Read IfMapServer - Show JSON = ", ifMapServer)
+	log.Println("Read IfMapServer - Show JSON = ", redactPayload(d, ifMapServer))
 
 	if v := ifMapServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -473,7 +476,7 @@ func updateManagementIfMapServer(d *schema.ResourceData, m interface{}) error {
 		ifMapServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update IfMapServer - Map = ", ifMapServer)
+	log.Println("Update IfMapServer - Map = ", redactPayload(d, ifMapServer))
 
 	updateIfMapServerRes, err := client.ApiCallSimple("set-if-map-server", ifMapServer)
 	if err != nil || !updateIfMapServerRes.Success {
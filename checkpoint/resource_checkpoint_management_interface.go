@@ -14,6 +14,9 @@ func resourceManagementInterface() *schema.Resource {
 		Read:   readManagementInterface,
 		Update: updateManagementInterface,
 		Delete: deleteManagementInterface,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -572,7 +575,7 @@ func createManagementInterface(d *schema.ResourceData, m interface{}) error {
 		interfaceMap["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Interface - Map = ", interfaceMap)
+	log.Println("Create Interface - Map = ", redactPayload(d, interfaceMap))
 
 	addInterfaceRes, err := client.ApiCall("add-interface", interfaceMap, client.GetSessionID(), true, false)
 	if err != nil || !addInterfaceRes.Success {
@@ -609,7 +612,7 @@ func readManagementInterface(d *schema.ResourceData, m interface{}) error {
 
 	interfaceMap := showInterfaceRes.GetData()
 
-	log.Println("Read Interface - Show JSON = ", interfaceMap)
+	log.Println("Read Interface - Show JSON = ", redactPayload(d, interfaceMap))
 
 	if v := interfaceMap["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1106,7 +1109,7 @@ func updateManagementInterface(d *schema.ResourceData, m interface{}) error {
 		interfaceMap["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Interface - Map = ", interfaceMap)
+	log.Println("Update Interface - Map = ", redactPayload(d, interfaceMap))
 
 	updateInterfaceRes, err := client.ApiCall("set-interface", interfaceMap, client.GetSessionID(), true, false)
 	if err != nil || !updateInterfaceRes.Success {
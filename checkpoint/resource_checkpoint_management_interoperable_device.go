@@ -16,6 +16,9 @@ func resourceManagementInteroperableDevice() *schema.Resource {
 		Read:   readManagementInteroperableDevice,
 		Update: updateManagementInteroperableDevice,
 		Delete: deleteManagementInteroperableDevice,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -378,7 +381,7 @@ func createManagementInteroperableDevice(d *schema.ResourceData, m interface{})
 		interoperableDevice["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create InteroperableDevice - Map = ", interoperableDevice)
+	log.Println("Create InteroperableDevice - Map = ", redactPayload(d, interoperableDevice))
 
 	addInteroperableDeviceRes, err := client.ApiCall("add-interoperable-device", interoperableDevice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addInteroperableDeviceRes.Success {
@@ -415,7 +418,7 @@ func readManagementInteroperableDevice(d *schema.ResourceData, m interface{}) er
 
 	interoperableDevice := showInteroperableDeviceRes.GetData()
 
-	log.Println("Read InteroperableDevice - Show JSON = ", interoperableDevice)
+	log.Println("Read InteroperableDevice - Show JSON = ", redactPayload(d, interoperableDevice))
 
 	if v := interoperableDevice["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -790,7 +793,7 @@ func updateManagementInteroperableDevice(d *schema.ResourceData, m interface{})
 		interoperableDevice["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update InteroperableDevice - Map = ", interoperableDevice)
+	log.Println("Update InteroperableDevice - Map = ", redactPayload(d, interoperableDevice))
 
 	updateInteroperableDeviceRes, err := client.ApiCall("set-interoperable-device", interoperableDevice, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateInteroperableDeviceRes.Success {
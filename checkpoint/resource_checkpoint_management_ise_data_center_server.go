@@ -151,7 +151,7 @@ func createManagementIseDataCenterServer(d *schema.ResourceData, m interface{})
 		iseDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create iseDataCenterServer - Map = ", iseDataCenterServer)
+	log.Println("Create iseDataCenterServer - Map = ", redactPayload(d, iseDataCenterServer))
 
 	addIseDataCenterServerRes, err := client.ApiCall("add-data-center-server", iseDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -323,7 +323,7 @@ func updateManagementIseDataCenterServer(d *schema.ResourceData, m interface{})
 		iseDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update iseDataCenterServer - Map = ", iseDataCenterServer)
+	log.Println("Update iseDataCenterServer - Map = ", redactPayload(d, iseDataCenterServer))
 
 	updateIseDataCenterServerRes, err := client.ApiCall("set-data-center-server", iseDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
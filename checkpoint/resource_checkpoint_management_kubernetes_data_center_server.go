@@ -129,7 +129,7 @@ func createManagementKubernetesDataCenterServer(d *schema.ResourceData, m interf
 		kubernetesDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create kubernetesDataCenterServer - Map = ", kubernetesDataCenterServer)
+	log.Println("Create kubernetesDataCenterServer - Map = ", redactPayload(d, kubernetesDataCenterServer))
 
 	addKubernetesDataCenterServerRes, err := client.ApiCall("add-data-center-server", kubernetesDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -286,7 +286,7 @@ func updateManagementKubernetesDataCenterServer(d *schema.ResourceData, m interf
 		kubernetesDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update kubernetesDataCenterServer - Map = ", kubernetesDataCenterServer)
+	log.Println("Update kubernetesDataCenterServer - Map = ", redactPayload(d, kubernetesDataCenterServer))
 
 	updateKubernetesDataCenterServerRes, err := client.ApiCall("set-data-center-server", kubernetesDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -13,6 +13,9 @@ func resourceManagementLdapGroup() *schema.Resource {
 		Read:   readManagementLdapGroup,
 		Update: updateManagementLdapGroup,
 		Delete: deleteManagementLdapGroup,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -146,7 +149,7 @@ func createManagementLdapGroup(d *schema.ResourceData, m interface{}) error {
 		ldapGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create LdapGroup - Map = ", ldapGroup)
+	log.Println("Create LdapGroup - Map = ", redactPayload(d, ldapGroup))
 
 	addLdapGroupRes, err := client.ApiCallSimple("add-ldap-group", ldapGroup)
 	if err != nil || !addLdapGroupRes.Success {
@@ -183,7 +186,7 @@ func readManagementLdapGroup(d *schema.ResourceData, m interface{}) error {
 
 	ldapGroup := showLdapGroupRes.GetData()
 
-	log.Println("Read LdapGroup - Show JSON = ", ldapGroup)
+	log.Println("Read LdapGroup - Show JSON = ", redactPayload(d, ldapGroup))
 
 	if v := ldapGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -316,7 +319,7 @@ func updateManagementLdapGroup(d *schema.ResourceData, m interface{}) error {
 		ldapGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update LdapGroup - Map = ", ldapGroup)
+	log.Println("Update LdapGroup - Map = ", redactPayload(d, ldapGroup))
 
 	updateLdapGroupRes, err := client.ApiCallSimple("set-ldap-group", ldapGroup)
 	if err != nil || !updateLdapGroupRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementLimit() *schema.Resource {
 		Read:   readManagementLimit,
 		Update: updateManagementLimit,
 		Delete: deleteManagementLimit,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -143,7 +146,7 @@ func createManagementLimit(d *schema.ResourceData, m interface{}) error {
 		limit["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Limit - Map = ", limit)
+	log.Println("Create Limit - Map = ", redactPayload(d, limit))
 
 	addLimitRes, err := client.ApiCall("add-limit", limit, client.GetSessionID(), true, false)
 	if err != nil || !addLimitRes.Success {
@@ -180,7 +183,7 @@ func readManagementLimit(d *schema.ResourceData, m interface{}) error {
 
 	limit := showLimitRes.GetData()
 
-	log.Println("Read Limit - Show JSON = ", limit)
+	log.Println("Read Limit - Show JSON = ", redactPayload(d, limit))
 
 	if v := limit["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -308,7 +311,7 @@ func updateManagementLimit(d *schema.ResourceData, m interface{}) error {
 		limit["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Limit - Map = ", limit)
+	log.Println("Update Limit - Map = ", redactPayload(d, limit))
 
 	updateLimitRes, err := client.ApiCall("set-limit", limit, client.GetSessionID(), true, false)
 	if err != nil || !updateLimitRes.Success {
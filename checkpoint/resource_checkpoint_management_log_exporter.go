@@ -13,6 +13,9 @@ func resourceManagementLogExporter() *schema.Resource {
 		Read:   readManagementLogExporter,
 		Update: updateManagementLogExporter,
 		Delete: deleteManagementLogExporter,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -200,7 +203,7 @@ func createManagementLogExporter(d *schema.ResourceData, m interface{}) error {
 		logExporter["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create LogExporter - Map = ", logExporter)
+	log.Println("Create LogExporter - Map = ", redactPayload(d, logExporter))
 
 	addLogExporterRes, err := client.ApiCallSimple("add-log-exporter", logExporter)
 	if err != nil || !addLogExporterRes.Success {
@@ -237,7 +240,7 @@ func readManagementLogExporter(d *schema.ResourceData, m interface{}) error {
 
 	logExporter := showLogExporterRes.GetData()
 
-	log.Println("Read LogExporter - Show JSON = ", logExporter)
+	log.Println("Read LogExporter - Show JSON = ", redactPayload(d, logExporter))
 
 	if v := logExporter["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -425,7 +428,7 @@ func updateManagementLogExporter(d *schema.ResourceData, m interface{}) error {
 		logExporter["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update LogExporter - Map = ", logExporter)
+	log.Println("Update LogExporter - Map = ", redactPayload(d, logExporter))
 
 	updateLogExporterRes, err := client.ApiCallSimple("set-log-exporter", logExporter)
 	if err != nil || !updateLogExporterRes.Success {
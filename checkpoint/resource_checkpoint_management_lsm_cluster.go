@@ -14,6 +14,9 @@ func resourceManagementLsmCluster() *schema.Resource {
 		Read:   readManagementLsmCluster,
 		Update: updateManagementLsmCluster,
 		Delete: deleteManagementLsmCluster,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -559,7 +562,7 @@ func createManagementLsmCluster(d *schema.ResourceData, m interface{}) error {
 		lsmCluster["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create LsmCluster - Map = ", lsmCluster)
+	log.Println("Create LsmCluster - Map = ", redactPayload(d, lsmCluster))
 
 	addLsmClusterRes, err := client.ApiCall("add-lsm-cluster", lsmCluster, client.GetSessionID(), true, false)
 	if err != nil || !addLsmClusterRes.Success {
@@ -596,7 +599,7 @@ func readManagementLsmCluster(d *schema.ResourceData, m interface{}) error {
 
 	lsmCluster := showLsmClusterRes.GetData()
 
-	log.Println("Read LsmCluster - Show JSON = ", lsmCluster)
+	log.Println("Read LsmCluster - Show JSON = ", redactPayload(d, lsmCluster))
 
 	if v := lsmCluster["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1196,7 +1199,7 @@ func updateManagementLsmCluster(d *schema.ResourceData, m interface{}) error {
 		lsmCluster["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update LsmCluster - Map = ", lsmCluster)
+	log.Println("Update LsmCluster - Map = ", redactPayload(d, lsmCluster))
 
 	updateLsmClusterRes, err := client.ApiCall("set-lsm-cluster", lsmCluster, client.GetSessionID(), true, false)
 	if err != nil || !updateLsmClusterRes.Success {
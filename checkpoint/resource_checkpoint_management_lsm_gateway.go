@@ -13,6 +13,9 @@ func resourceManagementLsmGateway() *schema.Resource {
 		Read:   readManagementLsmGateway,
 		Update: updateManagementLsmGateway,
 		Delete: deleteManagementLsmGateway,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -404,7 +407,7 @@ func createManagementLsmGateway(d *schema.ResourceData, m interface{}) error {
 		lsmGateway["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create LsmGateway - Map = ", lsmGateway)
+	log.Println("Create LsmGateway - Map = ", redactPayload(d, lsmGateway))
 
 	addLsmGatewayRes, err := client.ApiCall("add-lsm-gateway", lsmGateway, client.GetSessionID(), true, false)
 	if err != nil || !addLsmGatewayRes.Success {
@@ -441,7 +444,7 @@ func readManagementLsmGateway(d *schema.ResourceData, m interface{}) error {
 
 	lsmGateway := showLsmGatewayRes.GetData()
 
-	log.Println("Read LsmGateway - Show JSON = ", lsmGateway)
+	log.Println("Read LsmGateway - Show JSON = ", redactPayload(d, lsmGateway))
 
 	if v := lsmGateway["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -870,7 +873,7 @@ func updateManagementLsmGateway(d *schema.ResourceData, m interface{}) error {
 		lsmGateway["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update LsmGateway - Map = ", lsmGateway)
+	log.Println("Update LsmGateway - Map = ", redactPayload(d, lsmGateway))
 
 	updateLsmGatewayRes, err := client.ApiCall("set-lsm-gateway", lsmGateway, client.GetSessionID(), true, false)
 	if err != nil || !updateLsmGatewayRes.Success {
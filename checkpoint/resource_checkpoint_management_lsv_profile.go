@@ -152,7 +152,7 @@ func createManagementLsvProfile(d *schema.ResourceData, m interface{}) error {
 		lsvProfile["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Lsv Profile - Map = ", lsvProfile)
+	log.Println("Create Lsv Profile - Map = ", redactPayload(d, lsvProfile))
 
 	addLsvProfileRes, err := client.ApiCall("add-lsv-profile", lsvProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addLsvProfileRes.Success {
@@ -189,7 +189,7 @@ func readManagementLsvProfile(d *schema.ResourceData, m interface{}) error {
 
 	lsvProfile := showLsvProfileRes.GetData()
 
-	log.Println("Read Lsv Profile - Show JSON = ", lsvProfile)
+	log.Println("Read Lsv Profile - Show JSON = ", redactPayload(d, lsvProfile))
 
 	if v := lsvProfile["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -338,7 +338,7 @@ func updateManagementLsvProfile(d *schema.ResourceData, m interface{}) error {
 		lsvProfile["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Lsv Profile - Map = ", lsvProfile)
+	log.Println("Update Lsv Profile - Map = ", redactPayload(d, lsvProfile))
 
 	updateLsvProfileRes, err := client.ApiCall("set-lsv-profile", lsvProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateLsvProfileRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementMdPermissionsProfile() *schema.Resource {
 		Read:   readManagementMdPermissionsProfile,
 		Update: updateManagementMdPermissionsProfile,
 		Delete: deleteManagementMdPermissionsProfile,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -219,7 +222,7 @@ func createManagementMdPermissionsProfile(d *schema.ResourceData, m interface{})
 		mdPermissionsProfile["permission-level"] = v.(string)
 	}
 
-	log.Println("Create MdPermissionsProfile - Map = ", mdPermissionsProfile)
+	log.Println("Create MdPermissionsProfile - Map = ", redactPayload(d, mdPermissionsProfile))
 
 	addMdPermissionsProfileRes, err := client.ApiCall("add-md-permissions-profile", mdPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addMdPermissionsProfileRes.Success {
@@ -256,7 +259,7 @@ func readManagementMdPermissionsProfile(d *schema.ResourceData, m interface{}) e
 
 	mdPermissionsProfile := showMdPermissionsProfileRes.GetData()
 
-	log.Println("Read MdPermissionsProfile - Show JSON = ", mdPermissionsProfile)
+	log.Println("Read MdPermissionsProfile - Show JSON = ", redactPayload(d, mdPermissionsProfile))
 
 	if v := mdPermissionsProfile["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -465,7 +468,7 @@ func updateManagementMdPermissionsProfile(d *schema.ResourceData, m interface{})
 		mdPermissionsProfile["permission-level"] = d.Get("permission_level")
 	}
 
-	log.Println("Update MdPermissionsProfile - Map = ", mdPermissionsProfile)
+	log.Println("Update MdPermissionsProfile - Map = ", redactPayload(d, mdPermissionsProfile))
 
 	updateMdPermissionsProfileRes, err := client.ApiCall("set-md-permissions-profile", mdPermissionsProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateMdPermissionsProfileRes.Success {
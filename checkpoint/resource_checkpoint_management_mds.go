@@ -13,6 +13,9 @@ func resourceManagementMds() *schema.Resource {
 		Read:   readManagementMds,
 		Update: updateManagementMds,
 		Delete: deleteManagementMds,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -193,7 +196,7 @@ func createManagementMds(d *schema.ResourceData, m interface{}) error {
 		mds["server-type"] = v.(string)
 	}
 
-	log.Println("Create Mds - Map = ", mds)
+	log.Println("Create Mds - Map = ", redactPayload(d, mds))
 
 	addMdsRes, err := client.ApiCall("add-mds", mds, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addMdsRes.Success {
@@ -230,7 +233,7 @@ func readManagementMds(d *schema.ResourceData, m interface{}) error {
 
 	mds := showMdsRes.GetData()
 
-	log.Println("Read Mds - Show JSON = ", mds)
+	log.Println("Read Mds - Show JSON = ", redactPayload(d, mds))
 
 	if v := mds["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -408,7 +411,7 @@ func updateManagementMds(d *schema.ResourceData, m interface{}) error {
 		mds["server-type"] = d.Get("server_type")
 	}
 
-	log.Println("Update Mds - Map = ", mds)
+	log.Println("Update Mds - Map = ", redactPayload(d, mds))
 
 	updateMdsRes, err := client.ApiCall("set-mds", mds, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateMdsRes.Success {
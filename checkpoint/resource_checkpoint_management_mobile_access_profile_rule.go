@@ -13,6 +13,9 @@ func resourceManagementMobileAccessProfileRule() *schema.Resource {
 		Read:   readManagementMobileAccessProfileRule,
 		Update: updateManagementMobileAccessProfileRule,
 		Delete: deleteManagementMobileAccessProfileRule,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -161,7 +164,7 @@ func createManagementMobileAccessProfileRule(d *schema.ResourceData, m interface
 		}
 	}
 
-	log.Println("Create MobileAccessProfileRule - Map = ", mobileAccessProfileRule)
+	log.Println("Create MobileAccessProfileRule - Map = ", redactPayload(d, mobileAccessProfileRule))
 
 	addMobileAccessProfileRuleRes, err := client.ApiCall("add-mobile-access-profile-rule", mobileAccessProfileRule, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessProfileRuleRes.Success {
@@ -198,7 +201,7 @@ func readManagementMobileAccessProfileRule(d *schema.ResourceData, m interface{}
 
 	mobileAccessProfileRule := showMobileAccessProfileRuleRes.GetData()
 
-	log.Println("Read MobileAccessProfileRule - Show JSON = ", mobileAccessProfileRule)
+	log.Println("Read MobileAccessProfileRule - Show JSON = ", redactPayload(d, mobileAccessProfileRule))
 
 	if v := mobileAccessProfileRule["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -344,7 +347,7 @@ func updateManagementMobileAccessProfileRule(d *schema.ResourceData, m interface
 		}
 	}
 
-	log.Println("Update MobileAccessProfileRule - Map = ", mobileAccessProfileRule)
+	log.Println("Update MobileAccessProfileRule - Map = ", redactPayload(d, mobileAccessProfileRule))
 
 	updateMobileAccessProfileRuleRes, err := client.ApiCall("set-mobile-access-profile-rule", mobileAccessProfileRule, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessProfileRuleRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementMobileAccessProfileSection() *schema.Resource {
 		Read:   readManagementMobileAccessProfileSection,
 		Update: updateManagementMobileAccessProfileSection,
 		Delete: deleteManagementMobileAccessProfileSection,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -119,7 +122,7 @@ func createManagementMobileAccessProfileSection(d *schema.ResourceData, m interf
 			}
 		}
 	}
-	log.Println("Create MobileAccessProfileSection - Map = ", mobileAccessProfileSection)
+	log.Println("Create MobileAccessProfileSection - Map = ", redactPayload(d, mobileAccessProfileSection))
 
 	addMobileAccessProfileSectionRes, err := client.ApiCall("add-mobile-access-profile-section", mobileAccessProfileSection, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessProfileSectionRes.Success {
@@ -156,7 +159,7 @@ func readManagementMobileAccessProfileSection(d *schema.ResourceData, m interfac
 
 	mobileAccessProfileSection := showMobileAccessProfileSectionRes.GetData()
 
-	log.Println("Read MobileAccessProfileSection - Show JSON = ", mobileAccessProfileSection)
+	log.Println("Read MobileAccessProfileSection - Show JSON = ", redactPayload(d, mobileAccessProfileSection))
 
 	if v := mobileAccessProfileSection["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -241,7 +244,7 @@ func updateManagementMobileAccessProfileSection(d *schema.ResourceData, m interf
 		}
 	}
 
-	log.Println("Update MobileAccessProfileSection - Map = ", mobileAccessProfileSection)
+	log.Println("Update MobileAccessProfileSection - Map = ", redactPayload(d, mobileAccessProfileSection))
 
 	updateMobileAccessProfileSectionRes, err := client.ApiCall("set-mobile-access-profile-section", mobileAccessProfileSection, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessProfileSectionRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementMobileAccessRule() *schema.Resource {
 		Read:   readManagementMobileAccessRule,
 		Update: updateManagementMobileAccessRule,
 		Delete: deleteManagementMobileAccessRule,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -173,7 +176,7 @@ func createManagementMobileAccessRule(d *schema.ResourceData, m interface{}) err
 			}
 		}
 	}
-	log.Println("Create MobileAccessRule - Map = ", mobileAccessRule)
+	log.Println("Create MobileAccessRule - Map = ", redactPayload(d, mobileAccessRule))
 
 	addMobileAccessRuleRes, err := client.ApiCall("add-mobile-access-rule", mobileAccessRule, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessRuleRes.Success {
@@ -210,7 +213,7 @@ func readManagementMobileAccessRule(d *schema.ResourceData, m interface{}) error
 
 	mobileAccessRule := showMobileAccessRuleRes.GetData()
 
-	log.Println("Read MobileAccessRule - Show JSON = ", mobileAccessRule)
+	log.Println("Read MobileAccessRule - Show JSON = ", redactPayload(d, mobileAccessRule))
 
 	if v := mobileAccessRule["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -385,7 +388,7 @@ func updateManagementMobileAccessRule(d *schema.ResourceData, m interface{}) err
 			}
 		}
 	}
-	log.Println("Update MobileAccessRule - Map = ", mobileAccessRule)
+	log.Println("Update MobileAccessRule - Map = ", redactPayload(d, mobileAccessRule))
 
 	updateMobileAccessRuleRes, err := client.ApiCall("set-mobile-access-rule", mobileAccessRule, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessRuleRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementMobileAccessSection() *schema.Resource {
 		Read:   readManagementMobileAccessSection,
 		Update: updateManagementMobileAccessSection,
 		Delete: deleteManagementMobileAccessSection,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -120,7 +123,7 @@ func createManagementMobileAccessSection(d *schema.ResourceData, m interface{})
 		}
 	}
 
-	log.Println("Create MobileAccessSection - Map = ", mobileAccessSection)
+	log.Println("Create MobileAccessSection - Map = ", redactPayload(d, mobileAccessSection))
 
 	addMobileAccessSectionRes, err := client.ApiCall("add-mobile-access-section", mobileAccessSection, client.GetSessionID(), true, false)
 	if err != nil || !addMobileAccessSectionRes.Success {
@@ -157,7 +160,7 @@ func readManagementMobileAccessSection(d *schema.ResourceData, m interface{}) er
 
 	mobileAccessSection := showMobileAccessSectionRes.GetData()
 
-	log.Println("Read MobileAccessSection - Show JSON = ", mobileAccessSection)
+	log.Println("Read MobileAccessSection - Show JSON = ", redactPayload(d, mobileAccessSection))
 
 	if v := mobileAccessSection["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -242,7 +245,7 @@ func updateManagementMobileAccessSection(d *schema.ResourceData, m interface{})
 		}
 	}
 
-	log.Println("Update MobileAccessSection - Map = ", mobileAccessSection)
+	log.Println("Update MobileAccessSection - Map = ", redactPayload(d, mobileAccessSection))
 
 	updateMobileAccessSectionRes, err := client.ApiCall("set-mobile-access-section", mobileAccessSection, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileAccessSectionRes.Success {
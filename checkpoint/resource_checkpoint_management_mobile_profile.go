@@ -13,6 +13,9 @@ func resourceManagementMobileProfile() *schema.Resource {
 		Read:   readManagementMobileProfile,
 		Update: updateManagementMobileProfile,
 		Delete: deleteManagementMobileProfile,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -794,7 +797,7 @@ func createManagementMobileProfile(d *schema.ResourceData, m interface{}) error
 		mobileProfile["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create MobileProfile - Map = ", mobileProfile)
+	log.Println("Create MobileProfile - Map = ", redactPayload(d, mobileProfile))
 
 	addMobileProfileRes, err := client.ApiCall("add-mobile-profile", mobileProfile, client.GetSessionID(), true, false)
 	if err != nil || !addMobileProfileRes.Success {
@@ -831,7 +834,7 @@ func readManagementMobileProfile(d *schema.ResourceData, m interface{}) error {
 
 	mobileProfile := showMobileProfileRes.GetData()
 
-	log.Println("Read MobileProfile - Show JSON = ", mobileProfile)
+	log.Println("Read MobileProfile - Show JSON = ", redactPayload(d, mobileProfile))
 
 	if v := mobileProfile["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1460,7 +1463,7 @@ func updateManagementMobileProfile(d *schema.ResourceData, m interface{}) error
 		mobileProfile["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update MobileProfile - Map = ", mobileProfile)
+	log.Println("Update MobileProfile - Map = ", redactPayload(d, mobileProfile))
 
 	updateMobileProfileRes, err := client.ApiCall("set-mobile-profile", mobileProfile, client.GetSessionID(), true, false)
 	if err != nil || !updateMobileProfileRes.Success {
@@ -140,7 +140,7 @@ func createManagementMulticastAddressRange(d *schema.ResourceData, m interface{}
 		multicastAddressRange["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create MulticastAddressRange - Map = ", multicastAddressRange)
+	log.Println("Create MulticastAddressRange - Map = ", redactPayload(d, multicastAddressRange))
 
 	addMulticastAddressRangeRes, err := client.ApiCall("add-multicast-address-range", multicastAddressRange, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addMulticastAddressRangeRes.Success {
@@ -177,7 +177,7 @@ func readManagementMulticastAddressRange(d *schema.ResourceData, m interface{})
 
 	multicastAddressRange := showMulticastAddressRangeRes.GetData()
 
-	log.Println("Read MulticastAddressRange - Show JSON = ", multicastAddressRange)
+	log.Println("Read MulticastAddressRange - Show JSON = ", redactPayload(d, multicastAddressRange))
 
 	if v := multicastAddressRange["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -305,7 +305,7 @@ func updateManagementMulticastAddressRange(d *schema.ResourceData, m interface{}
 		multicastAddressRange["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update MulticastAddressRange - Map = ", multicastAddressRange)
+	log.Println("Update MulticastAddressRange - Map = ", redactPayload(d, multicastAddressRange))
 
 	updateMulticastAddressRangeRes, err := client.ApiCall("set-multicast-address-range", multicastAddressRange, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateMulticastAddressRangeRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementMultipleKeyExchanges() *schema.Resource {
 		Read:   readManagementMultipleKeyExchanges,
 		Update: updateManagementMultipleKeyExchanges,
 		Delete: deleteManagementMultipleKeyExchanges,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -179,7 +182,7 @@ func createManagementMultipleKeyExchanges(d *schema.ResourceData, m interface{})
 		multipleKeyExchanges["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create MultipleKeyExchanges - Map = ", multipleKeyExchanges)
+	log.Println("Create MultipleKeyExchanges - Map = ", redactPayload(d, multipleKeyExchanges))
 
 	addMultipleKeyExchangesRes, err := client.ApiCall("add-multiple-key-exchanges", multipleKeyExchanges, client.GetSessionID(), true, false)
 	if err != nil || !addMultipleKeyExchangesRes.Success {
@@ -216,7 +219,7 @@ func readManagementMultipleKeyExchanges(d *schema.ResourceData, m interface{}) e
 
 	multipleKeyExchanges := showMultipleKeyExchangesRes.GetData()
 
-	log.Println("Read MultipleKeyExchanges - Show JSON = ", multipleKeyExchanges)
+	log.Println("Read MultipleKeyExchanges - Show JSON = ", redactPayload(d, multipleKeyExchanges))
 
 	if v := multipleKeyExchanges["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -419,7 +422,7 @@ func updateManagementMultipleKeyExchanges(d *schema.ResourceData, m interface{})
 		multipleKeyExchanges["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update MultipleKeyExchanges - Map = ", multipleKeyExchanges)
+	log.Println("Update MultipleKeyExchanges - Map = ", redactPayload(d, multipleKeyExchanges))
 
 	updateMultipleKeyExchangesRes, err := client.ApiCall("set-multiple-key-exchanges", multipleKeyExchanges, client.GetSessionID(), true, false)
 	if err != nil || !updateMultipleKeyExchangesRes.Success {
@@ -5,7 +5,6 @@ import (
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
-	"strings"
 )
 
 func resourceManagementNatRule() *schema.Resource {
@@ -15,15 +14,7 @@ func resourceManagementNatRule() *schema.Resource {
 		Update: updateManagementNatRule,
 		Delete: deleteManagementNatRule,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				arr := strings.Split(d.Id(), ";")
-				if len(arr) != 2 {
-					return nil, fmt.Errorf("invalid unique identifier format. UID format: <PACKAGE_NAME>;<RULE_UID>")
-				}
-				_ = d.Set("package", arr[0])
-				d.SetId(arr[1])
-				return []*schema.ResourceData{d}, nil
-			},
+			State: importStateCompositeId("<PACKAGE_NAME>;<RULE_UID>", "package"),
 		},
 		Schema: map[string]*schema.Schema{
 			"package": {
@@ -234,7 +225,7 @@ func createManagementNatRule(d *schema.ResourceData, m interface{}) error {
 		natRule["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create NAT Rule - Map = ", natRule)
+	log.Println("Create NAT Rule - Map = ", redactPayload(d, natRule))
 
 	addNatRuleRes, err := client.ApiCall("add-nat-rule", natRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addNatRuleRes.Success {
@@ -246,6 +237,8 @@ func createManagementNatRule(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(addNatRuleRes.GetData()["uid"].(string))
 
+	invalidateRulebaseCache(client, "show-nat-rulebase", map[string]interface{}{"package": d.Get("package")})
+
 	return readManagementNatRule(d, m)
 }
 
@@ -273,7 +266,11 @@ func readManagementNatRule(d *schema.ResourceData, m interface{}) error {
 
 	natRule := showNatRuleRes.GetData()
 
-	log.Println("Read NAT Rule - Show JSON = ", natRule)
+	log.Println("Read NAT Rule - Show JSON = ", redactPayload(d, natRule))
+
+	if err := readRulePosition(client, "show-nat-rulebase", map[string]interface{}{"package": d.Get("package")}, d); err != nil {
+		return err
+	}
 
 	if v := natRule["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -432,7 +429,7 @@ func updateManagementNatRule(d *schema.ResourceData, m interface{}) error {
 		natRule["comments"] = d.Get("comments")
 	}
 
-	log.Println("Update NAT Rule - Map = ", natRule)
+	log.Println("Update NAT Rule - Map = ", redactPayload(d, natRule))
 
 	updateNatRuleRes, err := client.ApiCall("set-nat-rule", natRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateNatRuleRes.Success {
@@ -441,6 +438,8 @@ func updateManagementNatRule(d *schema.ResourceData, m interface{}) error {
 		}
 		return fmt.Errorf(err.Error())
 	}
+	invalidateRulebaseCache(client, "show-nat-rulebase", map[string]interface{}{"package": d.Get("package")})
+
 	return readManagementNatRule(d, m)
 }
 
@@ -461,6 +460,7 @@ func deleteManagementNatRule(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(err.Error())
 	}
 
+	invalidateRulebaseCache(client, "show-nat-rulebase", map[string]interface{}{"package": d.Get("package")})
 	d.SetId("")
 	return nil
 }
@@ -13,6 +13,9 @@ func resourceManagementNatSection() *schema.Resource {
 		Read:   readManagementNatSection,
 		Update: updateManagementNatSection,
 		Delete: deleteManagementNatSection,
+		Importer: &schema.ResourceImporter{
+			State: importStateCompositeId("<PACKAGE_NAME>;<SECTION_UID>", "package"),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -117,7 +120,7 @@ func createManagementNatSection(d *schema.ResourceData, m interface{}) error {
 		natSection["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create NAT section - Map = ", natSection)
+	log.Println("Create NAT section - Map = ", redactPayload(d, natSection))
 
 	addNatSectionRes, err := client.ApiCall("add-nat-section", natSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addNatSectionRes.Success {
@@ -155,7 +158,7 @@ func readManagementNatSection(d *schema.ResourceData, m interface{}) error {
 
 	natSection := showNatSectionRes.GetData()
 
-	log.Println("Read NatSection - Show JSON = ", natSection)
+	log.Println("Read NatSection - Show JSON = ", redactPayload(d, natSection))
 
 	if v := natSection["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -184,7 +187,7 @@ func updateManagementNatSection(d *schema.ResourceData, m interface{}) error {
 		natSection["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update NAT section - Map = ", natSection)
+	log.Println("Update NAT section - Map = ", redactPayload(d, natSection))
 
 	updateNatSectionRes, err := client.ApiCall("set-nat-section", natSection, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateNatSectionRes.Success {
@@ -190,7 +190,7 @@ func createManagementNetwork(d *schema.ResourceData, m interface{}) error {
 		network["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Network - Map = ", network)
+	log.Println("Create Network - Map = ", redactPayload(d, network))
 
 	addNetworkRes, err := client.ApiCall("add-network", network, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addNetworkRes.Success {
@@ -423,7 +423,7 @@ func updateManagementNetwork(d *schema.ResourceData, m interface{}) error {
 		network["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Network - Map = ", network)
+	log.Println("Update Network - Map = ", redactPayload(d, network))
 
 	if len(network) != 3 {
 		setNetworkRes, err := client.ApiCall("set-network", network, client.GetSessionID(), true, client.IsProxyUsed())
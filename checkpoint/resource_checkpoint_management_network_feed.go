@@ -10,11 +10,14 @@ import (
 )
 
 func resourceManagementNetworkFeed() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementNetworkFeed,
 		Read:   readManagementNetworkFeed,
 		Update: updateManagementNetworkFeed,
 		Delete: deleteManagementNetworkFeed,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -149,7 +152,7 @@ func resourceManagementNetworkFeed() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "1.7", nil)
 }
 
 func createManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
@@ -257,7 +260,7 @@ func createManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
 		networkFeed["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create NetworkFeed - Map = ", networkFeed)
+	log.Println("Create NetworkFeed - Map = ", redactPayload(d, networkFeed))
 
 	addNetworkFeedRes, err := client.ApiCall("add-network-feed", networkFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addNetworkFeedRes.Success {
@@ -294,7 +297,7 @@ func readManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
 
 	networkFeed := showNetworkFeedRes.GetData()
 
-	log.Println("Read NetworkFeed - Show JSON = ", networkFeed)
+	log.Println("Read NetworkFeed - Show JSON = ", redactPayload(d, networkFeed))
 
 	if v := networkFeed["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -554,7 +557,7 @@ func updateManagementNetworkFeed(d *schema.ResourceData, m interface{}) error {
 		networkFeed["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update NetworkFeed - Map = ", networkFeed)
+	log.Println("Update NetworkFeed - Map = ", redactPayload(d, networkFeed))
 
 	updateNetworkFeedRes, err := client.ApiCall("set-network-feed", networkFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateNetworkFeedRes.Success {
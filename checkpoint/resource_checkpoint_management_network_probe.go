@@ -13,6 +13,9 @@ func resourceManagementNetworkProbe() *schema.Resource {
 		Read:   readManagementNetworkProbe,
 		Update: updateManagementNetworkProbe,
 		Delete: deleteManagementNetworkProbe,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -181,7 +184,7 @@ func createManagementNetworkProbe(d *schema.ResourceData, m interface{}) error {
 		networkProbe["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create NetworkProbe - Map = ", networkProbe)
+	log.Println("Create NetworkProbe - Map = ", redactPayload(d, networkProbe))
 
 	addNetworkProbeRes, err := client.ApiCall("add-network-probe", networkProbe, client.GetSessionID(), true, false)
 	if err != nil || !addNetworkProbeRes.Success {
@@ -218,7 +221,7 @@ func readManagementNetworkProbe(d *schema.ResourceData, m interface{}) error {
 
 	networkProbe := showNetworkProbeRes.GetData()
 
-	log.Println("Read NetworkProbe - Show JSON = ", networkProbe)
+	log.Println("Read NetworkProbe - Show JSON = ", redactPayload(d, networkProbe))
 
 	if v := networkProbe["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -407,7 +410,7 @@ func updateManagementNetworkProbe(d *schema.ResourceData, m interface{}) error {
 		networkProbe["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update NetworkProbe - Map = ", networkProbe)
+	log.Println("Update NetworkProbe - Map = ", redactPayload(d, networkProbe))
 
 	updateNetworkProbeRes, err := client.ApiCall("set-network-probe", networkProbe, client.GetSessionID(), true, false)
 	if err != nil || !updateNetworkProbeRes.Success {
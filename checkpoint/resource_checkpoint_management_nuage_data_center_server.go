@@ -158,7 +158,7 @@ func createManagementNuageDataCenterServer(d *schema.ResourceData, m interface{}
 		nuageDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create nuageDataCenterServer - Map = ", nuageDataCenterServer)
+	log.Println("Create nuageDataCenterServer - Map = ", redactPayload(d, nuageDataCenterServer))
 
 	addNuageDataCenterServerRes, err := client.ApiCall("add-data-center-server", nuageDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -331,7 +331,7 @@ func updateManagementNuageDataCenterServer(d *schema.ResourceData, m interface{}
 		nuageDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update nuageDataCenterServer - Map = ", nuageDataCenterServer)
+	log.Println("Update nuageDataCenterServer - Map = ", redactPayload(d, nuageDataCenterServer))
 
 	updateNuageDataCenterServerRes, err := client.ApiCall("set-data-center-server", nuageDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -145,7 +145,7 @@ func createManagementNutanixDataCenterServer(d *schema.ResourceData, m interface
 		nutanixDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Nutanix Data Center Server - Map = ", nutanixDataCenterServer)
+	log.Println("Create Nutanix Data Center Server - Map = ", redactPayload(d, nutanixDataCenterServer))
 
 	addNutanixDataCenterServerRes, err := client.ApiCall("add-data-center-server", nutanixDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -193,7 +193,7 @@ func readManagementNutanixDataCenterServer(d *schema.ResourceData, m interface{}
 
 	nutanixDataCenterServer := showNutanixDataCenterServerRes.GetData()
 
-	log.Println("Read Nutanix Data Center - Show JSON = ", nutanixDataCenterServer)
+	log.Println("Read Nutanix Data Center - Show JSON = ", redactPayload(d, nutanixDataCenterServer))
 
 	if v := nutanixDataCenterServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -313,7 +313,7 @@ func updateManagementNutanixDataCenterServer(d *schema.ResourceData, m interface
 		nutanixDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update nutanixDataCenterServer - Map = ", nutanixDataCenterServer)
+	log.Println("Update nutanixDataCenterServer - Map = ", redactPayload(d, nutanixDataCenterServer))
 
 	updateNutanixDataCenterServerRes, err := client.ApiCall("set-data-center-server", nutanixDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
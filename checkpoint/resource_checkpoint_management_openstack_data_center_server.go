@@ -148,7 +148,7 @@ func createManagementOpenStackDataCenterServer(d *schema.ResourceData, m interfa
 		openstackDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create openstackDataCenterServer - Map = ", openstackDataCenterServer)
+	log.Println("Create openstackDataCenterServer - Map = ", redactPayload(d, openstackDataCenterServer))
 
 	addOpenStackDataCenterServerRes, err := client.ApiCall("add-data-center-server", openstackDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -317,7 +317,7 @@ func updateManagementOpenStackDataCenterServer(d *schema.ResourceData, m interfa
 		openstackDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update openstackDataCenterServer - Map = ", openstackDataCenterServer)
+	log.Println("Update openstackDataCenterServer - Map = ", redactPayload(d, openstackDataCenterServer))
 
 	updateOpenStackDataCenterServerRes, err := client.ApiCall("set-data-center-server", openstackDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -188,7 +188,7 @@ func createManagementOpsecApplication(d *schema.ResourceData, m interface{}) err
 		opsecApplication["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create OpsecApplication - Map = ", opsecApplication)
+	log.Println("Create OpsecApplication - Map = ", redactPayload(d, opsecApplication))
 
 	addOpsecApplicationRes, err := client.ApiCall("add-opsec-application", opsecApplication, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addOpsecApplicationRes.Success {
@@ -225,7 +225,7 @@ func readManagementOpsecApplication(d *schema.ResourceData, m interface{}) error
 
 	opsecApplication := showOpsecApplicationRes.GetData()
 
-	log.Println("Read OpsecApplication - Show JSON = ", opsecApplication)
+	log.Println("Read OpsecApplication - Show JSON = ", redactPayload(d, opsecApplication))
 
 	if v := opsecApplication["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -399,7 +399,7 @@ func updateManagementOpsecApplication(d *schema.ResourceData, m interface{}) err
 		opsecApplication["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update OpsecApplication - Map = ", opsecApplication)
+	log.Println("Update OpsecApplication - Map = ", redactPayload(d, opsecApplication))
 
 	updateOpsecApplicationRes, err := client.ApiCall("set-opsec-application", opsecApplication, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateOpsecApplicationRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementOpsecTrustedCa() *schema.Resource {
 		Read:   readManagementOpsecTrustedCa,
 		Update: updateManagementOpsecTrustedCa,
 		Delete: deleteManagementOpsecTrustedCa,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -382,7 +385,7 @@ func createManagementOpsecTrustedCa(d *schema.ResourceData, m interface{}) error
 		opsecTrustedCa["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create OpsecTrustedCa - Map = ", opsecTrustedCa)
+	log.Println("Create OpsecTrustedCa - Map = ", redactPayload(d, opsecTrustedCa))
 
 	addOpsecTrustedCaRes, err := client.ApiCall("add-opsec-trusted-ca", opsecTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !addOpsecTrustedCaRes.Success {
@@ -419,7 +422,7 @@ func readManagementOpsecTrustedCa(d *schema.ResourceData, m interface{}) error {
 
 	opsecTrustedCa := showOpsecTrustedCaRes.GetData()
 
-	log.Println("Read OpsecTrustedCa - Show JSON = ", opsecTrustedCa)
+	log.Println("Read OpsecTrustedCa - Show JSON = ", redactPayload(d, opsecTrustedCa))
 
 	if v := opsecTrustedCa["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -819,7 +822,7 @@ func updateManagementOpsecTrustedCa(d *schema.ResourceData, m interface{}) error
 		opsecTrustedCa["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update OpsecTrustedCa - Map = ", opsecTrustedCa)
+	log.Println("Update OpsecTrustedCa - Map = ", redactPayload(d, opsecTrustedCa))
 
 	updateOpsecTrustedCaRes, err := client.ApiCall("set-opsec-trusted-ca", opsecTrustedCa, client.GetSessionID(), true, false)
 	if err != nil || !updateOpsecTrustedCaRes.Success {
@@ -141,7 +141,7 @@ func createManagementOracleCloudDataCenterServer(d *schema.ResourceData, m inter
 		oracleCloudDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create oracleCloudDataCenterServer - Map = ", oracleCloudDataCenterServer)
+	log.Println("Create oracleCloudDataCenterServer - Map = ", redactPayload(d, oracleCloudDataCenterServer))
 
 	addOracleCloudDataCenterServerRes, err := client.ApiCall("add-data-center-server", oracleCloudDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -308,7 +308,7 @@ func updateManagementOracleCloudDataCenterServer(d *schema.ResourceData, m inter
 		oracleCloudDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update oracleCloudDataCenterServer - Map = ", oracleCloudDataCenterServer)
+	log.Println("Update oracleCloudDataCenterServer - Map = ", redactPayload(d, oracleCloudDataCenterServer))
 
 	updateOracleCloudDataCenterServerRes, err := client.ApiCall("set-data-center-server", oracleCloudDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
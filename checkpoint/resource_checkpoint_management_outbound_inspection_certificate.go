@@ -13,6 +13,9 @@ func resourceManagementOutboundInspectionCertificate() *schema.Resource {
 		Read:   readManagementOutboundInspectionCertificate,
 		Update: updateManagementOutboundInspectionCertificate,
 		Delete: deleteManagementOutboundInspectionCertificate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -138,7 +141,7 @@ func createManagementOutboundInspectionCertificate(d *schema.ResourceData, m int
 		outboundInspectionCertificate["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create OutboundInspectionCertificate - Map = ", outboundInspectionCertificate)
+	log.Println("Create OutboundInspectionCertificate - Map = ", redactPayload(d, outboundInspectionCertificate))
 
 	addOutboundInspectionCertificateRes, err := client.ApiCall("add-outbound-inspection-certificate", outboundInspectionCertificate, client.GetSessionID(), true, false)
 	if err != nil || !addOutboundInspectionCertificateRes.Success {
@@ -175,7 +178,7 @@ func readManagementOutboundInspectionCertificate(d *schema.ResourceData, m inter
 
 	outboundInspectionCertificate := showOutboundInspectionCertificateRes.GetData()
 
-	log.Println("Read OutboundInspectionCertificate - Show JSON = ", outboundInspectionCertificate)
+	log.Println("Read OutboundInspectionCertificate - Show JSON = ", redactPayload(d, outboundInspectionCertificate))
 
 	if v := outboundInspectionCertificate["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -307,7 +310,7 @@ func updateManagementOutboundInspectionCertificate(d *schema.ResourceData, m int
 		outboundInspectionCertificate["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update OutboundInspectionCertificate - Map = ", outboundInspectionCertificate)
+	log.Println("Update OutboundInspectionCertificate - Map = ", redactPayload(d, outboundInspectionCertificate))
 
 	updateOutboundInspectionCertificateRes, err := client.ApiCall("set-outbound-inspection-certificate", outboundInspectionCertificate, client.GetSessionID(), true, false)
 	if err != nil || !updateOutboundInspectionCertificateRes.Success {
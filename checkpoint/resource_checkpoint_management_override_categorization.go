@@ -13,6 +13,9 @@ func resourceManagementOverrideCategorization() *schema.Resource {
 		Read:   readManagementOverrideCategorization,
 		Update: updateManagementOverrideCategorization,
 		Delete: deleteManagementOverrideCategorization,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"url": {
 				Type:        schema.TypeString,
@@ -123,7 +126,7 @@ func createManagementOverrideCategorization(d *schema.ResourceData, m interface{
 		overrideCategorization["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create OverrideCategorization - Map = ", overrideCategorization)
+	log.Println("Create OverrideCategorization - Map = ", redactPayload(d, overrideCategorization))
 
 	addOverrideCategorizationRes, err := client.ApiCall("add-override-categorization", overrideCategorization, client.GetSessionID(), true, false)
 	if err != nil || !addOverrideCategorizationRes.Success {
@@ -164,7 +167,7 @@ func readManagementOverrideCategorization(d *schema.ResourceData, m interface{})
 
 	overrideCategorization := showOverrideCategorizationRes.GetData()
 
-	log.Println("Read OverrideCategorization - Show JSON = ", overrideCategorization)
+	log.Println("Read OverrideCategorization - Show JSON = ", redactPayload(d, overrideCategorization))
 
 	if v := overrideCategorization["uid"]; v != nil {
 		_ = d.Set("uid", v)
@@ -292,7 +295,7 @@ func updateManagementOverrideCategorization(d *schema.ResourceData, m interface{
 		overrideCategorization["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update OverrideCategorization - Map = ", overrideCategorization)
+	log.Println("Update OverrideCategorization - Map = ", redactPayload(d, overrideCategorization))
 
 	updateOverrideCategorizationRes, err := client.ApiCall("set-override-categorization", overrideCategorization, client.GetSessionID(), true, false)
 	if err != nil || !updateOverrideCategorizationRes.Success {
@@ -147,7 +147,7 @@ func createManagementPackage(d *schema.ResourceData, m interface{}) error {
 		_package["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Package - Map = ", _package)
+	log.Println("Create Package - Map = ", redactPayload(d, _package))
 
 	addPackageRes, err := client.ApiCall("add-package", _package, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addPackageRes.Success {
@@ -185,7 +185,7 @@ func readManagementPackage(d *schema.ResourceData, m interface{}) error {
 
 	_package := showPackageRes.GetData()
 
-	log.Println("Read Package - Show JSON = ", _package)
+	log.Println("Read Package - Show JSON = ", redactPayload(d, _package))
 
 	if v := _package["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -335,7 +335,7 @@ func updateManagementPackage(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	log.Println("Update Package - Map = ", _package)
+	log.Println("Update Package - Map = ", redactPayload(d, _package))
 	updatePackageRes, err := client.ApiCall("set-package", _package, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updatePackageRes.Success {
 		if updatePackageRes.ErrorMsg != "" {
@@ -13,6 +13,9 @@ func resourceManagementPasscodeProfile() *schema.Resource {
 		Read:   readManagementPasscodeProfile,
 		Update: updateManagementPasscodeProfile,
 		Delete: deleteManagementPasscodeProfile,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -203,7 +206,7 @@ func createManagementPasscodeProfile(d *schema.ResourceData, m interface{}) erro
 		passcodeProfile["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create PasscodeProfile - Map = ", passcodeProfile)
+	log.Println("Create PasscodeProfile - Map = ", redactPayload(d, passcodeProfile))
 
 	addPasscodeProfileRes, err := client.ApiCall("add-passcode-profile", passcodeProfile, client.GetSessionID(), true, false)
 	if err != nil || !addPasscodeProfileRes.Success {
@@ -240,7 +243,7 @@ func readManagementPasscodeProfile(d *schema.ResourceData, m interface{}) error
 
 	passcodeProfile := showPasscodeProfileRes.GetData()
 
-	log.Println("Read PasscodeProfile - Show JSON = ", passcodeProfile)
+	log.Println("Read PasscodeProfile - Show JSON = ", redactPayload(d, passcodeProfile))
 
 	if v := passcodeProfile["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -416,7 +419,7 @@ func updateManagementPasscodeProfile(d *schema.ResourceData, m interface{}) erro
 		passcodeProfile["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update PasscodeProfile - Map = ", passcodeProfile)
+	log.Println("Update PasscodeProfile - Map = ", redactPayload(d, passcodeProfile))
 
 	updatePasscodeProfileRes, err := client.ApiCall("set-passcode-profile", passcodeProfile, client.GetSessionID(), true, false)
 	if err != nil || !updatePasscodeProfileRes.Success {
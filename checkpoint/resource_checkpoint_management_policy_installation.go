@@ -0,0 +1,512 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// resourceManagementPolicyInstallation keeps a policy package installed on a set of targets. Unlike
+// checkpoint_management_install_policy, the installed policy of every target is read back, and the package is
+// installed again when a target does not run it anymore, or a revision that changed the package was published after
+// the installation.
+func resourceManagementPolicyInstallation() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementPolicyInstallation,
+		Read:   readManagementPolicyInstallation,
+		Update: updateManagementPolicyInstallation,
+		Delete: deleteManagementPolicyInstallation,
+		Importer: &schema.ResourceImporter{
+			State: importManagementPolicyInstallation,
+		},
+		CustomizeDiff: customizeDiffManagementPolicyInstallation,
+		Schema: map[string]*schema.Schema{
+			"policy_package": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Policy Package to be installed.",
+			},
+			"targets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "On what targets to install the policy. Targets may be identified by their name, or object unique identifier.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"access": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the Access Control policy. By default, the value is true if Access Control policy is enabled on the input policy package, otherwise false.",
+			},
+			"desktop_security": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the Desktop Security policy. By default, the value is true if desktop security policy is enabled on the input policy package, otherwise false.",
+			},
+			"qos": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the QoS policy. By default, the value is true if Quality-of-Service policy is enabled on the input policy package, otherwise false.",
+			},
+			"threat_prevention": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Set to be true in order to install the Threat Prevention policy. By default, the value is true if Threat Prevention policy is enabled on the input policy package, otherwise false.",
+			},
+			"install_on_all_cluster_members_or_fail": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Relevant for the gateway clusters. If true, the policy is installed on all the cluster members. If the installation on a cluster member fails, don't install on that cluster.",
+			},
+			"ignore_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Install policy ignoring policy mismatch warnings.",
+			},
+			"up_to_date": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if every target runs the policy package, and no revision that changed the package was published after the installation. The policy is installed again when it is false.",
+			},
+			"installations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The installed policy of every target.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target name or UID as in targets.",
+						},
+						"access_policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the installed Access Control policy.",
+						},
+						"access_policy_installation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Installation date of the Access Control policy in ISO 8601 format.",
+						},
+						"threat_policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the installed Threat Prevention policy.",
+						},
+						"threat_policy_installation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Installation date of the Threat Prevention policy in ISO 8601 format.",
+						},
+						"up_to_date": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the target runs the policy package, and no revision that changed the package was published after the installation.",
+						},
+					},
+				},
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Asynchronous task unique identifier of the last installation.",
+			},
+		},
+	}
+}
+
+func createManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+	if err := installManagementPolicy(d, m); err != nil {
+		return err
+	}
+	d.SetId(policyInstallationId(d.Get("policy_package").(string), d.Get("targets").(*schema.Set).List()))
+	return readManagementPolicyInstallation(d, m)
+}
+
+func readManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	policyPackage := d.Get("policy_package").(string)
+
+	access, accessOk := d.GetOkExists("access")
+	threatPrevention, threatPreventionOk := d.GetOkExists("threat_prevention")
+
+	changes := &packageChanges{client: client, policyPackage: policyPackage, since: make(map[int64]bool)}
+
+	upToDate := true
+	var installationsState []map[string]interface{}
+	for _, target := range sortedTargets(d.Get("targets").(*schema.Set).List()) {
+		gateway, err := showInstallationTarget(client, target)
+		if err != nil {
+			return err
+		}
+
+		installation := map[string]interface{}{"target": target}
+		policy, _ := gateway["policy"].(map[string]interface{})
+
+		accessInstalled, _ := policy["access-policy-installed"].(bool)
+		threatInstalled, _ := policy["threat-policy-installed"].(bool)
+		if accessInstalled {
+			installation["access_policy_name"] = policy["access-policy-name"]
+			installation["access_policy_installation_date"] = isoTime(policy["access-policy-installation-date"])
+		}
+		if threatInstalled {
+			installation["threat_policy_name"] = policy["threat-policy-name"]
+			installation["threat_policy_installation_date"] = isoTime(policy["threat-policy-installation-date"])
+		}
+
+		// Policies that are not installed explicitly are checked if they are installed
+		checkAccess := (accessOk && access.(bool)) || (!accessOk && accessInstalled)
+		checkThreat := (threatPreventionOk && threatPrevention.(bool)) || (!threatPreventionOk && threatInstalled)
+
+		targetUpToDate := gateway != nil && (checkAccess || checkThreat)
+		var installedAt []int64
+		if checkAccess {
+			if !accessInstalled || policy["access-policy-name"] != policyPackage {
+				targetUpToDate = false
+			}
+			installedAt = append(installedAt, posixTime(policy["access-policy-installation-date"]))
+		}
+		if checkThreat {
+			if !threatInstalled || policy["threat-policy-name"] != policyPackage {
+				targetUpToDate = false
+			}
+			installedAt = append(installedAt, posixTime(policy["threat-policy-installation-date"]))
+		}
+		for _, since := range installedAt {
+			if !targetUpToDate {
+				break
+			}
+			changed, err := changes.changedSince(since)
+			if err != nil {
+				return err
+			}
+			targetUpToDate = !changed
+		}
+		if !targetUpToDate {
+			log.Printf("Policy package %s is not up to date on target %s", policyPackage, target)
+			upToDate = false
+		}
+		installation["up_to_date"] = targetUpToDate
+
+		installationsState = append(installationsState, installation)
+	}
+	_ = d.Set("installations", installationsState)
+	_ = d.Set("up_to_date", upToDate)
+
+	return nil
+}
+
+func updateManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+	if err := installManagementPolicy(d, m); err != nil {
+		return err
+	}
+	d.SetId(policyInstallationId(d.Get("policy_package").(string), d.Get("targets").(*schema.Set).List()))
+	return readManagementPolicyInstallation(d, m)
+}
+
+func deleteManagementPolicyInstallation(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// customizeDiffManagementPolicyInstallation plans to install the policy again when a target is not up to date.
+func customizeDiffManagementPolicyInstallation(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && !d.Get("up_to_date").(bool) {
+		return d.SetNew("up_to_date", true)
+	}
+	return nil
+}
+
+// importManagementPolicyInstallation imports by <POLICY_PACKAGE>;<TARGET_1>,...,<TARGET_N>.
+func importManagementPolicyInstallation(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	values, targets, err := parseCompositeId(d.Id(), "<POLICY_PACKAGE>;<TARGET_1>,...,<TARGET_N>", 1)
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("policy_package", values[0])
+	_ = d.Set("targets", strings.Split(targets, ","))
+	return []*schema.ResourceData{d}, nil
+}
+
+func installManagementPolicy(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	var payload = make(map[string]interface{})
+
+	payload["policy-package"] = d.Get("policy_package").(string)
+	payload["targets"] = d.Get("targets").(*schema.Set).List()
+
+	if v, ok := d.GetOkExists("access"); ok {
+		payload["access"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("desktop_security"); ok {
+		payload["desktop-security"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("qos"); ok {
+		payload["qos"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("threat_prevention"); ok {
+		payload["threat-prevention"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("install_on_all_cluster_members_or_fail"); ok {
+		payload["install-on-all-cluster-members-or-fail"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		payload["ignore-warnings"] = v.(bool)
+	}
+
+	installPolicyRes, err := client.ApiCall("install-policy", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !installPolicyRes.Success {
+		return fmt.Errorf(installPolicyRes.ErrorMsg)
+	}
+
+	taskId := resolveTaskId(installPolicyRes.GetData())
+	_ = d.Set("task_id", taskId)
+
+	// The installation is done when its task is, and its installation date is read back only then
+	var showTaskPayload = map[string]interface{}{}
+	showTaskPayload["task-id"] = taskId
+	showTaskPayload["details-level"] = "full"
+	showTaskRes, err := client.ApiCall("show-task", showTaskPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showTaskRes.Success {
+		return fmt.Errorf(showTaskRes.ErrorMsg)
+	}
+	tasks, _ := showTaskRes.GetData()["tasks"].([]interface{})
+	if len(tasks) == 0 {
+		return fmt.Errorf("task %v of install policy was not found", taskId)
+	}
+	for _, task := range tasks {
+		if status := task.(map[string]interface{})["status"]; status != "succeeded" {
+			return fmt.Errorf(createTaskFailMessage("install policy", showTaskRes.GetData()))
+		}
+	}
+	return nil
+}
+
+// packageChanges finds revisions that changed the policy of a package after its installation. Changes of the package,
+// of its layers and of the rules and sections of its layers change the policy, and so do changes of other objects,
+// e.g. hosts and services, that the rules of its layers or its NAT rules use, directly or through groups. Rules and
+// sections of the layers of other packages, and objects that no rule of the package uses, do not.
+type packageChanges struct {
+	client        *checkpoint.ApiClient
+	policyPackage string
+	layers        map[string]bool
+	since         map[int64]bool
+	usedByPackage map[string]bool
+}
+
+// changedSince returns true if a revision that was published at or after the given time in milliseconds changed the
+// policy of the package.
+func (c *packageChanges) changedSince(since int64) (bool, error) {
+	if changed, ok := c.since[since]; ok {
+		return changed, nil
+	}
+	if c.layers == nil {
+		layers, err := packageLayers(c.client, c.policyPackage)
+		if err != nil {
+			return false, err
+		}
+		c.layers = layers
+	}
+
+	payload := map[string]interface{}{
+		"from-date": time.Unix(since/1000, 0).UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	showChangesRes, err := c.client.ApiCall("show-changes", payload, c.client.GetSessionID(), true, c.client.IsProxyUsed())
+	if err != nil {
+		return false, fmt.Errorf(err.Error())
+	}
+	if !showChangesRes.Success {
+		return false, fmt.Errorf(showChangesRes.ErrorMsg)
+	}
+
+	changed := false
+	added, modified, deleted := changedObjects(showChangesRes.GetData())
+	for _, objects := range [][]map[string]interface{}{added, modified, deleted} {
+		for _, obj := range objects {
+			objChanged, err := c.changesPolicy(obj)
+			if err != nil {
+				return false, err
+			}
+			if objChanged {
+				log.Printf("Policy package %s was changed by %v %v since its installation", c.policyPackage, obj["type"], obj["uid"])
+				changed = true
+			}
+		}
+	}
+	c.since[since] = changed
+	return changed, nil
+}
+
+func (c *packageChanges) changesPolicy(obj map[string]interface{}) (bool, error) {
+	if layer, ok := obj["layer"]; ok {
+		return c.layers[objectIdentifier(layer, "uid")] || c.layers[objectIdentifier(layer, "name")], nil
+	}
+	if obj["type"] == "package" || strings.HasSuffix(fmt.Sprint(obj["type"]), "-layer") {
+		return c.layers[fmt.Sprint(obj["uid"])], nil
+	}
+	return c.usedByPolicy(fmt.Sprint(obj["uid"]))
+}
+
+// usedByPolicy returns true if a rule of the package uses the object, directly or through groups. An object that
+// does not exist anymore is not used: an object cannot be deleted while a rule uses it, so the rules that used it
+// were changed too.
+func (c *packageChanges) usedByPolicy(uid string) (bool, error) {
+	if used, ok := c.usedByPackage[uid]; ok {
+		return used, nil
+	}
+	if c.usedByPackage == nil {
+		c.usedByPackage = make(map[string]bool)
+	}
+
+	payload := map[string]interface{}{
+		"uid":      uid,
+		"indirect": true,
+	}
+	whereUsedRes, err := c.client.ApiCall("where-used", payload, c.client.GetSessionID(), true, c.client.IsProxyUsed())
+	if err != nil {
+		return false, fmt.Errorf(err.Error())
+	}
+	if !whereUsedRes.Success {
+		if code, _ := whereUsedRes.GetData()["code"].(string); objectNotFound(code) {
+			c.usedByPackage[uid] = false
+			return false, nil
+		}
+		return false, fmt.Errorf(whereUsedRes.ErrorMsg)
+	}
+
+	used := false
+	for _, usage := range []string{"used-directly", "used-indirectly"} {
+		usageJson, _ := whereUsedRes.GetData()[usage].(map[string]interface{})
+		for key, rules := range usageJson {
+			if !strings.HasSuffix(key, "-rules") {
+				continue
+			}
+			list, _ := rules.([]interface{})
+			for _, rule := range list {
+				ruleJson, _ := rule.(map[string]interface{})
+				for _, container := range []string{"layer", "package"} {
+					if c.layers[objectIdentifier(ruleJson[container], "uid")] || c.layers[objectIdentifier(ruleJson[container], "name")] {
+						used = true
+					}
+				}
+			}
+		}
+	}
+	c.usedByPackage[uid] = used
+	return used, nil
+}
+
+// packageLayers returns the UIDs and names of a package and of its access, threat prevention and HTTPS layers.
+func packageLayers(client *checkpoint.ApiClient, policyPackage string) (map[string]bool, error) {
+	payload := map[string]interface{}{
+		"name":          policyPackage,
+		"details-level": "full",
+	}
+	showPackageRes, err := client.ApiCall("show-package", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return nil, fmt.Errorf(err.Error())
+	}
+	if !showPackageRes.Success {
+		return nil, fmt.Errorf(showPackageRes.ErrorMsg)
+	}
+
+	pkg := showPackageRes.GetData()
+	layers := make(map[string]bool)
+	for _, layer := range []interface{}{pkg, pkg["https-layer"]} {
+		for _, key := range []string{"uid", "name"} {
+			if v := objectIdentifier(layer, key); v != "" {
+				layers[v] = true
+			}
+		}
+	}
+	for _, key := range []string{"access-layers", "threat-layers"} {
+		list, _ := pkg[key].([]interface{})
+		for _, layer := range list {
+			for _, identifier := range []string{"uid", "name"} {
+				if v := objectIdentifier(layer, identifier); v != "" {
+					layers[v] = true
+				}
+			}
+		}
+	}
+	return layers, nil
+}
+
+// objectIdentifier returns the given identifier of an object reference, that is either the object or its identifier.
+func objectIdentifier(v interface{}, key string) string {
+	switch reference := v.(type) {
+	case string:
+		return reference
+	case map[string]interface{}:
+		if identifier, ok := reference[key].(string); ok {
+			return identifier
+		}
+	}
+	return ""
+}
+
+// showInstallationTarget returns the gateway or cluster that is identified by the given name or UID, or nil if
+// there is no such target.
+func showInstallationTarget(client *checkpoint.ApiClient, target string) (map[string]interface{}, error) {
+	payload := map[string]interface{}{
+		"filter":        target,
+		"details-level": "full",
+		"limit":         objectsListPageLimit,
+	}
+	gateways, err := showObjectsPage(client, "show-gateways-and-servers", "objects", payload)
+	if err != nil {
+		return nil, err
+	}
+	objects, _ := gateways["objects"].([]interface{})
+	for _, obj := range objects {
+		if gateway, ok := obj.(map[string]interface{}); ok && (gateway["name"] == target || gateway["uid"] == target) {
+			return gateway, nil
+		}
+	}
+	return nil, nil
+}
+
+// policyInstallationId identifies an installation by its policy package and targets.
+func policyInstallationId(policyPackage string, targets []interface{}) string {
+	return policyPackage + ";" + strings.Join(sortedTargets(targets), ",")
+}
+
+func sortedTargets(targets []interface{}) []string {
+	var sorted []string
+	for _, target := range targets {
+		sorted = append(sorted, target.(string))
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// posixTime returns the milliseconds of a time object of the API, or 0.
+func posixTime(v interface{}) int64 {
+	if t, ok := v.(map[string]interface{}); ok {
+		if posix, ok := t["posix"].(float64); ok {
+			return int64(posix)
+		}
+	}
+	return 0
+}
+
+// isoTime returns the ISO 8601 representation of a time object of the API.
+func isoTime(v interface{}) interface{} {
+	if t, ok := v.(map[string]interface{}); ok {
+		return t["iso-8601"]
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccCheckpointManagementPolicyInstallation_basic(t *testing.T) {
+
+	resourceName := "checkpoint_management_policy_installation.test"
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	// Policy installation needs a gateway that is connected to the server
+	target := os.Getenv("CHECKPOINT_POLICY_TARGET")
+	if target == "" {
+		t.Skip("Env CHECKPOINT_POLICY_TARGET must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementPolicyInstallationConfig("Standard", target),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "up_to_date", "true"),
+					resource.TestCheckResourceAttr(resourceName, "installations.0.target", target),
+					resource.TestCheckResourceAttr(resourceName, "installations.0.access_policy_name", "Standard"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointManagementPolicyInstallation_basic(t *testing.T) {
+
+	resourceName := "checkpoint_management_policy_installation.test"
+	packageName := "tfTestManagementPolicyInstallation_" + acctest.RandString(6)
+	gatewayName := packageName + "_gw"
+
+	layerName := packageName + " Network"
+	otherLayerName := packageName + "_other Network"
+
+	mock := newMockApiServer(t)
+	login := mock.login()
+	sid := login["sid"].(string)
+	mock.run("add-access-layer", map[string]interface{}{"name": layerName}, sid)
+	mock.run("add-access-layer", map[string]interface{}{"name": otherLayerName}, sid)
+	mock.run("add-package", map[string]interface{}{"name": packageName, "access-layers": []interface{}{layerName}}, sid)
+	mock.run("add-simple-gateway", map[string]interface{}{"name": gatewayName, "ipv4-address": "192.0.2.1"}, sid)
+	mock.run("publish", map[string]interface{}{}, sid)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", packageName+";"+gatewayName),
+					resource.TestCheckResourceAttr(resourceName, "up_to_date", "true"),
+					resource.TestCheckResourceAttr(resourceName, "installations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "installations.0.access_policy_name", packageName),
+					resource.TestCheckResourceAttr(resourceName, "installations.0.threat_policy_name", packageName),
+					resource.TestCheckResourceAttrSet(resourceName, "installations.0.access_policy_installation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+					testUnitCheckCallCount(mock, "install-policy", 1),
+				),
+			},
+			{
+				// Revisions that do not change the package are ignored
+				PreConfig: func() {
+					mock.publishOnCall("show-gateways-and-servers", 1)
+					mock.run("add-access-rule", map[string]interface{}{"name": "other", "layer": otherLayerName, "position": "top"}, sid)
+					mock.run("publish", map[string]interface{}{}, sid)
+				},
+				Config:   mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				PlanOnly: true,
+			},
+			{
+				// A revision that changes the package after the installation is detected
+				PreConfig: func() {
+					mock.run("add-access-rule", map[string]interface{}{"name": "allow", "layer": layerName, "position": "top"}, sid)
+					mock.run("publish", map[string]interface{}{}, sid)
+				},
+				Config:             mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// A failed installation task fails the apply
+				PreConfig: func() {
+					mock.failTaskOfNext("install-policy", "Policy installation failed on gateway")
+				},
+				Config:      mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				ExpectError: regexp.MustCompile("Policy installation failed on gateway"),
+			},
+			{
+				Config: mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "up_to_date", "true"),
+					testUnitCheckCallCount(mock, "install-policy", 3),
+				),
+			},
+			{
+				// Installing another package on the target is reverted
+				PreConfig: func() {
+					mock.setField("simple-gateway", gatewayName, "policy", map[string]interface{}{
+						"access-policy-installed": true,
+						"access-policy-name":      "Standard",
+					})
+				},
+				Config: mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "installations.0.access_policy_name", packageName),
+					testUnitCheckCallCount(mock, "install-policy", 4),
+				),
+			},
+			{
+				Config:                  mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"task_id", "access"},
+			},
+			{
+				// Objects that no rule of the package uses are ignored
+				PreConfig: func() {
+					mock.run("add-host", map[string]interface{}{"name": "unused", "ipv4-address": "192.0.2.10"}, sid)
+					mock.run("add-host", map[string]interface{}{"name": "other", "ipv4-address": "192.0.2.11"}, sid)
+					mock.run("set-access-rule", map[string]interface{}{"name": "other", "layer": otherLayerName, "source": []interface{}{"other"}}, sid)
+					mock.run("publish", map[string]interface{}{}, sid)
+					mock.run("add-host", map[string]interface{}{"name": "used", "ipv4-address": "192.0.2.12"}, sid)
+					mock.run("add-group", map[string]interface{}{"name": "used_group", "members": []interface{}{"used"}}, sid)
+					mock.run("set-access-rule", map[string]interface{}{"name": "allow", "layer": layerName, "destination": []interface{}{"used_group"}}, sid)
+					mock.run("publish", map[string]interface{}{}, sid)
+				},
+				Config: mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				Check:  testUnitCheckCallCount(mock, "install-policy", 5),
+			},
+			{
+				PreConfig: func() {
+					mock.run("set-host", map[string]interface{}{"name": "unused", "color": "red"}, sid)
+					mock.run("set-host", map[string]interface{}{"name": "other", "color": "red"}, sid)
+					mock.run("publish", map[string]interface{}{}, sid)
+				},
+				Config:   mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				PlanOnly: true,
+			},
+			{
+				// An object that a rule of the package uses through a group is detected
+				PreConfig: func() {
+					mock.run("set-host", map[string]interface{}{"name": "used", "color": "red"}, sid)
+					mock.run("publish", map[string]interface{}{}, sid)
+				},
+				Config:             mock.providerConfig() + testAccManagementPolicyInstallationConfig(packageName, gatewayName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testUnitCheckCallCount(mock *mockApiServer, command string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if count := mock.callCount(command); count != expected {
+			return fmt.Errorf("%s was called %d times, expected %d", command, count, expected)
+		}
+		return nil
+	}
+}
+
+func testAccManagementPolicyInstallationConfig(policyPackage string, target string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_policy_installation" "test" {
+    policy_package = "%s"
+    targets = ["%s"]
+    access = true
+}
+`, policyPackage, target)
+}
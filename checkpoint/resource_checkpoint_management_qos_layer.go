@@ -0,0 +1,255 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func resourceManagementQosLayer() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementQosLayer,
+		Read:   readManagementQosLayer,
+		Update: updateManagementQosLayer,
+		Delete: deleteManagementQosLayer,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Object name.",
+			},
+			"add_default_rule": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Indicates whether to include a default rule in the new layer.",
+				Default:     true,
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Collection of tag identifiers.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Color of the object. Should be one of existing colors.",
+				Default:     "black",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments string.",
+			},
+			"ignore_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply changes ignoring warnings.",
+				Default:     false,
+			},
+			"ignore_errors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply changes ignoring errors. You won't be able to publish such a changes. If ignore-warnings flag was omitted - warnings will also be ignored.",
+				Default:     false,
+			},
+		},
+	}
+}
+
+func createManagementQosLayer(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	qosLayer := make(map[string]interface{})
+
+	if v, ok := d.GetOk("name"); ok {
+		qosLayer["name"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("add_default_rule"); ok {
+		qosLayer["add-default-rule"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		qosLayer["tags"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOk("color"); ok {
+		qosLayer["color"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("comments"); ok {
+		qosLayer["comments"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosLayer["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosLayer["ignore-errors"] = v.(bool)
+	}
+
+	log.Println("Create QosLayer - Map = ", redactPayload(d, qosLayer))
+
+	addQosLayerRes, err := client.ApiCall("add-qos-layer", qosLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !addQosLayerRes.Success {
+		if addQosLayerRes.ErrorMsg != "" {
+			return fmt.Errorf(addQosLayerRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	d.SetId(addQosLayerRes.GetData()["uid"].(string))
+
+	return readManagementQosLayer(d, m)
+}
+
+func readManagementQosLayer(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"uid": d.Id(),
+	}
+
+	showQosLayerRes, err := client.ApiCall("show-qos-layer", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showQosLayerRes.Success {
+		if objectNotFound(showQosLayerRes.GetData()["code"].(string)) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(showQosLayerRes.ErrorMsg)
+	}
+
+	qosLayer := showQosLayerRes.GetData()
+
+	log.Println("Read QosLayer - Show JSON = ", redactPayload(d, qosLayer))
+
+	if v := qosLayer["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
+
+	if qosLayer["tags"] != nil {
+		tagsJson, ok := qosLayer["tags"].([]interface{})
+		if ok {
+			tagsIds := make([]string, 0)
+			if len(tagsJson) > 0 {
+				for _, tags := range tagsJson {
+					tags := tags.(map[string]interface{})
+					tagsIds = append(tagsIds, tags["name"].(string))
+				}
+			}
+			_ = d.Set("tags", tagsIds)
+		}
+	} else {
+		_ = d.Set("tags", nil)
+	}
+
+	if v := qosLayer["color"]; v != nil {
+		_ = d.Set("color", v)
+	}
+
+	if v := qosLayer["comments"]; v != nil {
+		_ = d.Set("comments", v)
+	}
+
+	if v := qosLayer["ignore-warnings"]; v != nil {
+		_ = d.Set("ignore_warnings", v)
+	}
+
+	if v := qosLayer["ignore-errors"]; v != nil {
+		_ = d.Set("ignore_errors", v)
+	}
+
+	return nil
+
+}
+
+func updateManagementQosLayer(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+	qosLayer := make(map[string]interface{})
+
+	qosLayer["uid"] = d.Id()
+
+	if ok := d.HasChange("name"); ok {
+		qosLayer["new-name"] = d.Get("name")
+	}
+
+	if d.HasChange("tags") {
+		if v, ok := d.GetOk("tags"); ok {
+			qosLayer["tags"] = v.(*schema.Set).List()
+		} else {
+			oldTags, _ := d.GetChange("tags")
+			qosLayer["tags"] = map[string]interface{}{"remove": oldTags.(*schema.Set).List()}
+		}
+	}
+
+	if ok := d.HasChange("color"); ok {
+		qosLayer["color"] = d.Get("color")
+	}
+
+	if ok := d.HasChange("comments"); ok {
+		qosLayer["comments"] = d.Get("comments")
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosLayer["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosLayer["ignore-errors"] = v.(bool)
+	}
+
+	log.Println("Update QosLayer - Map = ", redactPayload(d, qosLayer))
+
+	updateQosLayerRes, err := client.ApiCall("set-qos-layer", qosLayer, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !updateQosLayerRes.Success {
+		if updateQosLayerRes.ErrorMsg != "" {
+			return fmt.Errorf(updateQosLayerRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	return readManagementQosLayer(d, m)
+}
+
+func deleteManagementQosLayer(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	qosLayerPayload := map[string]interface{}{
+		"uid": d.Id(),
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosLayerPayload["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosLayerPayload["ignore-errors"] = v.(bool)
+	}
+
+	log.Println("Delete QosLayer")
+
+	deleteQosLayerRes, err := client.ApiCall("delete-qos-layer", qosLayerPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !deleteQosLayerRes.Success {
+		if deleteQosLayerRes.ErrorMsg != "" {
+			return fmt.Errorf(deleteQosLayerRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,597 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func resourceManagementQosRule() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementQosRule,
+		Read:   readManagementQosRule,
+		Update: updateManagementQosRule,
+		Delete: deleteManagementQosRule,
+		Importer: &schema.ResourceImporter{
+			State: importStateCompositeId("<LAYER_IDENTIFIER>;<RULE_UID>", "layer"),
+		},
+		Schema: map[string]*schema.Schema{
+			"layer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Layer that the rule belongs to identified by the name or UID.",
+			},
+			"position": &schema.Schema{
+				Type:        schema.TypeMap,
+				Required:    true,
+				Description: "Position in the rulebase.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"top": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add rule on top of specific section identified by uid or name. Select value 'top' for entire rule base.",
+						},
+						"above": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add rule above specific section/rule identified by uid or name.",
+						},
+						"below": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add rule below specific section/rule identified by uid or name.",
+						},
+						"bottom": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add rule in the bottom of specific section identified by uid or name. Select value 'bottom' for entire rule base.",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Rule name.",
+			},
+			"action": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Bandwidth settings of the traffic that matches the rule.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"weight": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Relative share of the available bandwidth.",
+						},
+						"limit": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Maximal bandwidth of the rule in Kbps.",
+						},
+						"guarantee": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Bandwidth that is reserved for the rule in Kbps.",
+						},
+						"per_connection_limit": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Maximal bandwidth of every connection that matches the rule in Kbps.",
+						},
+						"per_connection_guarantee": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Bandwidth that is reserved for every connection that matches the rule in Kbps.",
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Collection of Network objects identified by the name or UID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"destination_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "True if negate is set for destination.",
+				Default:     false,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable/Disable the rule.",
+				Default:     true,
+			},
+			"install_on": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Which Gateways identified by the name or UID to install the policy on.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Collection of Network objects identified by the name or UID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"service_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "True if negate is set for service.",
+				Default:     false,
+			},
+			"source": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Collection of Network objects identified by the name or UID.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_negate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "True if negate is set for source.",
+				Default:     false,
+			},
+			"time": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of time objects. For example: \"Weekend\", \"Off-Work\", \"Every-Day\".",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"track": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Track type. \"None\", \"Log\" or \"Accounting\".",
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comments string.",
+			},
+			"ignore_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply changes ignoring warnings.",
+				Default:     false,
+			},
+			"ignore_errors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply changes ignoring errors. You won't be able to publish such a changes. If ignore-warnings flag was omitted - warnings will also be ignored.",
+				Default:     false,
+			},
+			"fields_with_uid_identifier": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of resource fields that will use object UIDs as object identifiers. Default is object name.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// Fields of the action of a QoS rule, by argument name.
+var qosRuleActionFields = map[string]string{
+	"weight":                   "weight",
+	"limit":                    "limit",
+	"guarantee":                "guarantee",
+	"per_connection_limit":     "per-connection-limit",
+	"per_connection_guarantee": "per-connection-guarantee",
+}
+
+func createManagementQosRule(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	qosRule := make(map[string]interface{})
+
+	if v, ok := d.GetOk("layer"); ok {
+		qosRule["layer"] = v.(string)
+	}
+
+	if _, ok := d.GetOk("position"); ok {
+		if v, ok := d.GetOk("position.top"); ok {
+			if v.(string) == "top" {
+				qosRule["position"] = "top" // entire rule-base
+			} else {
+				qosRule["position"] = map[string]interface{}{"top": v.(string)} // section-name
+			}
+		}
+		if v, ok := d.GetOk("position.above"); ok {
+			qosRule["position"] = map[string]interface{}{"above": v.(string)}
+		}
+		if v, ok := d.GetOk("position.below"); ok {
+			qosRule["position"] = map[string]interface{}{"below": v.(string)}
+		}
+		if v, ok := d.GetOk("position.bottom"); ok {
+			if v.(string) == "bottom" {
+				qosRule["position"] = "bottom" // entire rule-base
+			} else {
+				qosRule["position"] = map[string]interface{}{"bottom": v.(string)} // section-name
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		qosRule["name"] = v.(string)
+	}
+
+	if _, ok := d.GetOk("action"); ok {
+		qosRule["action"] = qosRuleActionPayload(d)
+	}
+
+	if v, ok := d.GetOk("destination"); ok {
+		qosRule["destination"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOkExists("destination_negate"); ok {
+		qosRule["destination-negate"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("enabled"); ok {
+		qosRule["enabled"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("install_on"); ok {
+		qosRule["install-on"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOk("service"); ok {
+		qosRule["service"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOkExists("service_negate"); ok {
+		qosRule["service-negate"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("source"); ok {
+		qosRule["source"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOkExists("source_negate"); ok {
+		qosRule["source-negate"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("time"); ok {
+		qosRule["time"] = v.(*schema.Set).List()
+	}
+
+	if v, ok := d.GetOk("track"); ok {
+		qosRule["track"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("comments"); ok {
+		qosRule["comments"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosRule["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosRule["ignore-errors"] = v.(bool)
+	}
+
+	log.Println("Create QosRule - Map = ", redactPayload(d, qosRule))
+
+	addQosRuleRes, err := client.ApiCall("add-qos-rule", qosRule, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !addQosRuleRes.Success {
+		if addQosRuleRes.ErrorMsg != "" {
+			return fmt.Errorf(addQosRuleRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	d.SetId(addQosRuleRes.GetData()["uid"].(string))
+
+	invalidateRulebaseCache(client, "show-qos-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
+	return readManagementQosRule(d, m)
+}
+
+func readManagementQosRule(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"uid":   d.Id(),
+		"layer": d.Get("layer"),
+	}
+
+	showQosRuleRes, err := client.ApiCall("show-qos-rule", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showQosRuleRes.Success {
+		if objectNotFound(showQosRuleRes.GetData()["code"].(string)) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(showQosRuleRes.ErrorMsg)
+	}
+
+	qosRule := showQosRuleRes.GetData()
+
+	log.Println("Read QosRule - Show JSON = ", redactPayload(d, qosRule))
+
+	if err := readRulePosition(client, "show-qos-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)), d); err != nil {
+		return err
+	}
+
+	if v := qosRule["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
+
+	if actionMap, ok := qosRule["action"].(map[string]interface{}); ok {
+		actionMapToReturn := make(map[string]interface{})
+		for field, apiField := range qosRuleActionFields {
+			if v, ok := actionMap[apiField].(float64); ok {
+				actionMapToReturn[field] = int(v)
+			}
+		}
+		_ = d.Set("action", []interface{}{actionMapToReturn})
+	} else {
+		_ = d.Set("action", nil)
+	}
+
+	if qosRule["destination"] != nil {
+		destinationIds := resolveListOfIdentifiers("destination", qosRule["destination"], d)
+		_ = d.Set("destination", destinationIds)
+	} else {
+		_ = d.Set("destination", nil)
+	}
+
+	if v := qosRule["destination-negate"]; v != nil {
+		_ = d.Set("destination_negate", v)
+	}
+
+	if v := qosRule["enabled"]; v != nil {
+		_ = d.Set("enabled", v)
+	}
+
+	if qosRule["install-on"] != nil {
+		installOnIds := resolveListOfIdentifiers("install-on", qosRule["install-on"], d)
+		_ = d.Set("install_on", installOnIds)
+	} else {
+		_ = d.Set("install_on", nil)
+	}
+
+	if qosRule["service"] != nil {
+		serviceIds := resolveListOfIdentifiers("service", qosRule["service"], d)
+		_ = d.Set("service", serviceIds)
+	} else {
+		_ = d.Set("service", nil)
+	}
+
+	if v := qosRule["service-negate"]; v != nil {
+		_ = d.Set("service_negate", v)
+	}
+
+	if qosRule["source"] != nil {
+		sourceIds := resolveListOfIdentifiers("source", qosRule["source"], d)
+		_ = d.Set("source", sourceIds)
+	} else {
+		_ = d.Set("source", nil)
+	}
+
+	if v := qosRule["source-negate"]; v != nil {
+		_ = d.Set("source_negate", v)
+	}
+
+	if qosRule["time"] != nil {
+		timeIds := resolveListOfIdentifiers("time", qosRule["time"], d)
+		_ = d.Set("time", timeIds)
+	} else {
+		_ = d.Set("time", nil)
+	}
+
+	if v := qosRule["track"]; v != nil {
+		if trackMap, ok := v.(map[string]interface{}); ok {
+			_ = d.Set("track", trackMap["name"])
+		} else {
+			_ = d.Set("track", v)
+		}
+	}
+
+	if v := qosRule["comments"]; v != nil {
+		_ = d.Set("comments", v)
+	}
+
+	if v := qosRule["ignore-warnings"]; v != nil {
+		_ = d.Set("ignore_warnings", v)
+	}
+
+	if v := qosRule["ignore-errors"]; v != nil {
+		_ = d.Set("ignore_errors", v)
+	}
+
+	return nil
+}
+
+func updateManagementQosRule(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+	qosRule := make(map[string]interface{})
+
+	qosRule["uid"] = d.Id()
+	qosRule["layer"] = d.Get("layer")
+
+	if d.HasChange("position") {
+		if _, ok := d.GetOk("position"); ok {
+			if v, ok := d.GetOk("position.top"); ok {
+				if v.(string) == "top" {
+					qosRule["new-position"] = "top" // entire rule-base
+				} else {
+					qosRule["new-position"] = map[string]interface{}{"top": v.(string)} // specific section-name
+				}
+			}
+			if v, ok := d.GetOk("position.above"); ok {
+				qosRule["new-position"] = map[string]interface{}{"above": v.(string)}
+			}
+			if v, ok := d.GetOk("position.below"); ok {
+				qosRule["new-position"] = map[string]interface{}{"below": v.(string)}
+			}
+			if v, ok := d.GetOk("position.bottom"); ok {
+				if v.(string) == "bottom" {
+					qosRule["new-position"] = "bottom" // entire rule-base
+				} else {
+					qosRule["new-position"] = map[string]interface{}{"bottom": v.(string)} // specific section-name
+				}
+			}
+		}
+	}
+
+	if ok := d.HasChange("name"); ok {
+		qosRule["new-name"] = d.Get("name")
+	}
+
+	if d.HasChange("action") {
+		qosRule["action"] = qosRuleActionPayload(d)
+	}
+
+	if d.HasChange("destination") {
+		if v, ok := d.GetOk("destination"); ok {
+			qosRule["destination"] = v.(*schema.Set).List()
+		} else {
+			oldDestination, _ := d.GetChange("destination")
+			qosRule["destination"] = map[string]interface{}{"remove": oldDestination.(*schema.Set).List()}
+		}
+	}
+
+	if ok := d.HasChange("destination_negate"); ok {
+		qosRule["destination-negate"] = d.Get("destination_negate")
+	}
+
+	if ok := d.HasChange("enabled"); ok {
+		qosRule["enabled"] = d.Get("enabled")
+	}
+
+	if d.HasChange("install_on") {
+		if v, ok := d.GetOk("install_on"); ok {
+			qosRule["install-on"] = v.(*schema.Set).List()
+		} else {
+			oldInstallOn, _ := d.GetChange("install_on")
+			qosRule["install-on"] = map[string]interface{}{"remove": oldInstallOn.(*schema.Set).List()}
+		}
+	}
+
+	if d.HasChange("service") {
+		if v, ok := d.GetOk("service"); ok {
+			qosRule["service"] = v.(*schema.Set).List()
+		} else {
+			oldService, _ := d.GetChange("service")
+			qosRule["service"] = map[string]interface{}{"remove": oldService.(*schema.Set).List()}
+		}
+	}
+
+	if ok := d.HasChange("service_negate"); ok {
+		qosRule["service-negate"] = d.Get("service_negate")
+	}
+
+	if d.HasChange("source") {
+		if v, ok := d.GetOk("source"); ok {
+			qosRule["source"] = v.(*schema.Set).List()
+		} else {
+			oldSource, _ := d.GetChange("source")
+			qosRule["source"] = map[string]interface{}{"remove": oldSource.(*schema.Set).List()}
+		}
+	}
+
+	if ok := d.HasChange("source_negate"); ok {
+		qosRule["source-negate"] = d.Get("source_negate")
+	}
+
+	if d.HasChange("time") {
+		if v, ok := d.GetOk("time"); ok {
+			qosRule["time"] = v.(*schema.Set).List()
+		} else {
+			oldTime, _ := d.GetChange("time")
+			qosRule["time"] = map[string]interface{}{"remove": oldTime.(*schema.Set).List()}
+		}
+	}
+
+	if ok := d.HasChange("track"); ok {
+		qosRule["track"] = d.Get("track")
+	}
+
+	if ok := d.HasChange("comments"); ok {
+		qosRule["comments"] = d.Get("comments")
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosRule["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosRule["ignore-errors"] = v.(bool)
+	}
+
+	log.Println("Update QosRule - Map = ", redactPayload(d, qosRule))
+
+	updateQosRuleRes, err := client.ApiCall("set-qos-rule", qosRule, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !updateQosRuleRes.Success {
+		if updateQosRuleRes.ErrorMsg != "" {
+			return fmt.Errorf(updateQosRuleRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	invalidateRulebaseCache(client, "show-qos-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
+	return readManagementQosRule(d, m)
+}
+
+func deleteManagementQosRule(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	qosRulePayload := map[string]interface{}{
+		"uid":   d.Id(),
+		"layer": d.Get("layer"),
+	}
+
+	log.Println("Delete QosRule")
+
+	deleteQosRuleRes, err := client.ApiCall("delete-qos-rule", qosRulePayload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !deleteQosRuleRes.Success {
+		if deleteQosRuleRes.ErrorMsg != "" {
+			return fmt.Errorf(deleteQosRuleRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	invalidateRulebaseCache(client, "show-qos-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+	d.SetId("")
+
+	return nil
+}
+
+// qosRuleActionPayload returns the configured bandwidth settings of a QoS rule.
+func qosRuleActionPayload(d *schema.ResourceData) map[string]interface{} {
+	action := make(map[string]interface{})
+	for field, apiField := range qosRuleActionFields {
+		if v, ok := d.GetOk("action.0." + field); ok {
+			action[apiField] = v.(int)
+		}
+	}
+	return action
+}
@@ -0,0 +1,238 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAccCheckpointManagementQosRule_basic(t *testing.T) {
+
+	var qosRuleMap map[string]interface{}
+	resourceName := "checkpoint_management_qos_rule.test"
+	objName := "tfTestManagementQosRule_" + acctest.RandString(6)
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckpointManagementQosRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementQosRuleConfig(objName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCheckpointManagementQosRuleExists(resourceName, &qosRuleMap),
+					testAccCheckCheckpointManagementQosRuleAttributes(&qosRuleMap, objName),
+					resource.TestCheckResourceAttr(resourceName, "action.0.weight", "20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckpointManagementQosRuleDestroy(s *terraform.State) error {
+
+	client := testAccProvider.Meta().(*checkpoint.ApiClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "checkpoint_management_qos_rule" {
+			continue
+		}
+		if rs.Primary.ID != "" {
+			res, _ := client.ApiCall("show-qos-rule", map[string]interface{}{"uid": rs.Primary.ID, "layer": rs.Primary.Attributes["layer"]}, client.GetSessionID(), true, client.IsProxyUsed())
+			if res.Success {
+				return fmt.Errorf("QosRule object (%s) still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckCheckpointManagementQosRuleExists(resourceTfName string, res *map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceTfName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", resourceTfName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("QosRule ID is not set")
+		}
+
+		client := testAccProvider.Meta().(*checkpoint.ApiClient)
+
+		response, err := client.ApiCall("show-qos-rule", map[string]interface{}{"uid": rs.Primary.ID, "layer": rs.Primary.Attributes["layer"]}, client.GetSessionID(), true, client.IsProxyUsed())
+		if !response.Success {
+			return err
+		}
+
+		*res = response.GetData()
+
+		return nil
+	}
+}
+
+func testAccCheckCheckpointManagementQosRuleAttributes(qosRuleMap *map[string]interface{}, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		qosRuleName := (*qosRuleMap)["name"].(string)
+		if !strings.EqualFold(qosRuleName, name) {
+			return fmt.Errorf("name is %s, expected %s", name, qosRuleName)
+		}
+		return nil
+	}
+}
+
+func testAccManagementQosRuleConfig(name string, weight int) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_qos_layer" "layer" {
+        name = "%[1]s_layer"
+}
+
+resource "checkpoint_management_qos_section" "section" {
+        name = "%[1]s_section"
+        position = {top = "top"}
+        layer = "${checkpoint_management_qos_layer.layer.name}"
+}
+
+resource "checkpoint_management_host" "branch" {
+        name = "%[1]s_branch"
+        ipv4_address = "192.0.2.1"
+}
+
+resource "checkpoint_management_qos_rule" "test" {
+        name = "%[1]s"
+        layer = "${checkpoint_management_qos_layer.layer.name}"
+        position = {top = "${checkpoint_management_qos_section.section.name}"}
+        source = ["${checkpoint_management_host.branch.name}"]
+        action {
+          weight = %[2]d
+          limit = 10000
+        }
+        track = "Log"
+}
+`, name, weight)
+}
+
+func TestUnitCheckpointManagementQosRule_basic(t *testing.T) {
+
+	resourceName := "checkpoint_management_qos_rule.test"
+	objName := "tfTestManagementQosRule_" + acctest.RandString(6)
+	layer := objName + "_layer"
+
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testUnitManagementQosRuleConfig(objName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action.0.weight", "20"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.limit", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					testUnitCheckMockRulebase(mock, layer, objName+"_section", objName, objName+"_second"),
+				),
+			},
+			{
+				Config: mock.providerConfig() + testUnitManagementQosRuleConfig(objName, 40),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action.0.weight", "40"),
+					testUnitCheckMockObject(mock, "qos-rule", objName, "track", "Log"),
+				),
+			},
+			{
+				// Moving the rule outside of Terraform is detected, and reverted by the next apply
+				PreConfig: func() {
+					mock.move("qos-rule", objName, "bottom")
+				},
+				Config:             mock.providerConfig() + testUnitManagementQosRuleConfig(objName, 40),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: mock.providerConfig() + testUnitManagementQosRuleConfig(objName, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckMockRulebase(mock, layer, objName+"_section", objName, objName+"_second"),
+				),
+			},
+			{
+				Config:                  mock.providerConfig() + testUnitManagementQosRuleConfig(objName, 40),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testUnitQosRuleImportId(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_warnings", "ignore_errors"},
+			},
+		},
+	})
+}
+
+func testUnitManagementQosRuleConfig(name string, weight int) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_qos_layer" "layer" {
+        name = "%[1]s_layer"
+}
+
+resource "checkpoint_management_qos_section" "section" {
+        name = "%[1]s_section"
+        position = {top = "top"}
+        layer = "${checkpoint_management_qos_layer.layer.name}"
+}
+
+resource "checkpoint_management_host" "branch" {
+        name = "%[1]s_branch"
+        ipv4_address = "192.0.2.1"
+}
+
+resource "checkpoint_management_qos_rule" "test" {
+        name = "%[1]s"
+        layer = "${checkpoint_management_qos_layer.layer.name}"
+        position = {top = "${checkpoint_management_qos_section.section.name}"}
+        source = ["${checkpoint_management_host.branch.name}"]
+        action {
+          weight = %[2]d
+          limit = 10000
+        }
+        track = "Log"
+}
+
+resource "checkpoint_management_qos_rule" "second" {
+        name = "%[1]s_second"
+        layer = "${checkpoint_management_qos_layer.layer.name}"
+        position = {below = "${checkpoint_management_qos_rule.test.name}"}
+}
+`, name, weight)
+}
+
+func testUnitCheckMockRulebase(mock *mockApiServer, layer string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rulebase := mock.rulebase(layer)
+		if strings.Join(rulebase, ",") != strings.Join(names, ",") {
+			return fmt.Errorf("rulebase of %s is %v, expected %v", layer, rulebase, names)
+		}
+		return nil
+	}
+}
+
+func testUnitQosRuleImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["layer"] + ";" + rs.Primary.ID, nil
+	}
+}
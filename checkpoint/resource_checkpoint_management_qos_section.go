@@ -0,0 +1,249 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+)
+
+func resourceManagementQosSection() *schema.Resource {
+	return &schema.Resource{
+		Create: createManagementQosSection,
+		Read:   readManagementQosSection,
+		Update: updateManagementQosSection,
+		Delete: deleteManagementQosSection,
+		Importer: &schema.ResourceImporter{
+			State: importStateCompositeId("<LAYER_IDENTIFIER>;<SECTION_UID>", "layer"),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Object name.",
+			},
+			"layer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Layer that holds the Object. Identified by the Name or UID.",
+			},
+			"ignore_warnings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply changes ignoring warnings.",
+				Default:     false,
+			},
+			"ignore_errors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Apply changes ignoring errors. You won't be able to publish such a changes. If ignore-warnings flag was omitted - warnings will also be ignored.",
+				Default:     false,
+			},
+			"position": &schema.Schema{
+				Type:        schema.TypeMap,
+				Required:    true,
+				Description: "Position in the rulebase.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"top": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add section on top of specific section identified by uid or name. Select value 'top' for entire rule base.",
+						},
+						"above": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add section above specific section/rule identified by uid or name.",
+						},
+						"below": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add section below specific section/rule identified by uid or name.",
+						},
+						"bottom": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Add section in the bottom of specific section identified by uid or name. Select value 'bottom' for entire rule base.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createManagementQosSection(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	qosSection := make(map[string]interface{})
+
+	if v, ok := d.GetOk("name"); ok {
+		qosSection["name"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("layer"); ok {
+		qosSection["layer"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosSection["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosSection["ignore-errors"] = v.(bool)
+	}
+
+	if _, ok := d.GetOk("position"); ok {
+
+		if v, ok := d.GetOk("position.top"); ok {
+			if v.(string) == "top" {
+				qosSection["position"] = "top"
+			} else {
+				qosSection["position"] = map[string]interface{}{"top": v.(string)} // section
+			}
+		}
+
+		if v, ok := d.GetOk("position.above"); ok {
+			qosSection["position"] = map[string]interface{}{"above": v.(string)} // section or rule
+		}
+
+		if v, ok := d.GetOk("position.below"); ok {
+			qosSection["position"] = map[string]interface{}{"below": v.(string)} // section or rule
+		}
+
+		if v, ok := d.GetOk("position.bottom"); ok {
+			if v.(string) == "bottom" {
+				qosSection["position"] = "bottom"
+			} else {
+				qosSection["position"] = map[string]interface{}{"bottom": v.(string)} // section
+			}
+		}
+	}
+
+	log.Println("Create QosSection - Map = ", redactPayload(d, qosSection))
+
+	addQosSectionRes, err := client.ApiCall("add-qos-section", qosSection, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !addQosSectionRes.Success {
+		if addQosSectionRes.ErrorMsg != "" {
+			return fmt.Errorf(addQosSectionRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	d.SetId(addQosSectionRes.GetData()["uid"].(string))
+
+	invalidateRulebaseCache(client, "show-qos-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
+	return readManagementQosSection(d, m)
+}
+
+func readManagementQosSection(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"uid":   d.Id(),
+		"layer": d.Get("layer"),
+	}
+
+	showQosSectionRes, err := client.ApiCall("show-qos-section", payload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return fmt.Errorf(err.Error())
+	}
+	if !showQosSectionRes.Success {
+		if objectNotFound(showQosSectionRes.GetData()["code"].(string)) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(showQosSectionRes.ErrorMsg)
+	}
+
+	qosSection := showQosSectionRes.GetData()
+
+	log.Println("Read QosSection - Show JSON = ", redactPayload(d, qosSection))
+
+	if v := qosSection["name"]; v != nil {
+		_ = d.Set("name", v)
+	}
+
+	if v := qosSection["ignore-warnings"]; v != nil {
+		_ = d.Set("ignore_warnings", v)
+	}
+
+	if v := qosSection["ignore-errors"]; v != nil {
+		_ = d.Set("ignore_errors", v)
+	}
+
+	return nil
+
+}
+
+func updateManagementQosSection(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+	qosSection := make(map[string]interface{})
+
+	qosSection["uid"] = d.Id()
+
+	qosSection["layer"] = d.Get("layer")
+
+	if ok := d.HasChange("name"); ok {
+		qosSection["new-name"] = d.Get("name")
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosSection["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosSection["ignore-errors"] = v.(bool)
+	}
+
+	log.Println("Update QosSection - Map = ", redactPayload(d, qosSection))
+
+	updateQosSectionRes, err := client.ApiCall("set-qos-section", qosSection, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !updateQosSectionRes.Success {
+		if updateQosSectionRes.ErrorMsg != "" {
+			return fmt.Errorf(updateQosSectionRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	invalidateRulebaseCache(client, "show-qos-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
+	return readManagementQosSection(d, m)
+}
+
+func deleteManagementQosSection(d *schema.ResourceData, m interface{}) error {
+
+	client := m.(*checkpoint.ApiClient)
+
+	qosSectionPayload := map[string]interface{}{
+		"uid":   d.Id(),
+		"layer": d.Get("layer"),
+	}
+
+	if v, ok := d.GetOkExists("ignore_warnings"); ok {
+		qosSectionPayload["ignore-warnings"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("ignore_errors"); ok {
+		qosSectionPayload["ignore-errors"] = v.(bool)
+	}
+
+	log.Println("Delete QosSection")
+
+	deleteQosSectionRes, err := client.ApiCall("delete-qos-section", qosSectionPayload, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil || !deleteQosSectionRes.Success {
+		if deleteQosSectionRes.ErrorMsg != "" {
+			return fmt.Errorf(deleteQosSectionRes.ErrorMsg)
+		}
+		return fmt.Errorf(err.Error())
+	}
+
+	invalidateRulebaseCache(client, "show-qos-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+	d.SetId("")
+
+	return nil
+}
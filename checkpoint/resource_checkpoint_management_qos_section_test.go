@@ -0,0 +1,109 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestAccCheckpointManagementQosSection_basic(t *testing.T) {
+
+	var qosSectionMap map[string]interface{}
+	resourceName := "checkpoint_management_qos_section.test"
+	objName := "tfTestManagementQosSection_" + acctest.RandString(6)
+
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "web_api" {
+		t.Skip("Skipping management test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckpointManagementQosSectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagementQosSectionConfig(objName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCheckpointManagementQosSectionExists(resourceName, &qosSectionMap),
+					testAccCheckCheckpointManagementQosSectionAttributes(&qosSectionMap, objName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckpointManagementQosSectionDestroy(s *terraform.State) error {
+
+	client := testAccProvider.Meta().(*checkpoint.ApiClient)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "checkpoint_management_qos_section" {
+			continue
+		}
+		if rs.Primary.ID != "" {
+			res, _ := client.ApiCall("show-qos-section", map[string]interface{}{"uid": rs.Primary.ID, "layer": rs.Primary.Attributes["layer"]}, client.GetSessionID(), true, client.IsProxyUsed())
+			if res.Success {
+				return fmt.Errorf("QosSection object (%s) still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func testAccCheckCheckpointManagementQosSectionExists(resourceTfName string, res *map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		rs, ok := s.RootModule().Resources[resourceTfName]
+		if !ok {
+			return fmt.Errorf("Resource not found: %s", resourceTfName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("QosSection ID is not set")
+		}
+
+		client := testAccProvider.Meta().(*checkpoint.ApiClient)
+
+		response, err := client.ApiCall("show-qos-section", map[string]interface{}{"uid": rs.Primary.ID, "layer": rs.Primary.Attributes["layer"]}, client.GetSessionID(), true, client.IsProxyUsed())
+		if !response.Success {
+			return err
+		}
+
+		*res = response.GetData()
+
+		return nil
+	}
+}
+
+func testAccCheckCheckpointManagementQosSectionAttributes(qosSectionMap *map[string]interface{}, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		qosSectionName := (*qosSectionMap)["name"].(string)
+		if !strings.EqualFold(qosSectionName, name) {
+			return fmt.Errorf("name is %s, expected %s", name, qosSectionName)
+		}
+		return nil
+	}
+}
+
+func testAccManagementQosSectionConfig(name string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_management_qos_layer" "layer" {
+        name = "%[1]s_layer"
+}
+
+resource "checkpoint_management_qos_section" "test" {
+        name = "%[1]s"
+        position = {top = "top"}
+        layer = "${checkpoint_management_qos_layer.layer.name}"
+}
+`, name)
+}
@@ -220,7 +220,7 @@ func updateManagementRadiusGroup(d *schema.ResourceData, m interface{}) error {
 		radiusGroup["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Radius Group - Map = ", radiusGroup)
+	log.Println("Update Radius Group - Map = ", redactPayload(d, radiusGroup))
 	setRadiusGroupRes, _ := client.ApiCall("set-radius-group", radiusGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if !setRadiusGroupRes.Success {
 		return fmt.Errorf(setRadiusGroupRes.ErrorMsg)
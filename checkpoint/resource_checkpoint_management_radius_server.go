@@ -180,7 +180,7 @@ func createManagementRadiusServer(d *schema.ResourceData, m interface{}) error {
 		radiusServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Radius Server - Map = ", radiusServer)
+	log.Println("Create Radius Server - Map = ", redactPayload(d, radiusServer))
 
 	addRadiusServerRes, err := client.ApiCall("add-radius-server", radiusServer, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -219,7 +219,7 @@ func readManagementRadiusServer(d *schema.ResourceData, m interface{}) error {
 
 	radiusServer := showRadiusServerRes.GetData()
 
-	log.Println("Read Radius Server - Show JSON = ", radiusServer)
+	log.Println("Read Radius Server - Show JSON = ", redactPayload(d, radiusServer))
 
 	if v := radiusServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -384,7 +384,7 @@ func updateManagementRadiusServer(d *schema.ResourceData, m interface{}) error {
 		radiusServer["ignore-errors"] = d.Get("ignore_errors")
 	}
 
-	log.Println("Update Radius Server - Map = ", radiusServer)
+	log.Println("Update Radius Server - Map = ", redactPayload(d, radiusServer))
 	updateRadiusServerRes, err := client.ApiCall("set-radius-server", radiusServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateRadiusServerRes.Success {
 		if updateRadiusServerRes.ErrorMsg != "" {
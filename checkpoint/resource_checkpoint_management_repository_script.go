@@ -13,6 +13,9 @@ func resourceManagementRepositoryScript() *schema.Resource {
 		Read:   readManagementRepositoryScript,
 		Update: updateManagementRepositoryScript,
 		Delete: deleteManagementRepositoryScript,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -106,7 +109,7 @@ func createManagementRepositoryScript(d *schema.ResourceData, m interface{}) err
 		repositoryScript["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create RepositoryScript - Map = ", repositoryScript)
+	log.Println("Create RepositoryScript - Map = ", redactPayload(d, repositoryScript))
 
 	addRepositoryScriptRes, err := client.ApiCall("add-repository-script", repositoryScript, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addRepositoryScriptRes.Success {
@@ -143,7 +146,7 @@ func readManagementRepositoryScript(d *schema.ResourceData, m interface{}) error
 
 	repositoryScript := showRepositoryScriptRes.GetData()
 
-	log.Println("Read RepositoryScript - Show JSON = ", repositoryScript)
+	log.Println("Read RepositoryScript - Show JSON = ", redactPayload(d, repositoryScript))
 
 	if v := repositoryScript["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -239,7 +242,7 @@ func updateManagementRepositoryScript(d *schema.ResourceData, m interface{}) err
 		repositoryScript["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update RepositoryScript - Map = ", repositoryScript)
+	log.Println("Update RepositoryScript - Map = ", redactPayload(d, repositoryScript))
 
 	updateRepositoryScriptRes, err := client.ApiCall("set-repository-script", repositoryScript, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateRepositoryScriptRes.Success {
@@ -15,6 +15,9 @@ func resourceManagementResourceCifs() *schema.Resource {
 		Read:   readManagementResourceCifs,
 		Update: updateManagementResourceCifs,
 		Delete: deleteManagementResourceCifs,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -158,7 +161,7 @@ func createManagementResourceCifs(d *schema.ResourceData, m interface{}) error {
 		resourceCifs["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ResourceCifs - Map = ", resourceCifs)
+	log.Println("Create ResourceCifs - Map = ", redactPayload(d, resourceCifs))
 
 	addResourceCifsRes, err := client.ApiCall("add-resource-cifs", resourceCifs, client.GetSessionID(), true, false)
 	if err != nil || !addResourceCifsRes.Success {
@@ -195,7 +198,7 @@ func readManagementResourceCifs(d *schema.ResourceData, m interface{}) error {
 
 	resourceCifs := showResourceCifsRes.GetData()
 
-	log.Println("Read ResourceCifs - Show JSON = ", resourceCifs)
+	log.Println("Read ResourceCifs - Show JSON = ", redactPayload(d, resourceCifs))
 
 	if v := resourceCifs["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -362,7 +365,7 @@ func updateManagementResourceCifs(d *schema.ResourceData, m interface{}) error {
 		resourceCifs["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ResourceCifs - Map = ", resourceCifs)
+	log.Println("Update ResourceCifs - Map = ", redactPayload(d, resourceCifs))
 
 	updateResourceCifsRes, err := client.ApiCall("set-resource-cifs", resourceCifs, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceCifsRes.Success {
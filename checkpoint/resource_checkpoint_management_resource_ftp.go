@@ -13,6 +13,9 @@ func resourceManagementResourceFtp() *schema.Resource {
 		Read:   readManagementResourceFtp,
 		Update: updateManagementResourceFtp,
 		Delete: deleteManagementResourceFtp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -172,7 +175,7 @@ func createManagementResourceFtp(d *schema.ResourceData, m interface{}) error {
 		resourceFtp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ResourceFtp - Map = ", resourceFtp)
+	log.Println("Create ResourceFtp - Map = ", redactPayload(d, resourceFtp))
 
 	addResourceFtpRes, err := client.ApiCall("add-resource-ftp", resourceFtp, client.GetSessionID(), true, false)
 	if err != nil || !addResourceFtpRes.Success {
@@ -209,7 +212,7 @@ func readManagementResourceFtp(d *schema.ResourceData, m interface{}) error {
 
 	resourceFtp := showResourceFtpRes.GetData()
 
-	log.Println("Read ResourceFtp - Show JSON = ", resourceFtp)
+	log.Println("Read ResourceFtp - Show JSON = ", redactPayload(d, resourceFtp))
 
 	if v := resourceFtp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -375,7 +378,7 @@ func updateManagementResourceFtp(d *schema.ResourceData, m interface{}) error {
 		resourceFtp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ResourceFtp - Map = ", resourceFtp)
+	log.Println("Update ResourceFtp - Map = ", redactPayload(d, resourceFtp))
 
 	updateResourceFtpRes, err := client.ApiCall("set-resource-ftp", resourceFtp, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceFtpRes.Success {
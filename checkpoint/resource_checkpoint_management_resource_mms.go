@@ -13,6 +13,9 @@ func resourceManagementResourceMms() *schema.Resource {
 		Read:   readManagementResourceMms,
 		Update: updateManagementResourceMms,
 		Delete: deleteManagementResourceMms,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -103,7 +106,7 @@ func createManagementResourceMms(d *schema.ResourceData, m interface{}) error {
 		resourceMms["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ResourceMms - Map = ", resourceMms)
+	log.Println("Create ResourceMms - Map = ", redactPayload(d, resourceMms))
 
 	addResourceMmsRes, err := client.ApiCallSimple("add-resource-mms", resourceMms)
 	if err != nil || !addResourceMmsRes.Success {
@@ -140,7 +143,7 @@ func readManagementResourceMms(d *schema.ResourceData, m interface{}) error {
 
 	resourceMms := showResourceMmsRes.GetData()
 
-	log.Println("Read ResourceMms - Show JSON = ", resourceMms)
+	log.Println("Read ResourceMms - Show JSON = ", redactPayload(d, resourceMms))
 
 	if v := resourceMms["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -233,7 +236,7 @@ func updateManagementResourceMms(d *schema.ResourceData, m interface{}) error {
 		resourceMms["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ResourceMms - Map = ", resourceMms)
+	log.Println("Update ResourceMms - Map = ", redactPayload(d, resourceMms))
 
 	updateResourceMmsRes, err := client.ApiCallSimple("set-resource-mms", resourceMms)
 	if err != nil || !updateResourceMmsRes.Success {
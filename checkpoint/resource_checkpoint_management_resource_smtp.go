@@ -13,6 +13,9 @@ func resourceManagementResourceSmtp() *schema.Resource {
 		Read:   readManagementResourceSmtp,
 		Update: updateManagementResourceSmtp,
 		Delete: deleteManagementResourceSmtp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -482,7 +485,7 @@ func createManagementResourceSmtp(d *schema.ResourceData, m interface{}) error {
 		resourceSmtp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ResourceSmtp - Map = ", resourceSmtp)
+	log.Println("Create ResourceSmtp - Map = ", redactPayload(d, resourceSmtp))
 
 	addResourceSmtpRes, err := client.ApiCall("add-resource-smtp", resourceSmtp, client.GetSessionID(), true, false)
 	if err != nil || !addResourceSmtpRes.Success {
@@ -519,7 +522,7 @@ func readManagementResourceSmtp(d *schema.ResourceData, m interface{}) error {
 
 	resourceSmtp := showResourceSmtpRes.GetData()
 
-	log.Println("Read ResourceSmtp - Show JSON = ", resourceSmtp)
+	log.Println("Read ResourceSmtp - Show JSON = ", redactPayload(d, resourceSmtp))
 
 	if v := resourceSmtp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -956,7 +959,7 @@ func updateManagementResourceSmtp(d *schema.ResourceData, m interface{}) error {
 		resourceSmtp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ResourceSmtp - Map = ", resourceSmtp)
+	log.Println("Update ResourceSmtp - Map = ", redactPayload(d, resourceSmtp))
 
 	updateResourceSmtpRes, err := client.ApiCall("set-resource-smtp", resourceSmtp, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceSmtpRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementResourceTcp() *schema.Resource {
 		Read:   readManagementResourceTcp,
 		Update: updateManagementResourceTcp,
 		Delete: deleteManagementResourceTcp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -220,7 +223,7 @@ func createManagementResourceTcp(d *schema.ResourceData, m interface{}) error {
 		resourceTcp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ResourceTcp - Map = ", resourceTcp)
+	log.Println("Create ResourceTcp - Map = ", redactPayload(d, resourceTcp))
 
 	addResourceTcpRes, err := client.ApiCallSimple("add-resource-tcp", resourceTcp)
 	if err != nil || !addResourceTcpRes.Success {
@@ -257,7 +260,7 @@ func readManagementResourceTcp(d *schema.ResourceData, m interface{}) error {
 
 	resourceTcp := showResourceTcpRes.GetData()
 
-	log.Println("Read ResourceTcp - Show JSON = ", resourceTcp)
+	log.Println("Read ResourceTcp - Show JSON = ", redactPayload(d, resourceTcp))
 
 	if v := resourceTcp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -458,7 +461,7 @@ func updateManagementResourceTcp(d *schema.ResourceData, m interface{}) error {
 		resourceTcp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ResourceTcp - Map = ", resourceTcp)
+	log.Println("Update ResourceTcp - Map = ", redactPayload(d, resourceTcp))
 
 	updateResourceTcpRes, err := client.ApiCallSimple("set-resource-tcp", resourceTcp)
 	if err != nil || !updateResourceTcpRes.Success {
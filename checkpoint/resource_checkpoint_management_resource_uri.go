@@ -14,6 +14,9 @@ func resourceManagementResourceUri() *schema.Resource {
 		Read:   readManagementResourceUri,
 		Update: updateManagementResourceUri,
 		Delete: deleteManagementResourceUri,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -596,7 +599,7 @@ func createManagementResourceUri(d *schema.ResourceData, m interface{}) error {
 		resourceUri["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ResourceUri - Map = ", resourceUri)
+	log.Println("Create ResourceUri - Map = ", redactPayload(d, resourceUri))
 
 	addResourceUriRes, err := client.ApiCall("add-resource-uri", resourceUri, client.GetSessionID(), true, false)
 	if err != nil || !addResourceUriRes.Success {
@@ -633,7 +636,7 @@ func readManagementResourceUri(d *schema.ResourceData, m interface{}) error {
 
 	resourceUri := showResourceUriRes.GetData()
 
-	log.Println("Read ResourceUri - Show JSON = ", resourceUri)
+	log.Println("Read ResourceUri - Show JSON = ", redactPayload(d, resourceUri))
 
 	if v := resourceUri["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1146,7 +1149,7 @@ func updateManagementResourceUri(d *schema.ResourceData, m interface{}) error {
 		resourceUri["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ResourceUri - Map = ", resourceUri)
+	log.Println("Update ResourceUri - Map = ", redactPayload(d, resourceUri))
 
 	updateResourceUriRes, err := client.ApiCall("set-resource-uri", resourceUri, client.GetSessionID(), true, false)
 	if err != nil || !updateResourceUriRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementResourceUriForQos() *schema.Resource {
 		Read:   readManagementResourceUriForQos,
 		Update: updateManagementResourceUriForQos,
 		Delete: deleteManagementResourceUriForQos,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -91,7 +94,7 @@ func createManagementResourceUriForQos(d *schema.ResourceData, m interface{}) er
 		resourceUriForQos["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ResourceUriForQos - Map = ", resourceUriForQos)
+	log.Println("Create ResourceUriForQos - Map = ", redactPayload(d, resourceUriForQos))
 
 	addResourceUriForQosRes, err := client.ApiCallSimple("add-resource-uri-for-qos", resourceUriForQos)
 	if err != nil || !addResourceUriForQosRes.Success {
@@ -128,7 +131,7 @@ func readManagementResourceUriForQos(d *schema.ResourceData, m interface{}) erro
 
 	resourceUriForQos := showResourceUriForQosRes.GetData()
 
-	log.Println("Read ResourceUriForQos - Show JSON = ", resourceUriForQos)
+	log.Println("Read ResourceUriForQos - Show JSON = ", redactPayload(d, resourceUriForQos))
 
 	if v := resourceUriForQos["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -213,7 +216,7 @@ func updateManagementResourceUriForQos(d *schema.ResourceData, m interface{}) er
 		resourceUriForQos["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ResourceUriForQos - Map = ", resourceUriForQos)
+	log.Println("Update ResourceUriForQos - Map = ", redactPayload(d, resourceUriForQos))
 
 	updateResourceUriForQosRes, err := client.ApiCallSimple("set-resource-uri-for-qos", resourceUriForQos)
 	if err != nil || !updateResourceUriForQosRes.Success {
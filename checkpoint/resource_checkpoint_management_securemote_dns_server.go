@@ -135,7 +135,7 @@ func createManagementSecuremoteDnsServer(d *schema.ResourceData, m interface{})
 		securemoteDnsServer["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create SecuremoteDnsServer - Map = ", securemoteDnsServer)
+	log.Println("Create SecuremoteDnsServer - Map = ", redactPayload(d, securemoteDnsServer))
 
 	addSecuremoteDnsServerRes, err := client.ApiCallSimple("add-securemote-dns-server", securemoteDnsServer)
 	if err != nil || !addSecuremoteDnsServerRes.Success {
@@ -173,7 +173,7 @@ func readManagementSecuremoteDnsServer(d *schema.ResourceData, m interface{}) er
 
 	securemoteDnsServer := showSecuremoteDnsServerRes.GetData()
 
-	log.Println("Read SecuremoteDnsServer - Show JSON = ", securemoteDnsServer)
+	log.Println("Read SecuremoteDnsServer - Show JSON = ", redactPayload(d, securemoteDnsServer))
 
 	if v := securemoteDnsServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -314,7 +314,7 @@ func updateManagementSecuremoteDnsServer(d *schema.ResourceData, m interface{})
 		securemoteDnsServer["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update SecuremoteDnsServer - Map = ", securemoteDnsServer)
+	log.Println("Update SecuremoteDnsServer - Map = ", redactPayload(d, securemoteDnsServer))
 	if len(securemoteDnsServer) != 3 {
 		updateSecuremoteDnsServerRes, err := client.ApiCallSimple("set-securemote-dns-server", securemoteDnsServer)
 		if err != nil || !updateSecuremoteDnsServerRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementSecuridServer() *schema.Resource {
 		Read:   readManagementSecuridServer,
 		Update: updateManagementSecuridServer,
 		Delete: deleteManagementSecuridServer,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -101,7 +104,7 @@ func createManagementSecuridServer(d *schema.ResourceData, m interface{}) error
 		securidServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create SecuridServer - Map = ", securidServer)
+	log.Println("Create SecuridServer - Map = ", redactPayload(d, securidServer))
 
 	addSecuridServerRes, err := client.ApiCallSimple("add-securid-server", securidServer)
 	if err != nil || !addSecuridServerRes.Success {
@@ -138,7 +141,7 @@ func readManagementSecuridServer(d *schema.ResourceData, m interface{}) error {
 
 	securidServer := showSecuridServerRes.GetData()
 
-	log.Println("Read SecuridServer - Show JSON = ", securidServer)
+	log.Println("Read SecuridServer - Show JSON = ", redactPayload(d, securidServer))
 
 	if v := securidServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -231,7 +234,7 @@ func updateManagementSecuridServer(d *schema.ResourceData, m interface{}) error
 		securidServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update SecuridServer - Map = ", securidServer)
+	log.Println("Update SecuridServer - Map = ", redactPayload(d, securidServer))
 
 	updateSecuridServerRes, err := client.ApiCallSimple("set-securid-server", securidServer)
 	if err != nil || !updateSecuridServerRes.Success {
@@ -86,7 +86,7 @@ func createManagementSecurityZone(d *schema.ResourceData, m interface{}) error {
 		securityZone["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create SecurityZone - Map = ", securityZone)
+	log.Println("Create SecurityZone - Map = ", redactPayload(d, securityZone))
 
 	addSecurityZoneRes, err := client.ApiCall("add-security-zone", securityZone, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addSecurityZoneRes.Success {
@@ -123,7 +123,7 @@ func readManagementSecurityZone(d *schema.ResourceData, m interface{}) error {
 
 	securityZone := showSecurityZoneRes.GetData()
 
-	log.Println("Read SecurityZone - Show JSON = ", securityZone)
+	log.Println("Read SecurityZone - Show JSON = ", redactPayload(d, securityZone))
 
 	if v := securityZone["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -203,7 +203,7 @@ func updateManagementSecurityZone(d *schema.ResourceData, m interface{}) error {
 		securityZone["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update SecurityZone - Map = ", securityZone)
+	log.Println("Update SecurityZone - Map = ", redactPayload(d, securityZone))
 
 	updateSecurityZoneRes, err := client.ApiCall("set-security-zone", securityZone, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateSecurityZoneRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementServerCertificate() *schema.Resource {
 		Read:   readManagementServerCertificate,
 		Update: updateManagementServerCertificate,
 		Delete: deleteManagementServerCertificate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -76,7 +79,7 @@ func createManagementServerCertificate(d *schema.ResourceData, m interface{}) er
 		serverCertificate["comments"] = v.(string)
 	}
 
-	log.Println("Create ServerCertificate - Map = ", serverCertificate)
+	log.Println("Create ServerCertificate - Map = ", redactPayload(d, serverCertificate))
 
 	addServerCertificateRes, err := client.ApiCall("add-server-certificate", serverCertificate, client.GetSessionID(), true, false)
 	if err != nil || !addServerCertificateRes.Success {
@@ -113,7 +116,7 @@ func readManagementServerCertificate(d *schema.ResourceData, m interface{}) erro
 
 	serverCertificate := showServerCertificateRes.GetData()
 
-	log.Println("Read ServerCertificate - Show JSON = ", serverCertificate)
+	log.Println("Read ServerCertificate - Show JSON = ", redactPayload(d, serverCertificate))
 
 	if v := serverCertificate["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -158,7 +161,7 @@ func updateManagementServerCertificate(d *schema.ResourceData, m interface{}) er
 		serverCertificate["comments"] = d.Get("comments")
 	}
 
-	log.Println("Update ServerCertificate - Map = ", serverCertificate)
+	log.Println("Update ServerCertificate - Map = ", redactPayload(d, serverCertificate))
 
 	updateServerCertificateRes, err := client.ApiCall("set-server-certificate", serverCertificate, client.GetSessionID(), true, false)
 	if err != nil || !updateServerCertificateRes.Success {
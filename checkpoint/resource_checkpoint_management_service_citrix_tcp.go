@@ -13,6 +13,9 @@ func resourceManagementServiceCitrixTcp() *schema.Resource {
 		Read:   readManagementServiceCitrixTcp,
 		Update: updateManagementServiceCitrixTcp,
 		Delete: deleteManagementServiceCitrixTcp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -92,7 +95,7 @@ func createManagementServiceCitrixTcp(d *schema.ResourceData, m interface{}) err
 		serviceCitrixTcp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceCitrixTcp - Map = ", serviceCitrixTcp)
+	log.Println("Create ServiceCitrixTcp - Map = ", redactPayload(d, serviceCitrixTcp))
 
 	addServiceCitrixTcpRes, err := client.ApiCall("add-service-citrix-tcp", serviceCitrixTcp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceCitrixTcpRes.Success {
@@ -129,7 +132,7 @@ func readManagementServiceCitrixTcp(d *schema.ResourceData, m interface{}) error
 
 	serviceCitrixTcp := showServiceCitrixTcpRes.GetData()
 
-	log.Println("Read ServiceCitrixTcp - Show JSON = ", serviceCitrixTcp)
+	log.Println("Read ServiceCitrixTcp - Show JSON = ", redactPayload(d, serviceCitrixTcp))
 
 	if v := serviceCitrixTcp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -208,7 +211,7 @@ func updateManagementServiceCitrixTcp(d *schema.ResourceData, m interface{}) err
 		serviceCitrixTcp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceCitrixTcp - Map = ", serviceCitrixTcp)
+	log.Println("Update ServiceCitrixTcp - Map = ", redactPayload(d, serviceCitrixTcp))
 
 	updateServiceCitrixTcpRes, err := client.ApiCall("set-service-citrix-tcp", serviceCitrixTcp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceCitrixTcpRes.Success {
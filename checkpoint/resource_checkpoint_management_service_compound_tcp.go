@@ -13,6 +13,9 @@ func resourceManagementServiceCompoundTcp() *schema.Resource {
 		Read:   readManagementServiceCompoundTcp,
 		Update: updateManagementServiceCompoundTcp,
 		Delete: deleteManagementServiceCompoundTcp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -103,7 +106,7 @@ func createManagementServiceCompoundTcp(d *schema.ResourceData, m interface{}) e
 		serviceCompoundTcp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceCompoundTcp - Map = ", serviceCompoundTcp)
+	log.Println("Create ServiceCompoundTcp - Map = ", redactPayload(d, serviceCompoundTcp))
 
 	addServiceCompoundTcpRes, err := client.ApiCall("add-service-compound-tcp", serviceCompoundTcp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceCompoundTcpRes.Success {
@@ -140,7 +143,7 @@ func readManagementServiceCompoundTcp(d *schema.ResourceData, m interface{}) err
 
 	serviceCompoundTcp := showServiceCompoundTcpRes.GetData()
 
-	log.Println("Read ServiceCompoundTcp - Show JSON = ", serviceCompoundTcp)
+	log.Println("Read ServiceCompoundTcp - Show JSON = ", redactPayload(d, serviceCompoundTcp))
 
 	if v := serviceCompoundTcp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -227,7 +230,7 @@ func updateManagementServiceCompoundTcp(d *schema.ResourceData, m interface{}) e
 		serviceCompoundTcp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceCompoundTcp - Map = ", serviceCompoundTcp)
+	log.Println("Update ServiceCompoundTcp - Map = ", redactPayload(d, serviceCompoundTcp))
 
 	updateServiceCompoundTcpRes, err := client.ApiCall("set-service-compound-tcp", serviceCompoundTcp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceCompoundTcpRes.Success {
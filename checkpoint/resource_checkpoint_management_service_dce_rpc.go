@@ -104,7 +104,7 @@ func createManagementServiceDceRpc(d *schema.ResourceData, m interface{}) error
 		serviceDceRpc["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceDceRpc - Map = ", serviceDceRpc)
+	log.Println("Create ServiceDceRpc - Map = ", redactPayload(d, serviceDceRpc))
 
 	addServiceDceRpcRes, err := client.ApiCall("add-service-dce-rpc", serviceDceRpc, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceDceRpcRes.Success {
@@ -141,7 +141,7 @@ func readManagementServiceDceRpc(d *schema.ResourceData, m interface{}) error {
 
 	serviceDceRpc := showServiceDceRpcRes.GetData()
 
-	log.Println("Read ServiceDceRpc - Show JSON = ", serviceDceRpc)
+	log.Println("Read ServiceDceRpc - Show JSON = ", redactPayload(d, serviceDceRpc))
 
 	if v := serviceDceRpc["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -237,7 +237,7 @@ func updateManagementServiceDceRpc(d *schema.ResourceData, m interface{}) error
 		serviceDceRpc["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceDceRpc - Map = ", serviceDceRpc)
+	log.Println("Update ServiceDceRpc - Map = ", redactPayload(d, serviceDceRpc))
 
 	updateServiceDceRpcRes, err := client.ApiCall("set-service-dce-rpc", serviceDceRpc, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceDceRpcRes.Success {
@@ -93,7 +93,7 @@ func createManagementServiceGroup(d *schema.ResourceData, m interface{}) error {
 		serviceGroup["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Service Group - Map = ", serviceGroup)
+	log.Println("Create Service Group - Map = ", redactPayload(d, serviceGroup))
 
 	addServiceGroupRes, err := client.ApiCall("add-service-group", serviceGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceGroupRes.Success {
@@ -220,7 +220,7 @@ func updateManagementServiceGroup(d *schema.ResourceData, m interface{}) error {
 		serviceGroup["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Service Group - Map = ", serviceGroup)
+	log.Println("Update Service Group - Map = ", redactPayload(d, serviceGroup))
 	setserviceGroupRes, _ := client.ApiCall("set-service-group", serviceGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if !setserviceGroupRes.Success {
 		return fmt.Errorf(setserviceGroupRes.ErrorMsg)
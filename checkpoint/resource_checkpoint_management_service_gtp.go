@@ -14,6 +14,9 @@ func resourceManagementServiceGtp() *schema.Resource {
 		Read:   readManagementServiceGtp,
 		Update: updateManagementServiceGtp,
 		Delete: deleteManagementServiceGtp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -526,7 +529,7 @@ func createManagementServiceGtp(d *schema.ResourceData, m interface{}) error {
 		serviceGtp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceGtp - Map = ", serviceGtp)
+	log.Println("Create ServiceGtp - Map = ", redactPayload(d, serviceGtp))
 
 	addServiceGtpRes, err := client.ApiCall("add-service-gtp", serviceGtp, client.GetSessionID(), true, false)
 	if err != nil || !addServiceGtpRes.Success {
@@ -563,7 +566,7 @@ func readManagementServiceGtp(d *schema.ResourceData, m interface{}) error {
 
 	serviceGtp := showServiceGtpRes.GetData()
 
-	log.Println("Read ServiceGtp - Show JSON = ", serviceGtp)
+	log.Println("Read ServiceGtp - Show JSON = ", redactPayload(d, serviceGtp))
 
 	if v := serviceGtp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1024,7 +1027,7 @@ func updateManagementServiceGtp(d *schema.ResourceData, m interface{}) error {
 		serviceGtp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceGtp - Map = ", serviceGtp)
+	log.Println("Update ServiceGtp - Map = ", redactPayload(d, serviceGtp))
 
 	updateServiceGtpRes, err := client.ApiCall("set-service-gtp", serviceGtp, client.GetSessionID(), true, false)
 	if err != nil || !updateServiceGtpRes.Success {
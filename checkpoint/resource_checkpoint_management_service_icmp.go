@@ -116,7 +116,7 @@ func createManagementServiceIcmp(d *schema.ResourceData, m interface{}) error {
 		serviceIcmp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceIcmp - Map = ", serviceIcmp)
+	log.Println("Create ServiceIcmp - Map = ", redactPayload(d, serviceIcmp))
 
 	addServiceIcmpRes, err := client.ApiCall("add-service-icmp", serviceIcmp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceIcmpRes.Success {
@@ -153,7 +153,7 @@ func readManagementServiceIcmp(d *schema.ResourceData, m interface{}) error {
 
 	serviceIcmp := showServiceIcmpRes.GetData()
 
-	log.Println("Read ServiceIcmp - Show JSON = ", serviceIcmp)
+	log.Println("Read ServiceIcmp - Show JSON = ", redactPayload(d, serviceIcmp))
 
 	if v := serviceIcmp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -257,7 +257,7 @@ func updateManagementServiceIcmp(d *schema.ResourceData, m interface{}) error {
 		serviceIcmp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceIcmp - Map = ", serviceIcmp)
+	log.Println("Update ServiceIcmp - Map = ", redactPayload(d, serviceIcmp))
 
 	updateServiceIcmpRes, err := client.ApiCall("set-service-icmp", serviceIcmp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceIcmpRes.Success {
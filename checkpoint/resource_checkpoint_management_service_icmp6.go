@@ -13,6 +13,9 @@ func resourceManagementServiceIcmp6() *schema.Resource {
 		Read:   readManagementServiceIcmp6,
 		Update: updateManagementServiceIcmp6,
 		Delete: deleteManagementServiceIcmp6,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -113,7 +116,7 @@ func createManagementServiceIcmp6(d *schema.ResourceData, m interface{}) error {
 		serviceIcmp6["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceIcmp6 - Map = ", serviceIcmp6)
+	log.Println("Create ServiceIcmp6 - Map = ", redactPayload(d, serviceIcmp6))
 
 	addServiceIcmp6Res, err := client.ApiCall("add-service-icmp6", serviceIcmp6, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceIcmp6Res.Success {
@@ -150,7 +153,7 @@ func readManagementServiceIcmp6(d *schema.ResourceData, m interface{}) error {
 
 	serviceIcmp6 := showServiceIcmp6Res.GetData()
 
-	log.Println("Read ServiceIcmp6 - Show JSON = ", serviceIcmp6)
+	log.Println("Read ServiceIcmp6 - Show JSON = ", redactPayload(d, serviceIcmp6))
 
 	if v := serviceIcmp6["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -254,7 +257,7 @@ func updateManagementServiceIcmp6(d *schema.ResourceData, m interface{}) error {
 		serviceIcmp6["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceIcmp6 - Map = ", serviceIcmp6)
+	log.Println("Update ServiceIcmp6 - Map = ", redactPayload(d, serviceIcmp6))
 
 	updateServiceIcmp6Res, err := client.ApiCall("set-service-icmp6", serviceIcmp6, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceIcmp6Res.Success {
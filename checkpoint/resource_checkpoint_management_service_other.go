@@ -233,7 +233,7 @@ func createManagementServiceOther(d *schema.ResourceData, m interface{}) error {
 		serviceOther["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceOther - Map = ", serviceOther)
+	log.Println("Create ServiceOther - Map = ", redactPayload(d, serviceOther))
 
 	addServiceOtherRes, err := client.ApiCall("add-service-other", serviceOther, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceOtherRes.Success {
@@ -270,7 +270,7 @@ func readManagementServiceOther(d *schema.ResourceData, m interface{}) error {
 
 	serviceOther := showServiceOtherRes.GetData()
 
-	log.Println("Read ServiceOther - Show JSON = ", serviceOther)
+	log.Println("Read ServiceOther - Show JSON = ", redactPayload(d, serviceOther))
 
 	if v := serviceOther["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -490,7 +490,7 @@ func updateManagementServiceOther(d *schema.ResourceData, m interface{}) error {
 		serviceOther["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceOther - Map = ", serviceOther)
+	log.Println("Update ServiceOther - Map = ", redactPayload(d, serviceOther))
 
 	updateServiceOtherRes, err := client.ApiCall("set-service-other", serviceOther, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceOtherRes.Success {
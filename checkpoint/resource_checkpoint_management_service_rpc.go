@@ -104,7 +104,7 @@ func createManagementServiceRpc(d *schema.ResourceData, m interface{}) error {
 		serviceRpc["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceRpc - Map = ", serviceRpc)
+	log.Println("Create ServiceRpc - Map = ", redactPayload(d, serviceRpc))
 
 	addServiceRpcRes, err := client.ApiCall("add-service-rpc", serviceRpc, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceRpcRes.Success {
@@ -141,7 +141,7 @@ func readManagementServiceRpc(d *schema.ResourceData, m interface{}) error {
 
 	serviceRpc := showServiceRpcRes.GetData()
 
-	log.Println("Read ServiceRpc - Show JSON = ", serviceRpc)
+	log.Println("Read ServiceRpc - Show JSON = ", redactPayload(d, serviceRpc))
 
 	if v := serviceRpc["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -237,7 +237,7 @@ func updateManagementServiceRpc(d *schema.ResourceData, m interface{}) error {
 		serviceRpc["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceRpc - Map = ", serviceRpc)
+	log.Println("Update ServiceRpc - Map = ", redactPayload(d, serviceRpc))
 
 	updateServiceRpcRes, err := client.ApiCall("set-service-rpc", serviceRpc, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceRpcRes.Success {
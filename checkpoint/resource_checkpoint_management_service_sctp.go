@@ -203,7 +203,7 @@ func createManagementServiceSctp(d *schema.ResourceData, m interface{}) error {
 		serviceSctp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ServiceSctp - Map = ", serviceSctp)
+	log.Println("Create ServiceSctp - Map = ", redactPayload(d, serviceSctp))
 
 	addServiceSctpRes, err := client.ApiCall("add-service-sctp", serviceSctp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceSctpRes.Success {
@@ -240,7 +240,7 @@ func readManagementServiceSctp(d *schema.ResourceData, m interface{}) error {
 
 	serviceSctp := showServiceSctpRes.GetData()
 
-	log.Println("Read ServiceSctp - Show JSON = ", serviceSctp)
+	log.Println("Read ServiceSctp - Show JSON = ", redactPayload(d, serviceSctp))
 
 	if v := serviceSctp["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -431,7 +431,7 @@ func updateManagementServiceSctp(d *schema.ResourceData, m interface{}) error {
 		serviceSctp["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ServiceSctp - Map = ", serviceSctp)
+	log.Println("Update ServiceSctp - Map = ", redactPayload(d, serviceSctp))
 
 	updateServiceSctpRes, err := client.ApiCall("set-service-sctp", serviceSctp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateServiceSctpRes.Success {
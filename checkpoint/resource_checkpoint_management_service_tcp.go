@@ -217,7 +217,7 @@ func createManagementServiceTcp(d *schema.ResourceData, m interface{}) error {
 		serviceTcp["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Service Tcp - Map = ", serviceTcp)
+	log.Println("Create Service Tcp - Map = ", redactPayload(d, serviceTcp))
 
 	addServiceTcpRes, err := client.ApiCall("add-service-tcp", serviceTcp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceTcpRes.Success {
@@ -487,7 +487,7 @@ func updateManagementServiceTcp(d *schema.ResourceData, m interface{}) error {
 		serviceTcp["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Service Tcp - Map = ", serviceTcp)
+	log.Println("Update Service Tcp - Map = ", redactPayload(d, serviceTcp))
 
 	if len(serviceTcp) != 3 {
 		setServiceTcpRes, err := client.ApiCall("set-service-tcp", serviceTcp, client.GetSessionID(), true, client.IsProxyUsed())
@@ -226,7 +226,7 @@ func createManagementServiceUdp(d *schema.ResourceData, m interface{}) error {
 		serviceUdp["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Service Udp - Map = ", serviceUdp)
+	log.Println("Create Service Udp - Map = ", redactPayload(d, serviceUdp))
 
 	addServiceUdpRes, err := client.ApiCall("add-service-udp", serviceUdp, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addServiceUdpRes.Success {
@@ -510,7 +510,7 @@ func updateManagementServiceUdp(d *schema.ResourceData, m interface{}) error {
 		serviceUdp["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Service Udp - Map = ", serviceUdp)
+	log.Println("Update Service Udp - Map = ", redactPayload(d, serviceUdp))
 
 	if len(serviceUdp) != 3 {
 		setServiceUdpRes, err := client.ApiCall("set-service-udp", serviceUdp, client.GetSessionID(), true, client.IsProxyUsed())
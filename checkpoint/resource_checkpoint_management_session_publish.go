@@ -0,0 +1,91 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceManagementSessionPublish() *schema.Resource {
+	return &schema.Resource{
+		Create:        createManagementSessionPublish,
+		Read:          readManagementSessionPublish,
+		Update:        updateManagementSessionPublish,
+		Delete:        deleteManagementSessionPublish,
+		CustomizeDiff: customizeDiffManagementSessionPublish,
+		Schema: map[string]*schema.Schema{
+			"triggers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Publish also when any of these values change, e.g. to publish changes that were made in the session outside of the provider.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Asynchronous task unique identifier of the last publish.",
+			},
+			"published_changes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of changes that the last publish published.",
+			},
+		},
+	}
+}
+
+// customizeDiffManagementSessionPublish plans a publish when the session has pending changes, or when the plan changes
+// resources of the session. It waits until the other resources of the provider were planned.
+func customizeDiffManagementSessionPublish(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if l := clientSessionLifecycle(m); l != nil && l.hasChangesAtRunEnd() {
+		if err := d.SetNewComputed("task_id"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("published_changes")
+	}
+	return nil
+}
+
+func createManagementSessionPublish(d *schema.ResourceData, m interface{}) error {
+	if err := publishManagementSession(d, m); err != nil {
+		return err
+	}
+	d.SetId("session-publish-" + acctest.RandString(10))
+	return readManagementSessionPublish(d, m)
+}
+
+func readManagementSessionPublish(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func updateManagementSessionPublish(d *schema.ResourceData, m interface{}) error {
+	if err := publishManagementSession(d, m); err != nil {
+		return err
+	}
+	return readManagementSessionPublish(d, m)
+}
+
+func deleteManagementSessionPublish(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+func publishManagementSession(d *schema.ResourceData, m interface{}) error {
+	l := clientSessionLifecycle(m)
+	if l == nil {
+		return fmt.Errorf("checkpoint_management_session_publish requires the session_lifecycle block in the provider configuration")
+	}
+
+	taskId, changes, err := l.publish()
+	if err != nil {
+		return err
+	}
+	_ = d.Set("task_id", taskId)
+	_ = d.Set("published_changes", changes)
+	return nil
+}
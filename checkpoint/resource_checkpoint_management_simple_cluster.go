@@ -9,7 +9,7 @@ import (
 )
 
 func resourceManagementSimpleCluster() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementSimpleCluster,
 		Read:   readManagementSimpleCluster,
 		Update: updateManagementSimpleCluster,
@@ -1754,7 +1754,11 @@ func resourceManagementSimpleCluster() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "", map[string]string{
+		"geo_mode":           "1.9",
+		"zero_phishing":      "1.9",
+		"zero_phishing_fqdn": "1.9",
+	})
 }
 
 func createManagementSimpleCluster(d *schema.ResourceData, m interface{}) error {
@@ -2586,7 +2590,7 @@ func createManagementSimpleCluster(d *schema.ResourceData, m interface{}) error
 		cluster["ignore-errors"] = v
 	}
 
-	log.Println("Create Simple Cluster - Map = ", cluster)
+	log.Println("Create Simple Cluster - Map = ", redactPayload(d, cluster))
 
 	addClusterRes, err := client.ApiCall("add-simple-cluster", cluster, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -2653,7 +2657,7 @@ func readManagementSimpleCluster(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	log.Println("Read Simple Cluster - Show JSON = ", cluster)
+	log.Println("Read Simple Cluster - Show JSON = ", redactPayload(d, cluster))
 
 	if v := cluster["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -4582,7 +4586,7 @@ func updateManagementSimpleCluster(d *schema.ResourceData, m interface{}) error
 		cluster["ignore-errors"] = v
 	}
 
-	log.Println("Update Simple Cluster - Map = ", cluster)
+	log.Println("Update Simple Cluster - Map = ", redactPayload(d, cluster))
 
 	if len(cluster) != 3 {
 		updateSimpleClusterRes, err := client.ApiCall("set-simple-cluster", cluster, client.GetSessionID(), true, client.IsProxyUsed())
@@ -10,7 +10,7 @@ import (
 )
 
 func resourceManagementSimpleGateway() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementSimpleGateway,
 		Read:   readManagementSimpleGateway,
 		Update: updateManagementSimpleGateway,
@@ -1889,7 +1889,10 @@ func resourceManagementSimpleGateway() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "", map[string]string{
+		"zero_phishing":      "1.9",
+		"zero_phishing_fqdn": "1.9",
+	})
 }
 
 func createManagementSimpleGateway(d *schema.ResourceData, m interface{}) error {
@@ -2739,7 +2742,7 @@ func createManagementSimpleGateway(d *schema.ResourceData, m interface{}) error
 		gateway["ignore-warnings"] = v
 	}
 
-	log.Println("Create Simple Gateway - Map = ", gateway)
+	log.Println("Create Simple Gateway - Map = ", redactPayload(d, gateway))
 
 	addGatewayRes, err := client.ApiCall("add-simple-gateway", gateway, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addGatewayRes.Success {
@@ -2775,7 +2778,7 @@ func readManagementSimpleGateway(d *schema.ResourceData, m interface{}) error {
 
 	gateway := showGatewayRes.GetData()
 
-	log.Println("Read Simple Gateway - Show JSON = ", gateway)
+	log.Println("Read Simple Gateway - Show JSON = ", redactPayload(d, gateway))
 
 	if v := gateway["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -4831,7 +4834,7 @@ func updateManagementSimpleGateway(d *schema.ResourceData, m interface{}) error
 		gateway["ignore-warnings"] = v
 	}
 
-	log.Println("Update Simple Gateway - Map = ", gateway)
+	log.Println("Update Simple Gateway - Map = ", redactPayload(d, gateway))
 
 	if len(gateway) != 2 {
 		updateSimpleGatewayRes, err := client.ApiCall("set-simple-gateway", gateway, client.GetSessionID(), true, client.IsProxyUsed())
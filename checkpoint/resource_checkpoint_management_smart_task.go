@@ -9,11 +9,14 @@ import (
 )
 
 func resourceManagementSmartTask() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementSmartTask,
 		Read:   readManagementSmartTask,
 		Update: updateManagementSmartTask,
 		Delete: deleteManagementSmartTask,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -254,7 +257,7 @@ func resourceManagementSmartTask() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "1.7", nil)
 }
 
 func createManagementSmartTask(d *schema.ResourceData, m interface{}) error {
@@ -396,7 +399,7 @@ func createManagementSmartTask(d *schema.ResourceData, m interface{}) error {
 		smartTask["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create SmartTask - Map = ", smartTask)
+	log.Println("Create SmartTask - Map = ", redactPayload(d, smartTask))
 
 	addSmartTaskRes, err := client.ApiCall("add-smart-task", smartTask, client.GetSessionID(), true, false)
 	if err != nil || !addSmartTaskRes.Success {
@@ -433,7 +436,7 @@ func readManagementSmartTask(d *schema.ResourceData, m interface{}) error {
 
 	smartTask := showSmartTaskRes.GetData()
 
-	log.Println("Read SmartTask - Show JSON = ", smartTask)
+	log.Println("Read SmartTask - Show JSON = ", redactPayload(d, smartTask))
 
 	if v := smartTask["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -782,7 +785,7 @@ func updateManagementSmartTask(d *schema.ResourceData, m interface{}) error {
 		smartTask["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update SmartTask - Map = ", smartTask)
+	log.Println("Update SmartTask - Map = ", redactPayload(d, smartTask))
 
 	updateSmartTaskRes, err := client.ApiCall("set-smart-task", smartTask, client.GetSessionID(), true, false)
 	if err != nil || !updateSmartTaskRes.Success {
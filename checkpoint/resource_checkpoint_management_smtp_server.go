@@ -13,6 +13,9 @@ func resourceManagementSmtpServer() *schema.Resource {
 		Read:   readManagementSmtpServer,
 		Update: updateManagementSmtpServer,
 		Delete: deleteManagementSmtpServer,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -153,7 +156,7 @@ func createManagementSmtpServer(d *schema.ResourceData, m interface{}) error {
 		smtpServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create SmtpServer - Map = ", smtpServer)
+	log.Println("Create SmtpServer - Map = ", redactPayload(d, smtpServer))
 
 	addSmtpServerRes, err := client.ApiCall("add-smtp-server", smtpServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addSmtpServerRes.Success {
@@ -190,7 +193,7 @@ func readManagementSmtpServer(d *schema.ResourceData, m interface{}) error {
 
 	smtpServer := showSmtpServerRes.GetData()
 
-	log.Println("Read SmtpServer - Show JSON = ", smtpServer)
+	log.Println("Read SmtpServer - Show JSON = ", redactPayload(d, smtpServer))
 
 	if v := smtpServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -343,7 +346,7 @@ func updateManagementSmtpServer(d *schema.ResourceData, m interface{}) error {
 		smtpServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update SmtpServer - Map = ", smtpServer)
+	log.Println("Update SmtpServer - Map = ", redactPayload(d, smtpServer))
 
 	updateSmtpServerRes, err := client.ApiCall("set-smtp-server", smtpServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateSmtpServerRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementSyslogServer() *schema.Resource {
 		Read:   readManagementSyslogServer,
 		Update: updateManagementSyslogServer,
 		Delete: deleteManagementSyslogServer,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -110,7 +113,7 @@ func createManagementSyslogServer(d *schema.ResourceData, m interface{}) error {
 		syslogServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create SyslogServer - Map = ", syslogServer)
+	log.Println("Create SyslogServer - Map = ", redactPayload(d, syslogServer))
 
 	addSyslogServerRes, err := client.ApiCallSimple("add-syslog-server", syslogServer)
 	if err != nil || !addSyslogServerRes.Success {
@@ -147,7 +150,7 @@ func readManagementSyslogServer(d *schema.ResourceData, m interface{}) error {
 
 	syslogServer := showSyslogServerRes.GetData()
 
-	log.Println("Read SyslogServer - Show JSON = ", syslogServer)
+	log.Println("Read SyslogServer - Show JSON = ", redactPayload(d, syslogServer))
 
 	if v := syslogServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -248,7 +251,7 @@ func updateManagementSyslogServer(d *schema.ResourceData, m interface{}) error {
 		syslogServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update SyslogServer - Map = ", syslogServer)
+	log.Println("Update SyslogServer - Map = ", redactPayload(d, syslogServer))
 
 	updateSyslogServerRes, err := client.ApiCallSimple("set-syslog-server", syslogServer)
 	if err != nil || !updateSyslogServerRes.Success {
@@ -98,7 +98,7 @@ func createManagementTacacsGroup(d *schema.ResourceData, m interface{}) error {
 		tacacsGroupPayload["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Tacacs Group - Map = ", tacacsGroupPayload)
+	log.Println("Create Tacacs Group - Map = ", redactPayload(d, tacacsGroupPayload))
 
 	addTacacsGroupRes, err := client.ApiCall("add-tacacs-group", tacacsGroupPayload, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTacacsGroupRes.Success {
@@ -135,7 +135,7 @@ func readManagementTacacsGroup(d *schema.ResourceData, m interface{}) error {
 
 	tacacsGroup := showTacacsGroupRes.GetData()
 
-	log.Println("Read Tacacs Group - Show JSON = ", tacacsGroup)
+	log.Println("Read Tacacs Group - Show JSON = ", redactPayload(d, tacacsGroup))
 
 	if v := tacacsGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -232,7 +232,7 @@ func updateManagementTacacsGroup(d *schema.ResourceData, m interface{}) error {
 		tacacsGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Tacacs Group - Map = ", tacacsGroup)
+	log.Println("Update Tacacs Group - Map = ", redactPayload(d, tacacsGroup))
 
 	updateTacacsGroupRes, err := client.ApiCall("set-tacacs-group", tacacsGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTacacsGroupRes.Success {
@@ -13,6 +13,9 @@ func resourceManagementTacacsServer() *schema.Resource {
 		Read:   readManagementTacacsServer,
 		Update: updateManagementTacacsServer,
 		Delete: deleteManagementTacacsServer,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -142,7 +145,7 @@ func createManagementTacacsServer(d *schema.ResourceData, m interface{}) error {
 		tacacsServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Tacacs Server - Map = ", tacacsServer)
+	log.Println("Create Tacacs Server - Map = ", redactPayload(d, tacacsServer))
 
 	addTacacsServerRes, err := client.ApiCall("add-tacacs-server", tacacsServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTacacsServerRes.Success {
@@ -179,7 +182,7 @@ func readManagementTacacsServer(d *schema.ResourceData, m interface{}) error {
 
 	tacacsServer := showTacacsServerRes.GetData()
 
-	log.Println("Read TacacsServer - Show JSON = ", tacacsServer)
+	log.Println("Read TacacsServer - Show JSON = ", redactPayload(d, tacacsServer))
 
 	if v := tacacsServer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -295,7 +298,7 @@ func updateManagementTacacsServer(d *schema.ResourceData, m interface{}) error {
 		tacacsServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update TacacsServer - Map = ", tacacsServer)
+	log.Println("Update TacacsServer - Map = ", redactPayload(d, tacacsServer))
 
 	updateTacacsServerRes, err := client.ApiCall("set-tacacs-server", tacacsServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTacacsServerRes.Success {
@@ -86,7 +86,7 @@ func createManagementTag(d *schema.ResourceData, m interface{}) error {
 		tag["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Tag - Map = ", tag)
+	log.Println("Create Tag - Map = ", redactPayload(d, tag))
 
 	addTagRes, err := client.ApiCall("add-tag", tag, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTagRes.Success {
@@ -123,7 +123,7 @@ func readManagementTag(d *schema.ResourceData, m interface{}) error {
 
 	tag := showTagRes.GetData()
 
-	log.Println("Read Tag - Show JSON = ", tag)
+	log.Println("Read Tag - Show JSON = ", redactPayload(d, tag))
 
 	if v := tag["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -203,7 +203,7 @@ func updateManagementTag(d *schema.ResourceData, m interface{}) error {
 		tag["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Tag - Map = ", tag)
+	log.Println("Update Tag - Map = ", redactPayload(d, tag))
 
 	updateTagRes, err := client.ApiCall("set-tag", tag, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTagRes.Success {
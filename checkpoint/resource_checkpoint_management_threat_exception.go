@@ -16,16 +16,17 @@ func resourceManagementThreatException() *schema.Resource {
 		Delete: deleteManagementThreatException,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				arr := strings.Split(d.Id(), ";")
-				if len(arr) != 3 {
-					return nil, fmt.Errorf("invalid unique identifier format. UID format: <LAYER_UID>;exception_group_uid or rule_uid;<EXCEPTION_GROUP_UID> or <PARENT_RULE_UID>;<RULE_UID>")
+				arr, uid, err := parseCompositeId(d.Id(), "<LAYER_UID>;exception_group_uid or rule_uid;<EXCEPTION_GROUP_UID> or <PARENT_RULE_UID>;<RULE_UID>", 3)
+				if err != nil {
+					return nil, err
 				}
-				if !strings.EqualFold(arr[1], "exception_group_uid") && !strings.EqualFold(arr[1], "rule_uid") {
+				field := strings.ToLower(arr[1])
+				if field != "exception_group_uid" && field != "rule_uid" {
 					return nil, fmt.Errorf("invalid unique identifier 2nd argument. Valid values: exception_group_uid or rule_uid")
 				}
 				_ = d.Set("layer", arr[0])
-				_ = d.Set(arr[1], arr[2]) // Set exception_group_uid or rule_uid
-				d.SetId(arr[3])
+				_ = d.Set(field, arr[2]) // Set exception_group_uid or rule_uid
+				d.SetId(uid)
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -326,7 +327,7 @@ func createManagementThreatException(d *schema.ResourceData, m interface{}) erro
 		threatException["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Create Threat Exception - Map = ", threatException)
+	log.Println("Create Threat Exception - Map = ", redactPayload(d, threatException))
 
 	addThreatExceptionRes, err := client.ApiCall("add-threat-exception", threatException, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addThreatExceptionRes.Success {
@@ -382,7 +383,7 @@ func readManagementThreatException(d *schema.ResourceData, m interface{}) error
 
 	exceptionRule := showThreatRuleRes.GetData()
 
-	log.Println("Read Threat Exception - Show JSON = ", exceptionRule)
+	log.Println("Read Threat Exception - Show JSON = ", redactPayload(d, exceptionRule))
 
 	if v := exceptionRule["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -680,7 +681,7 @@ func updateManagementThreatException(d *schema.ResourceData, m interface{}) erro
 		threatException["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Threat Exception - Map = ", threatException)
+	log.Println("Update Threat Exception - Map = ", redactPayload(d, threatException))
 
 	updateThreatExceptionRes, err := client.ApiCall("set-threat-exception", threatException, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateThreatExceptionRes.Success {
@@ -15,7 +15,7 @@ func resourceManagementThreatIndicator() *schema.Resource {
 		Update: updateManagementThreatIndicator,
 		Delete: deleteManagementThreatIndicator,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByField("name"),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -281,7 +281,7 @@ func createManagementThreatIndicator(d *schema.ResourceData, m interface{}) erro
 		threatIndicator["ignore-warnings"] = val.(bool)
 	}
 
-	log.Println("Create Threat Indicator - Map = ", threatIndicator)
+	log.Println("Create Threat Indicator - Map = ", redactPayload(d, threatIndicator))
 
 	threatIndicatorRes, err := client.ApiCall("add-threat-indicator", threatIndicator, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -344,7 +344,7 @@ func readManagementThreatIndicator(d *schema.ResourceData, m interface{}) error
 
 	threatIndicator := showThreatIndicatorRes.GetData()
 
-	log.Println("Read Threat Indicator - Show JSON = ", threatIndicator)
+	log.Println("Read Threat Indicator - Show JSON = ", redactPayload(d, threatIndicator))
 
 	if v := threatIndicator["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -486,7 +486,7 @@ func updateManagementThreatIndicator(d *schema.ResourceData, m interface{}) erro
 		}
 	}
 
-	log.Println("Update Threat Indicator - Map = ", threatIndicator)
+	log.Println("Update Threat Indicator - Map = ", redactPayload(d, threatIndicator))
 	updateThreatIndicatorRes, err := client.ApiCall("set-threat-indicator", threatIndicator, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateThreatIndicatorRes.Success {
 		if updateThreatIndicatorRes.ErrorMsg != "" {
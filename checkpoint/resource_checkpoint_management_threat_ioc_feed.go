@@ -15,6 +15,9 @@ func resourceManagementThreatIocFeed() *schema.Resource {
 		Read:   readManagementThreatIocFeed,
 		Update: updateManagementThreatIocFeed,
 		Delete: deleteManagementThreatIocFeed,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -285,7 +288,7 @@ func createManagementThreatIocFeed(d *schema.ResourceData, m interface{}) error
 		threatIocFeed["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create ThreatIocFeed - Map = ", threatIocFeed)
+	log.Println("Create ThreatIocFeed - Map = ", redactPayload(d, threatIocFeed))
 
 	addThreatIocFeedRes, err := client.ApiCall("add-threat-ioc-feed", threatIocFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addThreatIocFeedRes.Success {
@@ -322,7 +325,7 @@ func readManagementThreatIocFeed(d *schema.ResourceData, m interface{}) error {
 
 	threatIocFeed := showThreatIocFeedRes.GetData()
 
-	log.Println("Read ThreatIocFeed - Show JSON = ", threatIocFeed)
+	log.Println("Read ThreatIocFeed - Show JSON = ", redactPayload(d, threatIocFeed))
 
 	if v := threatIocFeed["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -594,7 +597,7 @@ func updateManagementThreatIocFeed(d *schema.ResourceData, m interface{}) error
 		threatIocFeed["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update ThreatIocFeed - Map = ", threatIocFeed)
+	log.Println("Update ThreatIocFeed - Map = ", redactPayload(d, threatIocFeed))
 
 	updateThreatIocFeedRes, err := client.ApiCall("set-threat-ioc-feed", threatIocFeed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateThreatIocFeedRes.Success {
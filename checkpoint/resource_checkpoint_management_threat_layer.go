@@ -106,7 +106,7 @@ func createManagementThreatLayer(d *schema.ResourceData, m interface{}) error {
 		threatLayer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Threat Layer - Map = ", threatLayer)
+	log.Println("Create Threat Layer - Map = ", redactPayload(d, threatLayer))
 
 	addThreatLayerRes, err := client.ApiCall("add-threat-layer", threatLayer, client.GetSessionID(), true, client.IsProxyUsed())
 
@@ -145,7 +145,7 @@ func readManagementThreatLayer(d *schema.ResourceData, m interface{}) error {
 
 	threatLayer := showThreatLayerRes.GetData()
 
-	log.Println("Read Threat Layer - Show JSON = ", threatLayer)
+	log.Println("Read Threat Layer - Show JSON = ", redactPayload(d, threatLayer))
 
 	if v := threatLayer["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -227,7 +227,7 @@ func updateManagementThreatLayer(d *schema.ResourceData, m interface{}) error {
 		threatLayer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Threat Layer - Map = ", threatLayer)
+	log.Println("Update Threat Layer - Map = ", redactPayload(d, threatLayer))
 	updateThreatLayerRes, err := client.ApiCall("set-threat-layer", threatLayer, client.GetSessionID(), true, client.IsProxyUsed())
 
 	if err != nil || !updateThreatLayerRes.Success {
@@ -10,7 +10,7 @@ import (
 )
 
 func resourceManagementThreatProfile() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementThreatProfile,
 		Read:   readManagementThreatProfile,
 		Update: updateManagementThreatProfile,
@@ -452,7 +452,9 @@ func resourceManagementThreatProfile() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "", map[string]string{
+		"zero_phishing": "1.9",
+	})
 }
 
 func createManagementThreatProfile(d *schema.ResourceData, m interface{}) error {
@@ -696,7 +698,7 @@ func createManagementThreatProfile(d *schema.ResourceData, m interface{}) error
 		threatProfile["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Create Threat Profile - Map = ", threatProfile)
+	log.Println("Create Threat Profile - Map = ", redactPayload(d, threatProfile))
 
 	threatProfileRes, err := client.ApiCall("add-threat-profile", threatProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -746,7 +748,7 @@ func readManagementThreatProfile(d *schema.ResourceData, m interface{}) error {
 
 	threatProfile := showThreatProfileRes.GetData()
 
-	log.Println("Read Threat Profile - Show JSON = ", threatProfile)
+	log.Println("Read Threat Profile - Show JSON = ", redactPayload(d, threatProfile))
 
 	if v := threatProfile["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1376,7 +1378,7 @@ func updateManagementThreatProfile(d *schema.ResourceData, m interface{}) error
 		threatProfile["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Threat Profile - Map = ", threatProfile)
+	log.Println("Update Threat Profile - Map = ", redactPayload(d, threatProfile))
 
 	threatProfileRes, err := client.ApiCall("set-threat-profile", threatProfile, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"log"
 	"reflect"
-	"strings"
 )
 
 func resourceManagementThreatRule() *schema.Resource {
@@ -16,15 +15,7 @@ func resourceManagementThreatRule() *schema.Resource {
 		Update: updateManagementThreatRule,
 		Delete: deleteManagementThreatRule,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				arr := strings.Split(d.Id(), ";")
-				if len(arr) != 2 {
-					return nil, fmt.Errorf("invalid unique identifier format. UID format: <LAYER_NAME>;<RULE_UID>")
-				}
-				_ = d.Set("layer", arr[0])
-				d.SetId(arr[1])
-				return []*schema.ResourceData{d}, nil
-			},
+			State: importStateCompositeId("<LAYER_NAME>;<RULE_UID>", "layer"),
 		},
 		Schema: map[string]*schema.Schema{
 			"layer": {
@@ -299,7 +290,7 @@ func createManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 		threatRule["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Create Threat Rule - Map = ", threatRule)
+	log.Println("Create Threat Rule - Map = ", redactPayload(d, threatRule))
 
 	addThreatRuleRes, err := client.ApiCall("add-threat-rule", threatRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addThreatRuleRes.Success {
@@ -311,6 +302,8 @@ func createManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(addThreatRuleRes.GetData()["uid"].(string))
 
+	invalidateRulebaseCache(client, "show-threat-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
 	return readManagementThreatRule(d, m)
 }
 
@@ -338,7 +331,11 @@ func readManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 
 	threatRule := showThreatRuleRes.GetData()
 
-	log.Println("Read Threat Rule - Show JSON = ", threatRule)
+	log.Println("Read Threat Rule - Show JSON = ", redactPayload(d, threatRule))
+
+	if err := readRulePosition(client, "show-threat-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)), d); err != nil {
+		return err
+	}
 
 	if v := threatRule["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -622,7 +619,7 @@ func updateManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 		threatRule["ignore-warnings"] = v.(bool)
 	}
 
-	log.Println("Update Threat Rule - Map = ", threatRule)
+	log.Println("Update Threat Rule - Map = ", redactPayload(d, threatRule))
 
 	updateThreatRuleRes, err := client.ApiCall("set-threat-rule", threatRule, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateThreatRuleRes.Success {
@@ -631,6 +628,8 @@ func updateManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 		}
 		return fmt.Errorf(err.Error())
 	}
+	invalidateRulebaseCache(client, "show-threat-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
+
 	return readManagementThreatRule(d, m)
 }
 
@@ -649,6 +648,7 @@ func deleteManagementThreatRule(d *schema.ResourceData, m interface{}) error {
 		}
 		return fmt.Errorf(err.Error())
 	}
+	invalidateRulebaseCache(client, "show-threat-rulebase", rulebaseIdentifierPayload(d.Get("layer").(string)))
 	d.SetId("")
 	return nil
 }
@@ -285,7 +285,7 @@ func createManagementTime(d *schema.ResourceData, m interface{}) error {
 		time["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Time - Map = ", time)
+	log.Println("Create Time - Map = ", redactPayload(d, time))
 
 	addTimeRes, err := client.ApiCall("add-time", time, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTimeRes.Success {
@@ -322,7 +322,7 @@ func readManagementTime(d *schema.ResourceData, m interface{}) error {
 
 	time := showTimeRes.GetData()
 
-	log.Println("Read Time - Show JSON = ", time)
+	log.Println("Read Time - Show JSON = ", redactPayload(d, time))
 
 	if v := time["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -639,7 +639,7 @@ func updateManagementTime(d *schema.ResourceData, m interface{}) error {
 		time["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Time - Map = ", time)
+	log.Println("Update Time - Map = ", redactPayload(d, time))
 
 	updateTimeRes, err := client.ApiCall("set-time", time, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTimeRes.Success {
@@ -98,7 +98,7 @@ func createManagementTimeGroup(d *schema.ResourceData, m interface{}) error {
 		timeGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create TimeGroup - Map = ", timeGroup)
+	log.Println("Create TimeGroup - Map = ", redactPayload(d, timeGroup))
 
 	addTimeGroupRes, err := client.ApiCall("add-time-group", timeGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTimeGroupRes.Success {
@@ -135,7 +135,7 @@ func readManagementTimeGroup(d *schema.ResourceData, m interface{}) error {
 
 	timeGroup := showTimeGroupRes.GetData()
 
-	log.Println("Read TimeGroup - Show JSON = ", timeGroup)
+	log.Println("Read TimeGroup - Show JSON = ", redactPayload(d, timeGroup))
 
 	if v := timeGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -240,7 +240,7 @@ func updateManagementTimeGroup(d *schema.ResourceData, m interface{}) error {
 		timeGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update TimeGroup - Map = ", timeGroup)
+	log.Println("Update TimeGroup - Map = ", redactPayload(d, timeGroup))
 
 	updateTimeGroupRes, err := client.ApiCall("set-time-group", timeGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTimeGroupRes.Success {
@@ -199,7 +199,7 @@ func createManagementTrustedClient(d *schema.ResourceData, m interface{}) error
 		trustedClient["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create TrustedClient - Map = ", trustedClient)
+	log.Println("Create TrustedClient - Map = ", redactPayload(d, trustedClient))
 
 	addTrustedClientRes, err := client.ApiCall("add-trusted-client", trustedClient, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addTrustedClientRes.Success {
@@ -236,7 +236,7 @@ func readManagementTrustedClient(d *schema.ResourceData, m interface{}) error {
 
 	trustedClient := showTrustedClientRes.GetData()
 
-	log.Println("Read TrustedClient - Show JSON = ", trustedClient)
+	log.Println("Read TrustedClient - Show JSON = ", redactPayload(d, trustedClient))
 
 	if v := trustedClient["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -429,7 +429,7 @@ func updateManagementTrustedClient(d *schema.ResourceData, m interface{}) error
 		trustedClient["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update TrustedClient - Map = ", trustedClient)
+	log.Println("Update TrustedClient - Map = ", redactPayload(d, trustedClient))
 
 	updateTrustedClientRes, err := client.ApiCall("set-trusted-client", trustedClient, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateTrustedClientRes.Success {
@@ -16,6 +16,9 @@ func resourceManagementUser() *schema.Resource {
 		Read:   readManagementUser,
 		Update: updateManagementUser,
 		Delete: deleteManagementUser,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -285,7 +288,7 @@ func createManagementUser(d *schema.ResourceData, m interface{}) error {
 		user["template"] = v.(string)
 	}
 
-	log.Println("Create User - Map = ", user)
+	log.Println("Create User - Map = ", redactPayload(d, user))
 
 	addUserRes, err := client.ApiCall("add-user", user, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addUserRes.Success {
@@ -322,7 +325,7 @@ func readManagementUser(d *schema.ResourceData, m interface{}) error {
 
 	user := showUserRes.GetData()
 
-	log.Println("Read User - Show JSON = ", user)
+	log.Println("Read User - Show JSON = ", redactPayload(d, user))
 
 	if v := user["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -586,7 +589,7 @@ func updateManagementUser(d *schema.ResourceData, m interface{}) error {
 		user["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update User - Map = ", user)
+	log.Println("Update User - Map = ", redactPayload(d, user))
 
 	updateUserRes, err := client.ApiCall("set-user", user, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateUserRes.Success {
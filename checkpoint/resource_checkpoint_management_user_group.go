@@ -13,6 +13,9 @@ func resourceManagementUserGroup() *schema.Resource {
 		Read:   readManagementUserGroup,
 		Update: updateManagementUserGroup,
 		Delete: deleteManagementUserGroup,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -104,7 +107,7 @@ func createManagementUserGroup(d *schema.ResourceData, m interface{}) error {
 		userGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create UserGroup - Map = ", userGroup)
+	log.Println("Create UserGroup - Map = ", redactPayload(d, userGroup))
 
 	addUserGroupRes, err := client.ApiCall("add-user-group", userGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addUserGroupRes.Success {
@@ -141,7 +144,7 @@ func readManagementUserGroup(d *schema.ResourceData, m interface{}) error {
 
 	userGroup := showUserGroupRes.GetData()
 
-	log.Println("Read UserGroup - Show JSON = ", userGroup)
+	log.Println("Read UserGroup - Show JSON = ", redactPayload(d, userGroup))
 
 	if v := userGroup["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -245,7 +248,7 @@ func updateManagementUserGroup(d *schema.ResourceData, m interface{}) error {
 		userGroup["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update UserGroup - Map = ", userGroup)
+	log.Println("Update UserGroup - Map = ", redactPayload(d, userGroup))
 
 	updateUserGroupRes, err := client.ApiCall("set-user-group", userGroup, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateUserGroupRes.Success {
@@ -16,6 +16,9 @@ func resourceManagementUserTemplate() *schema.Resource {
 		Read:   readManagementUserTemplate,
 		Update: updateManagementUserTemplate,
 		Delete: deleteManagementUserTemplate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -252,7 +255,7 @@ func createManagementUserTemplate(d *schema.ResourceData, m interface{}) error {
 		userTemplate["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create UserTemplate - Map = ", userTemplate)
+	log.Println("Create UserTemplate - Map = ", redactPayload(d, userTemplate))
 
 	addUserTemplateRes, err := client.ApiCall("add-user-template", userTemplate, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addUserTemplateRes.Success {
@@ -289,7 +292,7 @@ func readManagementUserTemplate(d *schema.ResourceData, m interface{}) error {
 
 	userTemplate := showUserTemplateRes.GetData()
 
-	log.Println("Read UserTemplate - Show JSON = ", userTemplate)
+	log.Println("Read UserTemplate - Show JSON = ", redactPayload(d, userTemplate))
 
 	if v := userTemplate["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -553,7 +556,7 @@ func updateManagementUserTemplate(d *schema.ResourceData, m interface{}) error {
 		userTemplate["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update UserTemplate - Map = ", userTemplate)
+	log.Println("Update UserTemplate - Map = ", redactPayload(d, userTemplate))
 
 	updateUserTemplateRes, err := client.ApiCall("set-user-template", userTemplate, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateUserTemplateRes.Success {
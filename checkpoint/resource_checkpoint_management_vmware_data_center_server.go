@@ -177,7 +177,7 @@ func createManagementVMwareDataCenterServer(d *schema.ResourceData, m interface{
 		vmwareDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create vmwareDataCenterServer - Map = ", vmwareDataCenterServer)
+	log.Println("Create vmwareDataCenterServer - Map = ", redactPayload(d, vmwareDataCenterServer))
 
 	addVMwareDataCenterServerRes, err := client.ApiCall("add-data-center-server", vmwareDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -360,7 +360,7 @@ func updateManagementVMwareDataCenterServer(d *schema.ResourceData, m interface{
 		vmwareDataCenterServer["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update vmwareDataCenterServer - Map = ", vmwareDataCenterServer)
+	log.Println("Update vmwareDataCenterServer - Map = ", redactPayload(d, vmwareDataCenterServer))
 
 	updateVMwareDataCenterServerRes, err := client.ApiCall("set-data-center-server", vmwareDataCenterServer, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil {
@@ -10,7 +10,7 @@ import (
 )
 
 func resourceManagementVpnCommunityMeshed() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementVpnCommunityMeshed,
 		Read:   readManagementVpnCommunityMeshed,
 		Update: updateManagementVpnCommunityMeshed,
@@ -297,7 +297,9 @@ func resourceManagementVpnCommunityMeshed() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "", map[string]string{
+		"granular_encryptions": "1.8",
+	})
 }
 
 func createManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) error {
@@ -498,7 +500,7 @@ func createManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) e
 		vpnCommunityMeshed["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create VpnCommunityMeshed - Map = ", vpnCommunityMeshed)
+	log.Println("Create VpnCommunityMeshed - Map = ", redactPayload(d, vpnCommunityMeshed))
 
 	addVpnCommunityMeshedRes, err := client.ApiCall("add-vpn-community-meshed", vpnCommunityMeshed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVpnCommunityMeshedRes.Success {
@@ -535,7 +537,7 @@ func readManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) err
 
 	vpnCommunityMeshed := showVpnCommunityMeshedRes.GetData()
 
-	log.Println("Read VpnCommunityMeshed - Show JSON = ", vpnCommunityMeshed)
+	log.Println("Read VpnCommunityMeshed - Show JSON = ", redactPayload(d, vpnCommunityMeshed))
 
 	if v := vpnCommunityMeshed["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1073,7 +1075,7 @@ func updateManagementVpnCommunityMeshed(d *schema.ResourceData, m interface{}) e
 		vpnCommunityMeshed["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update VpnCommunityMeshed - Map = ", vpnCommunityMeshed)
+	log.Println("Update VpnCommunityMeshed - Map = ", redactPayload(d, vpnCommunityMeshed))
 
 	updateVpnCommunityMeshedRes, err := client.ApiCall("set-vpn-community-meshed", vpnCommunityMeshed, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVpnCommunityMeshedRes.Success {
@@ -14,6 +14,9 @@ func resourceManagementVpnCommunityRemoteAccess() *schema.Resource {
 		Read:   readManagementVpnCommunityRemoteAccess,
 		Update: updateManagementVpnCommunityRemoteAccess,
 		Delete: deleteManagementVpnCommunityRemoteAccess,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -201,7 +204,7 @@ func readManagementVpnCommunityRemoteAccess(d *schema.ResourceData, m interface{
 
 	vpnCommunityRemoteAccess := showVpnCommunityRemoteAccessRes.GetData()
 
-	log.Println("Read VpnCommunityRemoteAccess - Show JSON = ", vpnCommunityRemoteAccess)
+	log.Println("Read VpnCommunityRemoteAccess - Show JSON = ", redactPayload(d, vpnCommunityRemoteAccess))
 
 	if v := vpnCommunityRemoteAccess["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -10,7 +10,7 @@ import (
 )
 
 func resourceManagementVpnCommunityStar() *schema.Resource {
-	return &schema.Resource{
+	return requireApiVersions(&schema.Resource{
 		Create: createManagementVpnCommunityStar,
 		Read:   readManagementVpnCommunityStar,
 		Update: updateManagementVpnCommunityStar,
@@ -311,7 +311,9 @@ func resourceManagementVpnCommunityStar() *schema.Resource {
 				Default:     false,
 			},
 		},
-	}
+	}, "", map[string]string{
+		"granular_encryptions": "1.8",
+	})
 }
 
 func createManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) error {
@@ -520,7 +522,7 @@ func createManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) err
 		vpnCommunityStar["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create VpnCommunityStar - Map = ", vpnCommunityStar)
+	log.Println("Create VpnCommunityStar - Map = ", redactPayload(d, vpnCommunityStar))
 
 	addVpnCommunityStarRes, err := client.ApiCall("add-vpn-community-star", vpnCommunityStar, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addVpnCommunityStarRes.Success {
@@ -557,7 +559,7 @@ func readManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) error
 
 	vpnCommunityStar := showVpnCommunityStarRes.GetData()
 
-	log.Println("Read VpnCommunityStar - Show JSON = ", vpnCommunityStar)
+	log.Println("Read VpnCommunityStar - Show JSON = ", redactPayload(d, vpnCommunityStar))
 
 	if v := vpnCommunityStar["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -1127,7 +1129,7 @@ func updateManagementVpnCommunityStar(d *schema.ResourceData, m interface{}) err
 		vpnCommunityStar["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update VpnCommunityStar - Map = ", vpnCommunityStar)
+	log.Println("Update VpnCommunityStar - Map = ", redactPayload(d, vpnCommunityStar))
 
 	updateVpnCommunityStarRes, err := client.ApiCall("set-vpn-community-star", vpnCommunityStar, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateVpnCommunityStarRes.Success {
@@ -122,7 +122,7 @@ func createManagementWildcard(d *schema.ResourceData, m interface{}) error {
 		wildcard["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Create Wildcard - Map = ", wildcard)
+	log.Println("Create Wildcard - Map = ", redactPayload(d, wildcard))
 
 	addWildcardRes, err := client.ApiCall("add-wildcard", wildcard, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !addWildcardRes.Success {
@@ -159,7 +159,7 @@ func readManagementWildcard(d *schema.ResourceData, m interface{}) error {
 
 	wildcard := showWildcardRes.GetData()
 
-	log.Println("Read Wildcard - Show JSON = ", wildcard)
+	log.Println("Read Wildcard - Show JSON = ", redactPayload(d, wildcard))
 
 	if v := wildcard["name"]; v != nil {
 		_ = d.Set("name", v)
@@ -271,7 +271,7 @@ func updateManagementWildcard(d *schema.ResourceData, m interface{}) error {
 		wildcard["ignore-errors"] = v.(bool)
 	}
 
-	log.Println("Update Wildcard - Map = ", wildcard)
+	log.Println("Update Wildcard - Map = ", redactPayload(d, wildcard))
 
 	updateWildcardRes, err := client.ApiCall("set-wildcard", wildcard, client.GetSessionID(), true, client.IsProxyUsed())
 	if err != nil || !updateWildcardRes.Success {
@@ -0,0 +1,148 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceNtp() *schema.Resource {
+	return &schema.Resource{
+		Create: createNtp,
+		Read:   readNtp,
+		Update: updateNtp,
+		Delete: deleteNtp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable or disable the NTP client.",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "NTP servers.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IPv4 address or host name of the NTP server.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "server",
+							Description:  "The type of the NTP server, pool or server.",
+							ValidateFunc: validateStringValue("pool", "server"),
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     4,
+							Description: "The NTP version of the server.",
+						},
+					},
+				},
+			},
+			"preferred": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address of the preferred NTP server, one of the servers.",
+			},
+			"current_server": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Address of the NTP server that the machine is synchronized with.",
+			},
+		},
+	}
+}
+
+func ntpParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	ntpMap := make(map[string]interface{})
+
+	ntpMap["enabled"] = d.Get("enabled").(bool)
+
+	servers := make([]interface{}, 0)
+	for _, server := range d.Get("servers").([]interface{}) {
+		serverMap := server.(map[string]interface{})
+		servers = append(servers, map[string]interface{}{
+			"address": serverMap["address"],
+			"type":    serverMap["type"],
+			"version": serverMap["version"],
+		})
+	}
+	ntpMap["servers"] = servers
+
+	if v, ok := d.GetOk("preferred"); ok {
+		ntpMap["preferred"] = v.(string)
+	}
+
+	return ntpMap
+}
+
+func createNtp(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-ntp", ntpParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	// The NTP settings are a single object of the machine
+	d.SetId("ntp")
+
+	return readNtp(d, m)
+}
+
+func readNtp(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	ntpJson, err := showGaiaObject(client, gaiaTarget(d), "show-ntp", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if ntpJson == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := ntpJson["enabled"].(bool); ok {
+		_ = d.Set("enabled", v)
+	}
+
+	var serversState []map[string]interface{}
+	if servers, ok := ntpJson["servers"].([]interface{}); ok {
+		for _, server := range servers {
+			serverMap, ok := server.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			serversState = append(serversState, map[string]interface{}{
+				"address": serverMap["address"],
+				"type":    serverMap["type"],
+				"version": gaiaInt(serverMap["version"]),
+			})
+		}
+	}
+	_ = d.Set("servers", serversState)
+
+	_ = d.Set("preferred", ntpJson["preferred"])
+	_ = d.Set("current_server", ntpJson["current-server"])
+
+	return nil
+}
+
+func updateNtp(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-ntp", ntpParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readNtp(d, m)
+}
+
+func deleteNtp(d *schema.ResourceData, m interface{}) error {
+	d.SetId("") // Destroy resource
+	return nil
+}
@@ -0,0 +1,84 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointNtp_basic(t *testing.T) {
+	resourceName := "checkpoint_ntp.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNtpConfig("pool.ntp.org", "time.google.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "preferred", "pool.ntp.org"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointNtp_basic(t *testing.T) {
+	resourceName := "checkpoint_ntp.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccNtpConfig("ntp1.example.com", "ntp2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "servers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "servers.0.address", "ntp1.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "servers.0.type", "pool"),
+					resource.TestCheckResourceAttr(resourceName, "servers.1.version", "4"),
+					resource.TestCheckResourceAttr(resourceName, "preferred", "ntp1.example.com"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccNtpConfig("ntp3.example.com", "ntp2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "servers.0.address", "ntp3.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "preferred", "ntp3.example.com"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccNtpConfig("ntp3.example.com", "ntp2.example.com"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "ntp",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNtpConfig(preferred string, other string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_ntp" "test" {
+    enabled = true
+    servers {
+        address = "%[1]s"
+        type = "pool"
+    }
+    servers {
+        address = "%[2]s"
+    }
+    preferred = "%[1]s"
+}
+`, preferred, other)
+}
@@ -1,7 +1,6 @@
 package checkpoint
 
 import (
-	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"reflect"
@@ -15,7 +14,7 @@ func resourcePhysicalInterface() *schema.Resource {
 		Update: updatePhysicalInterface,
 		Delete: deletePhysicalInterface,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByField("name"),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -280,13 +279,13 @@ func createPhysicalInterface(d *schema.ResourceData, m interface{}) error {
 		payload["ipv4-mask-length"] = v.(int)
 	}
 
-	setPIRes, _ := client.ApiCall("set-physical-interface", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !setPIRes.Success {
-		return fmt.Errorf(setPIRes.ErrorMsg)
+	setPIJson, err := callGaiaApi(client, gaiaTarget(d), "set-physical-interface", payload)
+	if err != nil {
+		return err
 	}
 
 	// Set Schema UID = Object key
-	d.SetId(setPIRes.GetData()["name"].(string))
+	d.SetId(setPIJson["name"].(string))
 
 	return readPhysicalInterface(d, m)
 }
@@ -296,16 +295,15 @@ func readPhysicalInterface(d *schema.ResourceData, m interface{}) error {
 	payload := map[string]interface{}{
 		"name": d.Get("name"),
 	}
-	showPIRes, _ := client.ApiCall("show-physical-interface", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !showPIRes.Success {
-		// Handle deletion of an object from other clients - Object not found
-		if objectNotFound(showPIRes.GetData()["code"].(string)) {
-			d.SetId("") // Destroy resource
-			return nil
-		}
-		return fmt.Errorf(showPIRes.ErrorMsg)
+	PIJson, err := showGaiaObject(client, gaiaTarget(d), "show-physical-interface", payload)
+	if err != nil {
+		return err
+	}
+	// Handle deletion of an object from other clients - Object not found
+	if PIJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
 	}
-	PIJson := showPIRes.GetData()
 
 	_ = d.Set("name", PIJson["name"].(string))
 
@@ -353,9 +351,8 @@ func updatePhysicalInterface(d *schema.ResourceData, m interface{}) error {
 		payload["ipv4-mask-length"] = d.Get("ipv4_mask_length")
 	}
 
-	setNetworkRes, _ := client.ApiCall("set-physical-interface", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !setNetworkRes.Success {
-		return fmt.Errorf(setNetworkRes.ErrorMsg)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-physical-interface", payload); err != nil {
+		return err
 	}
 	return readPhysicalInterface(d, m)
 }
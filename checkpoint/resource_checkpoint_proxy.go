@@ -0,0 +1,86 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: createProxy,
+		Read:   readProxy,
+		Update: updateProxy,
+		Delete: deleteProxy,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IPv4 address or host name of the proxy server, that the machine uses to connect to the internet.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Port of the proxy server.",
+			},
+		},
+	}
+}
+
+func proxyParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"address": d.Get("address").(string),
+		"port":    d.Get("port").(int),
+	}
+}
+
+func createProxy(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-proxy", proxyParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	// The proxy is a single object of the machine
+	d.SetId("proxy")
+
+	return readProxy(d, m)
+}
+
+func readProxy(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	proxyJson, err := showGaiaObject(client, gaiaTarget(d), "show-proxy", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	// The machine has no proxy when the address is not set
+	if proxyJson == nil || proxyJson["address"] == nil || proxyJson["address"] == "" {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("address", proxyJson["address"])
+	if v := proxyJson["port"]; v != nil {
+		_ = d.Set("port", gaiaInt(v))
+	}
+
+	return nil
+}
+
+func updateProxy(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-proxy", proxyParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readProxy(d, m)
+}
+
+func deleteProxy(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-proxy", map[string]interface{}{}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
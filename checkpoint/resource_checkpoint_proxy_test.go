@@ -0,0 +1,79 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointProxy_basic(t *testing.T) {
+	resourceName := "checkpoint_proxy.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProxyConfig(8080),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address", "proxy.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointProxy_basic(t *testing.T) {
+	resourceName := "checkpoint_proxy.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			if mock.callCount("delete-proxy") != 1 {
+				return fmt.Errorf("proxy was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccProxyConfig(8080),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "proxy"),
+					resource.TestCheckResourceAttr(resourceName, "port", "8080"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccProxyConfig(3128),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "port", "3128"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccProxyConfig(3128),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "proxy",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProxyConfig(port int) string {
+	return fmt.Sprintf(`
+resource "checkpoint_proxy" "test" {
+    address = "proxy.example.com"
+    port = %d
+}
+`, port)
+}
@@ -1,7 +1,6 @@
 package checkpoint
 
 import (
-	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -51,9 +50,8 @@ func putFileParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
 func createPutFile(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 	payload := putFileParseSchemaToMap(d)
-	setPIRes, _ := client.ApiCall("put-file", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !setPIRes.Success {
-		return fmt.Errorf(setPIRes.ErrorMsg)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "put-file", payload); err != nil {
+		return err
 	}
 
 	// Set Schema UID = Object key
@@ -69,9 +67,8 @@ func readPutFile(d *schema.ResourceData, m interface{}) error {
 func updatePutFile(d *schema.ResourceData, m interface{}) error {
 	client := m.(*checkpoint.ApiClient)
 	payload := putFileParseSchemaToMap(d)
-	setNetworkRes, _ := client.ApiCall("put-file", payload, client.GetSessionID(), true, client.IsProxyUsed())
-	if !setNetworkRes.Success {
-		return fmt.Errorf(setNetworkRes.ErrorMsg)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "put-file", payload); err != nil {
+		return err
 	}
 	return readPutFile(d, m)
 }
@@ -0,0 +1,136 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceRole() *schema.Resource {
+	return &schema.Resource{
+		Create: createRole,
+		Read:   readRole,
+		Update: updateRole,
+		Delete: deleteRole,
+		Importer: &schema.ResourceImporter{
+			State: importStateByField("name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Role name.",
+			},
+			"features": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Features of the role and their permission.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Feature name, e.g. route or interface.",
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "read-write",
+							Description:  "Permission of the feature, read-write or read-only.",
+							ValidateFunc: validateStringValue("read-write", "read-only"),
+						},
+					},
+				},
+			},
+			"extended_commands": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Extended commands that the role can run.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func roleParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	roleMap := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+
+	features := make([]interface{}, 0)
+	for _, feature := range d.Get("features").(*schema.Set).List() {
+		featureMap := feature.(map[string]interface{})
+		features = append(features, map[string]interface{}{
+			"name":       featureMap["name"],
+			"permission": featureMap["permission"],
+		})
+	}
+	roleMap["features"] = features
+
+	roleMap["extended-commands"] = d.Get("extended_commands").(*schema.Set).List()
+
+	return roleMap
+}
+
+func createRole(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "add-role", roleParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readRole(d, m)
+}
+
+func readRole(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	roleJson, err := showGaiaObject(client, gaiaTarget(d), "show-role", map[string]interface{}{"name": d.Get("name")})
+	if err != nil {
+		return err
+	}
+	if roleJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("name", roleJson["name"])
+
+	var featuresState []map[string]interface{}
+	if features, ok := roleJson["features"].([]interface{}); ok {
+		for _, feature := range features {
+			featureMap, ok := feature.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			featuresState = append(featuresState, map[string]interface{}{
+				"name":       featureMap["name"],
+				"permission": featureMap["permission"],
+			})
+		}
+	}
+	_ = d.Set("features", featuresState)
+
+	if v, ok := roleJson["extended-commands"].([]interface{}); ok {
+		_ = d.Set("extended_commands", v)
+	}
+
+	return nil
+}
+
+func updateRole(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-role", roleParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readRole(d, m)
+}
+
+func deleteRole(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-role", map[string]interface{}{"name": d.Get("name")}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
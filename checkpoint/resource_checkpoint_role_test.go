@@ -0,0 +1,83 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointRole_basic(t *testing.T) {
+	resourceName := "checkpoint_role.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig("read-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "terratestRole"),
+					resource.TestCheckResourceAttr(resourceName, "features.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointRole_basic(t *testing.T) {
+	resourceName := "checkpoint_role.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "role", "terratestRole")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccRoleConfig("read-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terratestRole"),
+					resource.TestCheckResourceAttr(resourceName, "features.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "extended_commands.#", "1"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccRoleConfig("read-write"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "features.#", "2"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccRoleConfig("read-write"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRoleConfig(routePermission string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_role" "test" {
+    name = "terratestRole"
+    features {
+        name = "route"
+        permission = "%s"
+    }
+    features {
+        name = "interface"
+    }
+    extended_commands = ["fw"]
+}
+`, routePermission)
+}
@@ -0,0 +1,112 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSnmp() *schema.Resource {
+	return &schema.Resource{
+		Create: createSnmp,
+		Read:   readSnmp,
+		Update: updateSnmp,
+		Delete: deleteSnmp,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable or disable the SNMP agent.",
+			},
+			"agent_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "SNMP versions that the agent supports, any or v3-only.",
+				ValidateFunc: validateStringValue("any", "v3-only"),
+			},
+			"agent_interfaces": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Names of the interfaces that the agent listens on. Default is all the interfaces.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"contact": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SNMP contact, that is the sysContact of the machine.",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SNMP location, that is the sysLocation of the machine.",
+			},
+		},
+	}
+}
+
+func snmpParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	snmpMap := make(map[string]interface{})
+
+	snmpMap["enabled"] = d.Get("enabled").(bool)
+	if v, ok := d.GetOk("agent_version"); ok {
+		snmpMap["agent-version"] = v.(string)
+	}
+	snmpMap["agent-interfaces"] = d.Get("agent_interfaces").(*schema.Set).List()
+	snmpMap["contact"] = d.Get("contact").(string)
+	snmpMap["location"] = d.Get("location").(string)
+
+	return snmpMap
+}
+
+func createSnmp(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-snmp", snmpParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	// The SNMP settings are a single object of the machine
+	d.SetId("snmp")
+
+	return readSnmp(d, m)
+}
+
+func readSnmp(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	snmpJson, err := showGaiaObject(client, gaiaTarget(d), "show-snmp", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if snmpJson == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := snmpJson["enabled"].(bool); ok {
+		_ = d.Set("enabled", v)
+	}
+	_ = d.Set("agent_version", snmpJson["agent-version"])
+	if v, ok := snmpJson["agent-interfaces"].([]interface{}); ok {
+		_ = d.Set("agent_interfaces", v)
+	}
+	_ = d.Set("contact", snmpJson["contact"])
+	_ = d.Set("location", snmpJson["location"])
+
+	return nil
+}
+
+func updateSnmp(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-snmp", snmpParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readSnmp(d, m)
+}
+
+func deleteSnmp(d *schema.ResourceData, m interface{}) error {
+	d.SetId("") // Destroy resource
+	return nil
+}
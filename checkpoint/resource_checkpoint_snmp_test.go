@@ -0,0 +1,75 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointSnmp_basic(t *testing.T) {
+	resourceName := "checkpoint_snmp.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSnmpConfig("Terra Test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "location", "Terra Test"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointSnmp_basic(t *testing.T) {
+	resourceName := "checkpoint_snmp.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccSnmpConfig("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "snmp"),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "v3-only"),
+					resource.TestCheckResourceAttr(resourceName, "location", "first"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccSnmpConfig("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "location", "second"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccSnmpConfig("second"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "snmp",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSnmpConfig(location string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_snmp" "test" {
+    enabled = true
+    agent_version = "v3-only"
+    contact = "admin@example.com"
+    location = "%s"
+}
+`, location)
+}
@@ -0,0 +1,203 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"strconv"
+	"strings"
+)
+
+func resourceStaticRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: createStaticRoute,
+		Read:   readStaticRoute,
+		Update: updateStaticRoute,
+		Delete: deleteStaticRoute,
+		Importer: &schema.ResourceImporter{
+			State: importStaticRoute,
+		},
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Destination network address, or default for the default route.",
+			},
+			"mask_length": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Destination network mask length.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "gateway",
+				Description:  "Route type. gateway routes to next_hop, blackhole drops the packets and reject drops them with an ICMP unreachable.",
+				ValidateFunc: validateStringValue("gateway", "blackhole", "reject"),
+			},
+			"next_hop": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Next hop gateways of a gateway route.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gateway": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Gateway IP address or interface name.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Gateway priority, from 1 to 8. The gateway with the lowest priority is used.",
+						},
+					},
+				},
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Route comment.",
+			},
+			"rank": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Selection of a route among routes of different protocols to the same destination, from 0 to 255.",
+			},
+			"ping": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Monitor the next hop gateways with ping.",
+			},
+		},
+	}
+}
+
+func staticRouteParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	staticRouteMap := make(map[string]interface{})
+
+	staticRouteMap["address"] = d.Get("address").(string)
+	staticRouteMap["mask-length"] = d.Get("mask_length").(int)
+	staticRouteMap["type"] = d.Get("type").(string)
+
+	if staticRouteMap["type"] == "gateway" {
+		nextHops := make([]interface{}, 0)
+		for _, nextHop := range d.Get("next_hop").([]interface{}) {
+			nextHopMap := nextHop.(map[string]interface{})
+			nextHopPayload := map[string]interface{}{"gateway": nextHopMap["gateway"]}
+			if v := nextHopMap["priority"].(int); v != 0 {
+				nextHopPayload["priority"] = v
+			}
+			nextHops = append(nextHops, nextHopPayload)
+		}
+		staticRouteMap["next-hop"] = nextHops
+	}
+
+	staticRouteMap["comment"] = d.Get("comment").(string)
+
+	if v, ok := d.GetOk("rank"); ok {
+		staticRouteMap["rank"] = v.(int)
+	}
+
+	staticRouteMap["ping"] = d.Get("ping").(bool)
+
+	return staticRouteMap
+}
+
+func createStaticRoute(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-static-route", staticRouteParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", d.Get("address").(string), d.Get("mask_length").(int)))
+
+	return readStaticRoute(d, m)
+}
+
+func readStaticRoute(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	payload := map[string]interface{}{
+		"address":     d.Get("address"),
+		"mask-length": d.Get("mask_length"),
+	}
+	staticRouteJson, err := showGaiaObject(client, gaiaTarget(d), "show-static-route", payload)
+	if err != nil {
+		return err
+	}
+	if staticRouteJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	if v := staticRouteJson["type"]; v != nil {
+		_ = d.Set("type", v)
+	}
+
+	var nextHopsState []map[string]interface{}
+	if nextHops, ok := staticRouteJson["next-hop"].([]interface{}); ok {
+		for _, nextHop := range nextHops {
+			nextHopMap, ok := nextHop.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			nextHopsState = append(nextHopsState, map[string]interface{}{
+				"gateway":  nextHopMap["gateway"],
+				"priority": gaiaInt(nextHopMap["priority"]),
+			})
+		}
+	}
+	_ = d.Set("next_hop", nextHopsState)
+
+	_ = d.Set("comment", staticRouteJson["comment"])
+
+	if v := staticRouteJson["rank"]; v != nil {
+		_ = d.Set("rank", gaiaInt(v))
+	}
+
+	if v, ok := staticRouteJson["ping"].(bool); ok {
+		_ = d.Set("ping", v)
+	}
+
+	return nil
+}
+
+func updateStaticRoute(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-static-route", staticRouteParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readStaticRoute(d, m)
+}
+
+func deleteStaticRoute(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	payload := map[string]interface{}{
+		"address":     d.Get("address"),
+		"mask-length": d.Get("mask_length"),
+	}
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-static-route", payload); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// importStaticRoute imports a route by its destination, <ADDRESS>/<MASK_LENGTH>.
+func importStaticRoute(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	arr := strings.Split(d.Id(), "/")
+	if len(arr) != 2 {
+		return nil, fmt.Errorf("invalid unique identifier format. UID format: <ADDRESS>/<MASK_LENGTH>")
+	}
+	maskLength, err := strconv.Atoi(arr[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid mask length %s: %s", arr[1], err.Error())
+	}
+	_ = d.Set("address", arr[0])
+	_ = d.Set("mask_length", maskLength)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,181 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointStaticRoute_basic(t *testing.T) {
+	resourceName := "checkpoint_static_route.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticRouteConfig("blackhole", "terratest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "blackhole"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "terratest"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointStaticRoute_basic(t *testing.T) {
+	resourceName := "checkpoint_static_route.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "static-route", "198.51.100.0/24")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testUnitStaticRouteConfig("192.0.2.1", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "198.51.100.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "type", "gateway"),
+					resource.TestCheckResourceAttr(resourceName, "next_hop.0.gateway", "192.0.2.1"),
+					resource.TestCheckResourceAttr(resourceName, "next_hop.0.priority", "1"),
+					testUnitCheckGaiaObject(mock, "static-route", "198.51.100.0/24", "comment", "first"),
+				),
+			},
+			{
+				// A change on the machine is detected
+				PreConfig: func() {
+					mock.setGaiaObjectField("static-route", "198.51.100.0/24", "comment", "changed")
+				},
+				Config:             mock.gaiaProviderConfig() + testUnitStaticRouteConfig("192.0.2.1", "first"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testUnitStaticRouteConfig("192.0.2.2", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "next_hop.0.gateway", "192.0.2.2"),
+					testUnitCheckGaiaObject(mock, "static-route", "198.51.100.0/24", "comment", "second"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testUnitStaticRouteConfig("192.0.2.2", "second"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointStaticRoute_target(t *testing.T) {
+	resourceName := "checkpoint_static_route.test"
+	mock := newMockApiServer(t)
+	login := mock.login()
+	mock.run("add-simple-gateway", map[string]interface{}{"name": "gw1", "ipv4-address": "192.0.2.1"}, login["sid"].(string))
+	mock.run("publish", map[string]interface{}{}, login["sid"].(string))
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, mockGaiaKey("gw1", "static-route"), "198.51.100.0/24")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testUnitStaticRouteTargetConfig("gw1", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "target=gw1;198.51.100.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "next_hop.0.gateway", "192.0.2.254"),
+					testUnitCheckGaiaObject(mock, mockGaiaKey("gw1", "static-route"), "198.51.100.0/24", "comment", "first"),
+					func(s *terraform.State) error {
+						return testUnitCheckGaiaObjectDestroyed(mock, "static-route", "198.51.100.0/24")
+					},
+				),
+			},
+			{
+				Config: mock.providerConfig() + testUnitStaticRouteTargetConfig("gw1", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckGaiaObject(mock, mockGaiaKey("gw1", "static-route"), "198.51.100.0/24", "comment", "second"),
+					testUnitCheckCallCount(mock, "gaia-api/set-static-route", 2),
+				),
+			},
+			{
+				Config:            mock.providerConfig() + testUnitStaticRouteTargetConfig("gw1", "second"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "target=gw1;198.51.100.0/24",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStaticRouteConfig(routeType string, comment string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_static_route" "test" {
+    address = "198.51.100.0"
+    mask_length = 24
+    type = "%s"
+    comment = "%s"
+}
+`, routeType, comment)
+}
+
+func testUnitStaticRouteConfig(gateway string, comment string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_static_route" "test" {
+    address = "198.51.100.0"
+    mask_length = 24
+    next_hop {
+        gateway = "%s"
+        priority = 1
+    }
+    comment = "%s"
+    rank = 60
+}
+`, gateway, comment)
+}
+
+func testUnitCheckGaiaObject(mock *mockApiServer, objectType string, name string, field string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		obj := mock.gaiaObject(objectType, name)
+		if obj == nil {
+			return fmt.Errorf("%s (%s) not found", objectType, name)
+		}
+		if fmt.Sprint(obj[field]) != fmt.Sprint(value) {
+			return fmt.Errorf("%s is %v, expected %v", field, obj[field], value)
+		}
+		return nil
+	}
+}
+
+func testUnitCheckGaiaObjectDestroyed(mock *mockApiServer, objectType string, name string) error {
+	if mock.gaiaObject(objectType, name) != nil {
+		return fmt.Errorf("%s (%s) still exists", objectType, name)
+	}
+	return nil
+}
+
+func testUnitStaticRouteTargetConfig(target string, comment string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_static_route" "test" {
+    target = "%s"
+    address = "198.51.100.0"
+    mask_length = 24
+    next_hop {
+        gateway = "192.0.2.254"
+    }
+    comment = "%s"
+}
+`, target, comment)
+}
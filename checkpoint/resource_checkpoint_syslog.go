@@ -0,0 +1,89 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSyslog() *schema.Resource {
+	return &schema.Resource{
+		Create: createSyslog,
+		Read:   readSyslog,
+		Update: updateSyslog,
+		Delete: deleteSyslog,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"filename": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Path of the file that syslog messages are written to, e.g. /var/log/messages.",
+			},
+			"send_to_mgmt": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Send syslog messages to the management server.",
+			},
+		},
+	}
+}
+
+func syslogParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	syslogMap := make(map[string]interface{})
+
+	if v, ok := d.GetOk("filename"); ok {
+		syslogMap["filename"] = v.(string)
+	}
+	if v, ok := d.GetOkExists("send_to_mgmt"); ok {
+		syslogMap["send-to-mgmt"] = v.(bool)
+	}
+
+	return syslogMap
+}
+
+func createSyslog(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-syslog", syslogParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	// The syslog settings are a single object of the machine
+	d.SetId("syslog")
+
+	return readSyslog(d, m)
+}
+
+func readSyslog(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	syslogJson, err := showGaiaObject(client, gaiaTarget(d), "show-syslog", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if syslogJson == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("filename", syslogJson["filename"])
+	if v, ok := syslogJson["send-to-mgmt"].(bool); ok {
+		_ = d.Set("send_to_mgmt", v)
+	}
+
+	return nil
+}
+
+func updateSyslog(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-syslog", syslogParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readSyslog(d, m)
+}
+
+func deleteSyslog(d *schema.ResourceData, m interface{}) error {
+	d.SetId("") // Destroy resource
+	return nil
+}
@@ -0,0 +1,113 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSyslogServer() *schema.Resource {
+	return &schema.Resource{
+		Create: createSyslogServer,
+		Read:   readSyslogServer,
+		Update: updateSyslogServer,
+		Delete: deleteSyslogServer,
+		Importer: &schema.ResourceImporter{
+			State: importStateByField("address"),
+		},
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "IPv4 address of the remote syslog server.",
+			},
+			"level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "all",
+				Description:  "Minimal severity of the messages that are sent to the server.",
+				ValidateFunc: validateStringValue("emerg", "alert", "crit", "err", "warning", "notice", "info", "debug", "all"),
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Transport protocol of the messages, udp or tcp.",
+				ValidateFunc: validateStringValue("udp", "tcp"),
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Port of the remote syslog server.",
+			},
+		},
+	}
+}
+
+func syslogServerParseSchemaToMap(d *schema.ResourceData) map[string]interface{} {
+	syslogServerMap := map[string]interface{}{
+		"address": d.Get("address").(string),
+		"level":   d.Get("level").(string),
+	}
+
+	if v, ok := d.GetOk("protocol"); ok {
+		syslogServerMap["protocol"] = v.(string)
+	}
+	if v, ok := d.GetOk("port"); ok {
+		syslogServerMap["port"] = v.(int)
+	}
+
+	return syslogServerMap
+}
+
+func createSyslogServer(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "add-syslog-server", syslogServerParseSchemaToMap(d)); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("address").(string))
+
+	return readSyslogServer(d, m)
+}
+
+func readSyslogServer(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	syslogServerJson, err := showGaiaObject(client, gaiaTarget(d), "show-syslog-server", map[string]interface{}{"address": d.Get("address")})
+	if err != nil {
+		return err
+	}
+	if syslogServerJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("address", syslogServerJson["address"])
+	if v := syslogServerJson["level"]; v != nil {
+		_ = d.Set("level", v)
+	}
+	_ = d.Set("protocol", syslogServerJson["protocol"])
+	if v := syslogServerJson["port"]; v != nil {
+		_ = d.Set("port", gaiaInt(v))
+	}
+
+	return nil
+}
+
+func updateSyslogServer(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-syslog-server", syslogServerParseSchemaToMap(d)); err != nil {
+		return err
+	}
+	return readSyslogServer(d, m)
+}
+
+func deleteSyslogServer(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-syslog-server", map[string]interface{}{"address": d.Get("address")}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,75 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointSyslogServer_basic(t *testing.T) {
+	resourceName := "checkpoint_syslog_server.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyslogServerConfig("err"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address", "198.51.100.20"),
+					resource.TestCheckResourceAttr(resourceName, "level", "err"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointSyslogServer_basic(t *testing.T) {
+	resourceName := "checkpoint_syslog_server.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "syslog-server", "198.51.100.20")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccSyslogServerConfig("err"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "198.51.100.20"),
+					resource.TestCheckResourceAttr(resourceName, "level", "err"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccSyslogServerConfig("info"),
+				Check: resource.ComposeTestCheckFunc(
+					testUnitCheckGaiaObject(mock, "syslog-server", "198.51.100.20", "level", "info"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccSyslogServerConfig("info"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSyslogServerConfig(level string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_syslog_server" "test" {
+    address = "198.51.100.20"
+    level = "%s"
+}
+`, level)
+}
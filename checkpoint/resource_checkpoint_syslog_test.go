@@ -0,0 +1,72 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointSyslog_basic(t *testing.T) {
+	resourceName := "checkpoint_syslog.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyslogConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "filename", "/var/log/messages"),
+					resource.TestCheckResourceAttr(resourceName, "send_to_mgmt", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointSyslog_basic(t *testing.T) {
+	resourceName := "checkpoint_syslog.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccSyslogConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "syslog"),
+					resource.TestCheckResourceAttr(resourceName, "send_to_mgmt", "false"),
+				),
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccSyslogConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "send_to_mgmt", "true"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccSyslogConfig(true),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "syslog",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSyslogConfig(sendToMgmt bool) string {
+	return fmt.Sprintf(`
+resource "checkpoint_syslog" "test" {
+    filename = "/var/log/messages"
+    send_to_mgmt = %t
+}
+`, sendToMgmt)
+}
@@ -0,0 +1,66 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceTimeZone() *schema.Resource {
+	return &schema.Resource{
+		Create: createTimeZone,
+		Read:   readTimeZone,
+		Update: updateTimeZone,
+		Delete: deleteTimeZone,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"timezone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Time zone of the machine in Area/Region format, e.g. Europe/London.",
+			},
+		},
+	}
+}
+
+func createTimeZone(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-time-and-date", map[string]interface{}{"timezone": d.Get("timezone")}); err != nil {
+		return err
+	}
+
+	// The time zone is a single setting of the machine
+	d.SetId("time-zone")
+
+	return readTimeZone(d, m)
+}
+
+func readTimeZone(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	timeAndDateJson, err := showGaiaObject(client, gaiaTarget(d), "show-time-and-date", map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if timeAndDateJson == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("timezone", timeAndDateJson["timezone"])
+
+	return nil
+}
+
+func updateTimeZone(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-time-and-date", map[string]interface{}{"timezone": d.Get("timezone")}); err != nil {
+		return err
+	}
+	return readTimeZone(d, m)
+}
+
+func deleteTimeZone(d *schema.ResourceData, m interface{}) error {
+	d.SetId("") // Destroy resource
+	return nil
+}
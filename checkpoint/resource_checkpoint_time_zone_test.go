@@ -0,0 +1,79 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointTimeZone_basic(t *testing.T) {
+	resourceName := "checkpoint_time_zone.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeZoneConfig("Etc/GMT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Etc/GMT"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointTimeZone_basic(t *testing.T) {
+	resourceName := "checkpoint_time_zone.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccTimeZoneConfig("Etc/GMT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "time-zone"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Etc/GMT"),
+				),
+			},
+			{
+				// A change on the machine is detected
+				PreConfig: func() {
+					mock.setGaiaSetting("time-and-date", "timezone", "Asia/Tokyo")
+				},
+				Config:             mock.gaiaProviderConfig() + testAccTimeZoneConfig("Etc/GMT"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccTimeZoneConfig("Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Europe/London"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccTimeZoneConfig("Europe/London"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "time-zone",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTimeZoneConfig(timezone string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_time_zone" "test" {
+    timezone = "%s"
+}
+`, timezone)
+}
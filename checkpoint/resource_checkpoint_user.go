@@ -0,0 +1,202 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		Create: createUser,
+		Read:   readUser,
+		Update: updateUser,
+		Delete: deleteUser,
+		Importer: &schema.ResourceImporter{
+			State: importStateByField("name"),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "User name.",
+			},
+			"uid": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "User ID. Allocated by the machine when not set.",
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "User password. The password is not read back, a change on the machine is not detected.",
+				ConflictsWith: []string{"password_hash"},
+			},
+			"password_hash": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Hash of the user password, instead of password. The hash is not read back, a change on the machine is not detected.",
+				ConflictsWith: []string{"password"},
+			},
+			"real_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User real name.",
+			},
+			"homedir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User home directory. Default is /home/<name>.",
+			},
+			"shell": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User shell, e.g. cli or bash.",
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Names of the roles of the user.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"primary_system_group_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Primary system group ID of the user.",
+			},
+			"secondary_system_groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Names of the secondary system groups of the user.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allow_access_using": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Access mechanisms that the user can use, CLI, Web-UI and Gaia-API.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"must_change_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "The user must change the password on the next login.",
+			},
+		},
+	}
+}
+
+func userParseSchemaToMap(d *schema.ResourceData, update bool) map[string]interface{} {
+	userMap := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+
+	if v, ok := d.GetOk("uid"); ok && !update {
+		userMap["uid"] = v.(int)
+	}
+	if v, ok := d.GetOk("password"); ok && (!update || d.HasChange("password")) {
+		userMap["password"] = v.(string)
+	}
+	if v, ok := d.GetOk("password_hash"); ok && (!update || d.HasChange("password_hash")) {
+		userMap["password-hash"] = v.(string)
+	}
+	if !update || d.HasChange("real_name") {
+		userMap["real-name"] = d.Get("real_name").(string)
+	}
+	if v, ok := d.GetOk("homedir"); ok && (!update || d.HasChange("homedir")) {
+		userMap["homedir"] = v.(string)
+	}
+	if v, ok := d.GetOk("shell"); ok && (!update || d.HasChange("shell")) {
+		userMap["shell"] = v.(string)
+	}
+	if !update || d.HasChange("roles") {
+		userMap["roles"] = d.Get("roles").(*schema.Set).List()
+	}
+	if v, ok := d.GetOk("primary_system_group_id"); ok && (!update || d.HasChange("primary_system_group_id")) {
+		userMap["primary-system-group-id"] = v.(int)
+	}
+	if !update || d.HasChange("secondary_system_groups") {
+		userMap["secondary-system-groups"] = d.Get("secondary_system_groups").(*schema.Set).List()
+	}
+	if v, ok := d.GetOk("allow_access_using"); ok && (!update || d.HasChange("allow_access_using")) {
+		userMap["allow-access-using"] = v.(*schema.Set).List()
+	}
+	if v, ok := d.GetOkExists("must_change_password"); ok && (!update || d.HasChange("must_change_password")) {
+		userMap["must-change-password"] = v.(bool)
+	}
+
+	return userMap
+}
+
+func createUser(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "add-user", userParseSchemaToMap(d, false)); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return readUser(d, m)
+}
+
+func readUser(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	userJson, err := showGaiaObject(client, gaiaTarget(d), "show-user", map[string]interface{}{"name": d.Get("name")})
+	if err != nil {
+		return err
+	}
+	if userJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("name", userJson["name"])
+	if v := userJson["uid"]; v != nil {
+		_ = d.Set("uid", gaiaInt(v))
+	}
+	_ = d.Set("real_name", userJson["real-name"])
+	_ = d.Set("homedir", userJson["homedir"])
+	_ = d.Set("shell", userJson["shell"])
+	if v, ok := userJson["roles"].([]interface{}); ok {
+		_ = d.Set("roles", v)
+	}
+	if v := userJson["primary-system-group-id"]; v != nil {
+		_ = d.Set("primary_system_group_id", gaiaInt(v))
+	}
+	if v, ok := userJson["secondary-system-groups"].([]interface{}); ok {
+		_ = d.Set("secondary_system_groups", v)
+	}
+	if v, ok := userJson["allow-access-using"].([]interface{}); ok {
+		_ = d.Set("allow_access_using", v)
+	}
+	if v, ok := userJson["must-change-password"].(bool); ok {
+		_ = d.Set("must_change_password", v)
+	}
+
+	return nil
+}
+
+func updateUser(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-user", userParseSchemaToMap(d, true)); err != nil {
+		return err
+	}
+	return readUser(d, m)
+}
+
+func deleteUser(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-user", map[string]interface{}{"name": d.Get("name")}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
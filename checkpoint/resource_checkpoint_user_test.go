@@ -0,0 +1,94 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointUser_basic(t *testing.T) {
+	resourceName := "checkpoint_user.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig("terratest", "Terra Test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "terratest"),
+					resource.TestCheckResourceAttr(resourceName, "real_name", "Terra Test"),
+					resource.TestCheckResourceAttrSet(resourceName, "uid"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointUser_basic(t *testing.T) {
+	resourceName := "checkpoint_user.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "user", "terratest")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccUserConfig("terratest", "Terra Test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "terratest"),
+					resource.TestCheckResourceAttr(resourceName, "shell", "bash"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allow_access_using.#", "2"),
+					testUnitCheckGaiaObject(mock, "user", "terratest", "password", "Terra-Test-1"),
+				),
+			},
+			{
+				// A change on the machine is detected
+				PreConfig: func() {
+					mock.setGaiaObjectField("user", "terratest", "shell", "cli")
+				},
+				Config:             mock.gaiaProviderConfig() + testAccUserConfig("terratest", "Terra Test"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccUserConfig("terratest", "Terra Form"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "shell", "bash"),
+					testUnitCheckGaiaObject(mock, "user", "terratest", "real-name", "Terra Form"),
+				),
+			},
+			{
+				Config:                  mock.gaiaProviderConfig() + testAccUserConfig("terratest", "Terra Form"),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccUserConfig(name string, realName string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_user" "test" {
+    name = "%s"
+    password = "Terra-Test-1"
+    real_name = "%s"
+    shell = "bash"
+    roles = ["monitorRole"]
+    allow_access_using = ["CLI", "Web-UI"]
+}
+`, name, realName)
+}
@@ -0,0 +1,106 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceVlanInterface() *schema.Resource {
+	return &schema.Resource{
+		Create: createVlanInterface,
+		Read:   readVlanInterface,
+		Update: updateVlanInterface,
+		Delete: deleteVlanInterface,
+		Importer: &schema.ResourceImporter{
+			State: importStateByField("name"),
+		},
+		Schema: gaiaInterfaceSchema(map[string]*schema.Schema{
+			"parent": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the parent interface, e.g. eth1.",
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "VLAN ID, from 2 to 4094.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Interface name, <parent>.<vlan_id>.",
+			},
+		}),
+	}
+}
+
+func createVlanInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"parent": d.Get("parent").(string),
+		"id":     d.Get("vlan_id").(int),
+	}
+	gaiaInterfaceParseSchemaToMap(d, payload, false)
+
+	addVlanInterfaceRes, err := callGaiaApi(client, gaiaTarget(d), "add-vlan-interface", payload)
+	if err != nil {
+		return err
+	}
+
+	name, _ := addVlanInterfaceRes["name"].(string)
+	if name == "" {
+		name = fmt.Sprintf("%s.%d", d.Get("parent").(string), d.Get("vlan_id").(int))
+	}
+	_ = d.Set("name", name)
+	d.SetId(name)
+
+	return readVlanInterface(d, m)
+}
+
+func readVlanInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	vlanInterfaceJson, err := showGaiaObject(client, gaiaTarget(d), "show-vlan-interface", map[string]interface{}{"name": d.Get("name")})
+	if err != nil {
+		return err
+	}
+	if vlanInterfaceJson == nil {
+		d.SetId("") // Destroy resource
+		return nil
+	}
+
+	_ = d.Set("name", vlanInterfaceJson["name"])
+	_ = d.Set("parent", vlanInterfaceJson["parent"])
+	if v := vlanInterfaceJson["id"]; v != nil {
+		_ = d.Set("vlan_id", gaiaInt(v))
+	}
+	readGaiaInterface(d, vlanInterfaceJson)
+
+	return nil
+}
+
+func updateVlanInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+
+	payload := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	gaiaInterfaceParseSchemaToMap(d, payload, true)
+
+	if _, err := callGaiaApi(client, gaiaTarget(d), "set-vlan-interface", payload); err != nil {
+		return err
+	}
+	return readVlanInterface(d, m)
+}
+
+func deleteVlanInterface(d *schema.ResourceData, m interface{}) error {
+	client := m.(*checkpoint.ApiClient)
+	if _, err := callGaiaApi(client, gaiaTarget(d), "delete-vlan-interface", map[string]interface{}{"name": d.Get("name")}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,93 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"os"
+	"testing"
+)
+
+func TestAccCheckpointVlanInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_vlan_interface.test"
+	context := os.Getenv("CHECKPOINT_CONTEXT")
+	if context != "gaia_api" {
+		t.Skip("Skipping Gaia test")
+	} else if context == "" {
+		t.Skip("Env CHECKPOINT_CONTEXT must be specified to run this acc test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVlanInterfaceConfig("198.51.100.1", "terratest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "eth1.100"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_address", "198.51.100.1"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_mask_length", "24"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitCheckpointVlanInterface_basic(t *testing.T) {
+	resourceName := "checkpoint_vlan_interface.test"
+	mock := newMockApiServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		CheckDestroy: func(s *terraform.State) error {
+			return testUnitCheckGaiaObjectDestroyed(mock, "vlan-interface", "eth1.100")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mock.gaiaProviderConfig() + testAccVlanInterfaceConfig("198.51.100.1", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "eth1.100"),
+					resource.TestCheckResourceAttr(resourceName, "name", "eth1.100"),
+					resource.TestCheckResourceAttr(resourceName, "ipv4_address", "198.51.100.1"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					testUnitCheckGaiaObject(mock, "vlan-interface", "eth1.100", "ipv4-mask-length", 24),
+				),
+			},
+			{
+				// A change on the machine is detected
+				PreConfig: func() {
+					mock.setGaiaObjectField("vlan-interface", "eth1.100", "ipv4-address", "198.51.100.9")
+				},
+				Config:             mock.gaiaProviderConfig() + testAccVlanInterfaceConfig("198.51.100.1", "first"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: mock.gaiaProviderConfig() + testAccVlanInterfaceConfig("198.51.100.2", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ipv4_address", "198.51.100.2"),
+					testUnitCheckGaiaObject(mock, "vlan-interface", "eth1.100", "comments", "second"),
+				),
+			},
+			{
+				Config:            mock.gaiaProviderConfig() + testAccVlanInterfaceConfig("198.51.100.2", "second"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVlanInterfaceConfig(address string, comments string) string {
+	return fmt.Sprintf(`
+resource "checkpoint_vlan_interface" "test" {
+    parent = "eth1"
+    vlan_id = 100
+    ipv4_address = "%s"
+    ipv4_mask_length = 24
+    enabled = true
+    comments = "%s"
+}
+`, address, comments)
+}
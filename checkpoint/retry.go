@@ -0,0 +1,254 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxRetryBackoff = 60 * time.Second
+
+// Check Point error codes of requests that may succeed when sent again.
+var retryableErrorCodes = []string{
+	"generic_err_object_locked",
+	"generic_server_busy",
+	"err_server_busy",
+	"generic_err_too_many_requests",
+}
+
+// Messages of transient errors that are returned without a known code, e.g. connection errors. Only errors of requests
+// that the server provably did not process are retried. A timeout or a connection that was closed while waiting for
+// the reply may come after the server applied the request, and sending it again would e.g. add a second rule.
+var retryableErrorMessages = []string{
+	"is locked by another session",
+	"server is busy",
+	"management server is not ready",
+	"too many requests",
+	"connection refused",
+}
+
+// Error codes and messages of requests that were sent with an expired or unknown session.
+var sessionExpiredErrorCodes = []string{
+	"generic_err_wrong_session_id",
+	"err_session_expired",
+}
+
+var sessionExpiredErrorMessages = []string{
+	"wrong session id",
+	"session may be expired",
+	"session expired",
+}
+
+// HTTP statuses of transient errors: too many requests, and a server that is not ready. 500 is not retried, since the
+// API server returns it for errors of the request itself, that fail again, and it may have applied a part of the
+// request.
+var retryableErrorStatuses = []string{"429", "503"}
+
+// HTTP statuses of a proxy, load balancer or relay in front of the server that could not pass the request on, or did
+// not get the reply in time. The server may have applied the request, so they are retried for the operations that
+// can be sent again, but not for the creation of an object, which could be added twice.
+var gatewayErrorStatuses = []string{"502", "504"}
+
+var errorCodeRegexp = regexp.MustCompile(`Code: (\S+)`)
+var errorStatusRegexp = regexp.MustCompile(`Status: (\d{3})`)
+
+// retryPolicy sends again the operations of a configured provider that failed on a transient error. An operation that
+// failed because the session expired is sent again after a new login.
+type retryPolicy struct {
+	sync.Mutex
+	client     *checkpoint.ApiClient
+	maxRetries int
+	backoff    time.Duration
+	relogin    func() error
+}
+
+var retryPolicies = struct {
+	sync.Mutex
+	byClient map[*checkpoint.ApiClient]*retryPolicy
+}{byClient: make(map[*checkpoint.ApiClient]*retryPolicy)}
+
+// registerRetryPolicy enables retries of the operations of a configured provider. relogin is called when the session
+// expired and may be nil if the provider cannot log in again.
+func registerRetryPolicy(client *checkpoint.ApiClient, maxRetries int, backoff time.Duration, relogin func() error) {
+	if maxRetries <= 0 {
+		return
+	}
+
+	retryPolicies.Lock()
+	retryPolicies.byClient[client] = &retryPolicy{
+		client:     client,
+		maxRetries: maxRetries,
+		backoff:    backoff,
+		relogin:    relogin,
+	}
+	retryPolicies.Unlock()
+}
+
+// retryOnTransientErrors wraps the operations of every resource or data source with the retry policy of the provider.
+// Command resources, that run a command on create and have no update, e.g. install-policy or run-script, are not
+// retried, since a failed command may have run on the server.
+func retryOnTransientErrors(resources map[string]*schema.Resource) {
+	for _, r := range resources {
+		if r.Create != nil && r.Update == nil {
+			continue
+		}
+		read := r.Read
+		r.Read = retryOperation(r.Read, nil)
+		r.Create = retryOperation(r.Create, read)
+		r.Update = retryOperation(r.Update, nil)
+		r.Delete = retryOperation(r.Delete, nil)
+	}
+}
+
+// retryOperation returns op with retries. A create operation that already set the ID of the new object is not sent
+// again, instead the object is read with read.
+func retryOperation(op func(*schema.ResourceData, interface{}) error, read func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		client, ok := m.(*checkpoint.ApiClient)
+		if !ok {
+			return op(d, m)
+		}
+		retryPolicies.Lock()
+		p := retryPolicies.byClient[client]
+		retryPolicies.Unlock()
+		if p == nil {
+			return op(d, m)
+		}
+
+		created := false
+		for attempt := 0; ; attempt++ {
+			sid := client.GetSessionID()
+
+			var err error
+			if created {
+				err = read(d, m)
+			} else {
+				err = op(d, m)
+			}
+			if err == nil || attempt >= p.maxRetries {
+				return err
+			}
+
+			if isSessionExpiredError(err) {
+				if p.relogin == nil {
+					return err
+				}
+				log.Printf("Session expired (%s). Login again", errorSummary(err.Error()))
+				if loginErr := p.renewSession(sid); loginErr != nil {
+					return fmt.Errorf("%s\n%s", err.Error(), loginErr.Error())
+				}
+			} else if isRetryableError(err) || (read == nil && isGatewayError(err)) {
+				delay := p.backoffDelay(attempt)
+				log.Printf("Retryable error (%s). Retry %d of %d in %s", errorSummary(err.Error()), attempt+1, p.maxRetries, delay)
+				time.Sleep(delay)
+			} else {
+				return err
+			}
+
+			if read != nil && d.Id() != "" {
+				created = true
+			}
+		}
+	}
+}
+
+// renewSession logs in again, unless another operation already did it after the request that was sent with sid.
+func (p *retryPolicy) renewSession(sid string) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.client.GetSessionID() != sid {
+		return nil
+	}
+	return p.relogin()
+}
+
+// backoffDelay doubles the configured backoff on every attempt, up to maxRetryBackoff.
+func (p *retryPolicy) backoffDelay(attempt int) time.Duration {
+	delay := p.backoff
+	for i := 0; i < attempt && delay < maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryBackoff {
+		delay = maxRetryBackoff
+	}
+	return delay
+}
+
+// isRetryableError returns true if err is a transient error of the API server or of the connection to it.
+func isRetryableError(err error) bool {
+	msg := err.Error()
+	if code := errorCode(msg); code != "" {
+		for _, retryableCode := range retryableErrorCodes {
+			if code == retryableCode {
+				return true
+			}
+		}
+	}
+	if containsAny(errorStatus(msg), retryableErrorStatuses) {
+		return true
+	}
+	return containsAny(strings.ToLower(msg), retryableErrorMessages)
+}
+
+// isGatewayError returns true if err was returned by a proxy, load balancer or relay in front of the server.
+func isGatewayError(err error) bool {
+	return containsAny(errorStatus(err.Error()), gatewayErrorStatuses)
+}
+
+// isSessionExpiredError returns true if err was returned for a request that was sent with an expired session.
+func isSessionExpiredError(err error) bool {
+	msg := err.Error()
+	if code := errorCode(msg); code != "" {
+		for _, expiredCode := range sessionExpiredErrorCodes {
+			if code == expiredCode {
+				return true
+			}
+		}
+	}
+	return containsAny(strings.ToLower(msg), sessionExpiredErrorMessages)
+}
+
+// errorCode returns the Check Point error code of an error message that was built by the API client.
+func errorCode(msg string) string {
+	if match := errorCodeRegexp.FindStringSubmatch(msg); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// errorStatus returns the HTTP status of an error message that was built by the API client.
+func errorStatus(msg string) string {
+	if match := errorStatusRegexp.FindStringSubmatch(msg); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+// errorSummary returns the message of an error that was built by the API client, or its first line.
+func errorSummary(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.HasPrefix(lines[i], "Message: ") && lines[i] != "Message: " {
+			return strings.TrimPrefix(lines[i], "Message: ")
+		}
+	}
+	return lines[0]
+}
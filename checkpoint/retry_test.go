@@ -0,0 +1,104 @@
+package checkpoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestUnitProvider_retry(t *testing.T) {
+	mock := newMockApiServer(t)
+	mock.failNext("add-host", 500, "generic_err_object_locked", "Object is locked by another session")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: mock.providerConfig() + testAccManagementHostConfig("tfTestRetry", "192.0.2.1", "blue"),
+				Check: func(s *terraform.State) error {
+					if count := mock.callCount("add-host"); count != 2 {
+						return fmt.Errorf("add-host was called %d times, expected 2", count)
+					}
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					mock.expireSessionsOn("set-host")
+				},
+				Config: mock.providerConfig() + testAccManagementHostConfig("tfTestRetry", "192.0.2.1", "red"),
+				Check: func(s *terraform.State) error {
+					return testUnitCheckMockObject(mock, "host", "tfTestRetry", "color", "red")(s)
+				},
+			},
+			{
+				// The errors of a gateway in front of the server are retried for updates
+				PreConfig: func() {
+					mock.failNext("set-host", 502, "generic_error", "Bad Gateway")
+					mock.failNext("set-host", 504, "generic_error", "Gateway Timeout")
+				},
+				Config: mock.providerConfig() + testAccManagementHostConfig("tfTestRetry", "192.0.2.1", "green"),
+				Check:  testUnitCheckMockObject(mock, "host", "tfTestRetry", "color", "green"),
+			},
+			{
+				// The server may have applied a create that timed out
+				PreConfig: func() {
+					mock.failNext("add-group", 504, "generic_error", "Gateway Timeout")
+				},
+				Config: mock.providerConfig() + testAccManagementHostConfig("tfTestRetry", "192.0.2.1", "green") + `
+resource "checkpoint_management_group" "test" {
+  name = "tfTestRetry"
+}
+`,
+				ExpectError: regexp.MustCompile(`Gateway Timeout`),
+			},
+			{
+				// Commands are not sent again
+				PreConfig: func() {
+					mock.failNext("publish", 500, "generic_err_object_locked", "Object is locked by another session")
+				},
+				Config:      mock.providerConfig() + testAccManagementHostConfig("tfTestRetry", "192.0.2.1", "green") + "resource \"checkpoint_management_publish\" \"publish\" {}\n",
+				ExpectError: regexp.MustCompile(`Object is locked by another session`),
+			},
+		},
+	})
+
+	if count := mock.callCount("set-host"); count != 5 {
+		t.Fatalf("set-host was called %d times, expected 5", count)
+	}
+	if count := mock.callCount("add-group"); count != 1 {
+		t.Fatalf("add-group was called %d times, expected 1", count)
+	}
+	if count := mock.callCount("publish"); count != 1 {
+		t.Fatalf("publish was called %d times, expected 1", count)
+	}
+}
+
+func TestUnitProvider_retrySessionExpiredWithChanges(t *testing.T) {
+	mock := newMockApiServer(t)
+	providerConfig := mock.providerConfig("session_lifecycle {}")
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					mock.expireSessionsOn("add-group")
+				},
+				Config: providerConfig + testAccManagementHostConfig("tfTestExpired", "192.0.2.1", "blue") + `
+resource "checkpoint_management_group" "test" {
+  name = "tfTestExpired"
+  members = [checkpoint_management_host.test.name]
+}
+`,
+				ExpectError: regexp.MustCompile(`session .* expired with changes that were not published`),
+			},
+		},
+	})
+
+	if count := mock.callCount("add-group"); count != 1 {
+		t.Fatalf("add-group was called %d times, expected 1", count)
+	}
+}
@@ -0,0 +1,203 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"strings"
+	"sync"
+)
+
+// revisionGuard fails the apply of a change that was planned on an older revision than the latest revision of the
+// server, e.g. when an administrator published from SmartConsole between plan and apply. Terraform plans and applies
+// in separate provider instances, and plans every change again right before it is applied, so the revision that the
+// plan was made on is recorded outside of the plan: when the plan refreshes the state, the latest revision is stored
+// in the session file, or in memory when the session is kept in memory. The apply compares it with the latest
+// revision once, before the first change, and consumes it.
+type revisionGuard struct {
+	sync.Mutex
+	client          *checkpoint.ApiClient
+	sessionFileName string
+	key             string
+	recorded        bool
+	applying        bool
+	verifyErr       error
+}
+
+var revisionGuards = struct {
+	sync.Mutex
+	byClient map[*checkpoint.ApiClient]*revisionGuard
+}{byClient: make(map[*checkpoint.ApiClient]*revisionGuard)}
+
+// plannedRevisions are the revisions that the plans were made on, by session key, when the session is kept in memory.
+var plannedRevisions = struct {
+	sync.Mutex
+	byKey map[string]string
+}{byKey: make(map[string]string)}
+
+func registerRevisionGuard(client *checkpoint.ApiClient, sessionFileName string, key string) {
+	revisionGuards.Lock()
+	revisionGuards.byClient[client] = &revisionGuard{
+		client:          client,
+		sessionFileName: sessionFileName,
+		key:             key,
+	}
+	revisionGuards.Unlock()
+}
+
+func revisionGuardEnabled(client *checkpoint.ApiClient) bool {
+	revisionGuards.Lock()
+	defer revisionGuards.Unlock()
+	return revisionGuards.byClient[client] != nil
+}
+
+func clientRevisionGuard(m interface{}) *revisionGuard {
+	client, ok := m.(*checkpoint.ApiClient)
+	if !ok {
+		return nil
+	}
+	revisionGuards.Lock()
+	defer revisionGuards.Unlock()
+	return revisionGuards.byClient[client]
+}
+
+// guardRevisions records the latest revision when a management resource is refreshed by the plan, and checks it before
+// the resource is created or updated.
+func guardRevisions(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if !strings.HasPrefix(name, "checkpoint_management_") || strings.HasPrefix(name, "checkpoint_management_cme_") {
+			continue
+		}
+		r.Read = readOnPlannedRevision(r.Read)
+		r.Create = applyOnPlannedRevision(r.Create)
+		r.Update = applyOnPlannedRevision(r.Update)
+	}
+}
+
+func readOnPlannedRevision(op func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		if g := clientRevisionGuard(m); g != nil {
+			if err := g.record(); err != nil {
+				return err
+			}
+		}
+		return op(d, m)
+	}
+}
+
+func applyOnPlannedRevision(op func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		if g := clientRevisionGuard(m); g != nil {
+			if err := g.verify(); err != nil {
+				return err
+			}
+		}
+		return op(d, m)
+	}
+}
+
+// record stores the latest revision of the server as the revision that the plan is made on. It is looked up once per
+// refresh, so that all the changes are planned on the same revision. The objects that are read after a change of the
+// apply, e.g. by Create, do not record the revision, since the apply publishes its own revisions.
+func (g *revisionGuard) record() error {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.recorded || g.applying {
+		return nil
+	}
+	revision, err := lastPublishedSession(g.client)
+	if err != nil {
+		return err
+	}
+	uid, _ := revision["uid"].(string)
+	if err := g.savePlanned(uid); err != nil {
+		return err
+	}
+	g.recorded = true
+	return nil
+}
+
+// verify fails if the latest revision of the server is not the revision that the plan was made on. The revision is
+// verified once per apply, before the first change of the apply, and is then removed, so that a plan is verified by
+// one apply only. An apply of a plan that did not refresh any object, e.g. of new objects only, is not verified.
+func (g *revisionGuard) verify() error {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.applying {
+		return g.verifyErr
+	}
+	g.applying = true
+
+	planned, err := g.loadPlanned()
+	if err != nil {
+		g.verifyErr = err
+		return err
+	}
+	if planned == "" {
+		log.Printf("No revision was recorded by the plan of this apply. The apply is not verified against the latest revision")
+		return nil
+	}
+	if err := g.savePlanned(""); err != nil {
+		g.verifyErr = err
+		return err
+	}
+
+	revision, err := lastPublishedSession(g.client)
+	if err != nil {
+		g.verifyErr = err
+		return err
+	}
+	if uid, _ := revision["uid"].(string); uid != planned {
+		publishTime := ""
+		if v, ok := revision["publish-time"].(map[string]interface{}); ok {
+			publishTime = fmt.Sprintf(" at %v", v["iso-8601"])
+		}
+		g.verifyErr = fmt.Errorf("revision %s was published by %v%s after the plan, that was made on revision %s. Run terraform plan again to review the changes against the latest revision", uid, revision["user-name"], publishTime, planned)
+		return g.verifyErr
+	}
+	return nil
+}
+
+func (g *revisionGuard) savePlanned(revision string) error {
+	if g.sessionFileName != "" {
+		return savePlannedRevision(g.sessionFileName, g.key, revision)
+	}
+	plannedRevisions.Lock()
+	defer plannedRevisions.Unlock()
+	if revision == "" {
+		delete(plannedRevisions.byKey, g.key)
+	} else {
+		plannedRevisions.byKey[g.key] = revision
+	}
+	return nil
+}
+
+func (g *revisionGuard) loadPlanned() (string, error) {
+	if g.sessionFileName != "" {
+		return getPlannedRevision(g.sessionFileName, g.key)
+	}
+	plannedRevisions.Lock()
+	defer plannedRevisions.Unlock()
+	return plannedRevisions.byKey[g.key], nil
+}
+
+// lastPublishedSession returns the latest revision of the server.
+func lastPublishedSession(client *checkpoint.ApiClient) (map[string]interface{}, error) {
+	showLastPublishedSessionRes, err := client.ApiCall("show-last-published-session", map[string]interface{}{}, client.GetSessionID(), true, client.IsProxyUsed())
+	if err != nil {
+		return nil, fmt.Errorf(err.Error())
+	}
+	if !showLastPublishedSessionRes.Success {
+		return nil, fmt.Errorf(showLastPublishedSessionRes.ErrorMsg)
+	}
+	return showLastPublishedSessionRes.GetData(), nil
+}
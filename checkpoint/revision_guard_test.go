@@ -0,0 +1,48 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"path/filepath"
+	"regexp"
+	"testing"
+)
+
+func TestUnitProvider_revisionGuard(t *testing.T) {
+	testUnitRevisionGuard(t)
+}
+
+func TestUnitProvider_revisionGuardSessionFile(t *testing.T) {
+	// The plan and the apply share the revision through the session file
+	testUnitRevisionGuard(t, hclArgument("session_in_memory", false), hclArgument("session_file_name", filepath.Join(t.TempDir(), "sid.json")))
+}
+
+func testUnitRevisionGuard(t *testing.T, arguments ...string) {
+	mock := newMockApiServer(t)
+	providerConfig := mock.providerConfig(append([]string{hclArgument("revision_guard", true)}, arguments...)...)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccManagementHostConfig("tfTestRevisionGuard", "192.0.2.1", "blue"),
+				Check:  testUnitCheckMockObject(mock, "host", "tfTestRevisionGuard", "color", "blue"),
+			},
+			{
+				PreConfig: func() {
+					// Published after the refresh of the plan, that records the latest revision, and before the apply
+					mock.publishOnCall("show-last-published-session", 2)
+				},
+				Config:      providerConfig + testAccManagementHostConfig("tfTestRevisionGuard", "192.0.2.1", "red"),
+				ExpectError: regexp.MustCompile(`revision [0-9a-f-]+ was published by admin at .* after the plan, that was made on revision [0-9a-f-]+`),
+			},
+			{
+				Config: providerConfig + testAccManagementHostConfig("tfTestRevisionGuard", "192.0.2.1", "red"),
+				Check:  testUnitCheckMockObject(mock, "host", "tfTestRevisionGuard", "color", "red"),
+			},
+		},
+	})
+
+	if count := mock.callCount("set-host"); count != 1 {
+		t.Fatalf("set-host was called %d times, expected the guard to fail the first update", count)
+	}
+}
@@ -0,0 +1,316 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"math"
+	"strings"
+	"sync"
+)
+
+const rulebasePageLimit = 100
+
+// rulebaseEntry is a single row of a flattened rulebase, either a section title or a rule.
+type rulebaseEntry struct {
+	Type string
+	Uid  string
+	Name string
+	Json map[string]interface{}
+}
+
+func (e rulebaseEntry) isSection() bool {
+	return strings.HasSuffix(e.Type, "-section")
+}
+
+// matches returns true if the entry is identified by the given name or UID.
+func (e rulebaseEntry) matches(identifier string) bool {
+	return identifier != "" && (e.Uid == identifier || e.Name == identifier)
+}
+
+func (e rulebaseEntry) identifier() string {
+	if e.Name != "" {
+		return e.Name
+	}
+	return e.Uid
+}
+
+// rulebaseCache keeps the flattened rulebases read during this run, so that refreshing many rules of the same layer
+// reads the layer only once. An entry is dropped whenever a rule of that rulebase is added, moved or deleted. The
+// entries are kept by the UID of the rulebase, so that a layer that is identified by name by some rules and by UID by
+// others is read and dropped once.
+var rulebaseCache = struct {
+	sync.Mutex
+	entries map[string][]rulebaseEntry
+	uids    map[string]string
+}{
+	entries: make(map[string][]rulebaseEntry),
+	uids:    make(map[string]string),
+}
+
+// rulebaseCacheKey returns the key of the rulebase of identifierPayload in rulebaseCache, by the UID of the rulebase
+// once it was read. Must be called with rulebaseCache locked.
+func rulebaseCacheKey(client *checkpoint.ApiClient, command string, identifierPayload map[string]interface{}) string {
+	key := fmt.Sprintf("%p;%s;%v", client, command, identifierPayload)
+	if uid, ok := rulebaseCache.uids[key]; ok {
+		return fmt.Sprintf("%p;%s;%s", client, command, uid)
+	}
+	return key
+}
+
+// registerRulebaseUid records the UID of the rulebase of identifierPayload, and of the name and UID of the rulebase in
+// the reply of the show-*-rulebase command.
+func registerRulebaseUid(client *checkpoint.ApiClient, command string, identifierPayload map[string]interface{}, ruleBaseJson map[string]interface{}) {
+	uid, _ := ruleBaseJson["uid"].(string)
+	if uid == "" {
+		return
+	}
+	identifierPayloads := []map[string]interface{}{identifierPayload}
+	if _, ok := identifierPayload["package"]; !ok {
+		identifierPayloads = append(identifierPayloads, map[string]interface{}{"uid": uid})
+		if name, ok := ruleBaseJson["name"].(string); ok && name != "" {
+			identifierPayloads = append(identifierPayloads, map[string]interface{}{"name": name})
+		}
+	}
+
+	rulebaseCache.Lock()
+	defer rulebaseCache.Unlock()
+	for _, payload := range identifierPayloads {
+		rulebaseCache.uids[fmt.Sprintf("%p;%s;%v", client, command, payload)] = uid
+	}
+}
+
+func invalidateRulebaseCache(client *checkpoint.ApiClient, command string, identifierPayload map[string]interface{}) {
+	rulebaseCache.Lock()
+	defer rulebaseCache.Unlock()
+	delete(rulebaseCache.entries, rulebaseCacheKey(client, command, identifierPayload))
+}
+
+// rulebaseIdentifierPayload identifies a layer by UID or by name, as the show-*-rulebase commands expect.
+func rulebaseIdentifierPayload(identifier string) map[string]interface{} {
+	if uidRegexp.MatchString(identifier) {
+		return map[string]interface{}{"uid": identifier}
+	}
+	return map[string]interface{}{"name": identifier}
+}
+
+// showRulebaseEntries reads a whole rulebase page by page with the given show-*-rulebase command and flattens it
+// in rulebase order. Returns empty rulebase UID if the rulebase does not exist.
+func showRulebaseEntries(client *checkpoint.ApiClient, command string, identifierPayload map[string]interface{}) (string, []rulebaseEntry, error) {
+
+	var rulebaseUid string
+	var entries []rulebaseEntry
+	offset := 0
+
+	for {
+		payload := map[string]interface{}{
+			"offset":                offset,
+			"limit":                 rulebasePageLimit,
+			"details-level":         "standard",
+			"use-object-dictionary": false,
+		}
+		for k, v := range identifierPayload {
+			payload[k] = v
+		}
+
+		showRuleBaseRes, err := client.ApiCall(command, payload, client.GetSessionID(), true, client.IsProxyUsed())
+		if err != nil {
+			return "", nil, fmt.Errorf(err.Error())
+		}
+		if !showRuleBaseRes.Success {
+			if objectNotFound(showRuleBaseRes.GetData()["code"].(string)) {
+				return "", nil, nil
+			}
+			return "", nil, fmt.Errorf(showRuleBaseRes.ErrorMsg)
+		}
+		ruleBaseJson := showRuleBaseRes.GetData()
+
+		if v := ruleBaseJson["uid"]; v != nil {
+			rulebaseUid = v.(string)
+		}
+		if offset == 0 {
+			registerRulebaseUid(client, command, identifierPayload, ruleBaseJson)
+		}
+
+		if ruleBaseList, ok := ruleBaseJson["rulebase"].([]interface{}); ok {
+			for _, item := range ruleBaseList {
+				entry := newRulebaseEntry(item.(map[string]interface{}))
+				if !entry.isSection() {
+					entries = append(entries, entry)
+					continue
+				}
+				entries = appendRulebaseSection(entries, entry)
+				if rules, ok := entry.Json["rulebase"].([]interface{}); ok {
+					for _, rule := range rules {
+						entries = append(entries, newRulebaseEntry(rule.(map[string]interface{})))
+					}
+				}
+			}
+		}
+
+		to, total := 0, 0
+		if v := ruleBaseJson["to"]; v != nil {
+			to = int(math.Round(v.(float64)))
+		}
+		if v := ruleBaseJson["total"]; v != nil {
+			total = int(math.Round(v.(float64)))
+		}
+		if to >= total || to <= offset {
+			break
+		}
+		offset = to
+	}
+
+	return rulebaseUid, entries, nil
+}
+
+func newRulebaseEntry(itemJson map[string]interface{}) rulebaseEntry {
+	entry := rulebaseEntry{Json: itemJson}
+	entry.Type, _ = itemJson["type"].(string)
+	entry.Uid, _ = itemJson["uid"].(string)
+	entry.Name, _ = itemJson["name"].(string)
+	return entry
+}
+
+// appendRulebaseSection adds a section title unless it is the current section. A section that is split between
+// pages is returned again at the top of the next page.
+func appendRulebaseSection(entries []rulebaseEntry, section rulebaseEntry) []rulebaseEntry {
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].isSection() {
+			if entries[i].Uid == section.Uid {
+				return entries
+			}
+			break
+		}
+	}
+	return append(entries, section)
+}
+
+// readRulePosition compares the position of a rule in its rulebase with the configured position. If the configured
+// position is still true it is kept, otherwise the actual position is stored so that the next plan moves the rule
+// back with new-position.
+func readRulePosition(client *checkpoint.ApiClient, command string, identifierPayload map[string]interface{}, d *schema.ResourceData) error {
+
+	rulebaseCache.Lock()
+	entries, ok := rulebaseCache.entries[rulebaseCacheKey(client, command, identifierPayload)]
+	rulebaseCache.Unlock()
+
+	if !ok {
+		_, showEntries, err := showRulebaseEntries(client, command, identifierPayload)
+		if err != nil {
+			return err
+		}
+		entries = showEntries
+		rulebaseCache.Lock()
+		rulebaseCache.entries[rulebaseCacheKey(client, command, identifierPayload)] = entries
+		rulebaseCache.Unlock()
+	}
+
+	index := -1
+	for i, entry := range entries {
+		if !entry.isSection() && entry.Uid == d.Id() {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		// The rulebase was read before the rule was added. Read it again next time.
+		invalidateRulebaseCache(client, command, identifierPayload)
+		return nil
+	}
+
+	position := make(map[string]interface{})
+	if v, ok := d.GetOk("position"); ok {
+		position = v.(map[string]interface{})
+	}
+
+	if rulePositionMatches(entries, index, position) {
+		return nil
+	}
+
+	actualPosition := actualRulePosition(entries, index)
+	log.Printf("Rule [%s] was moved from position %v to %v", d.Id(), position, actualPosition)
+	_ = d.Set("position", actualPosition)
+
+	return nil
+}
+
+// rulePositionMatches returns true if the rule at index still satisfies the position the rule was configured with. A
+// position is a relative order, since the rules that are added later at the same position are placed between the rule
+// and the entry of its position: the rule is below or above the entry anywhere in the same section, and at the top or
+// the bottom anywhere in the leading or trailing run of rules of the rulebase or of the section.
+func rulePositionMatches(entries []rulebaseEntry, index int, position map[string]interface{}) bool {
+
+	// The rule is in the section of entries[start], or before the first section when start is -1, up to entries[end]
+	start := -1
+	for i := index - 1; i >= 0; i-- {
+		if entries[i].isSection() {
+			start = i
+			break
+		}
+	}
+	end := len(entries)
+	for i := index + 1; i < len(entries); i++ {
+		if entries[i].isSection() {
+			end = i
+			break
+		}
+	}
+
+	if v, ok := position["top"].(string); ok && v != "" {
+		if v == "top" {
+			for i := 0; i < start; i++ {
+				if !entries[i].isSection() {
+					return false
+				}
+			}
+			return true
+		}
+		return start >= 0 && entries[start].matches(v)
+	}
+	if v, ok := position["above"].(string); ok && v != "" {
+		// A rule above a section is at the end of the section before it
+		for i := index + 1; i <= end && i < len(entries); i++ {
+			if entries[i].matches(v) {
+				return true
+			}
+		}
+		return false
+	}
+	if v, ok := position["below"].(string); ok && v != "" {
+		// A rule below a section is at the start of the section
+		for i := index - 1; i >= 0 && i >= start; i-- {
+			if entries[i].matches(v) {
+				return true
+			}
+		}
+		return false
+	}
+	if v, ok := position["bottom"].(string); ok && v != "" {
+		if v == "bottom" {
+			for i := end + 1; i < len(entries); i++ {
+				if !entries[i].isSection() {
+					return false
+				}
+			}
+			return true
+		}
+		return start >= 0 && entries[start].matches(v)
+	}
+
+	// No position is known, e.g. after import
+	return false
+}
+
+// actualRulePosition describes where the rule at index is, relative to the entry right before it.
+func actualRulePosition(entries []rulebaseEntry, index int) map[string]interface{} {
+	if index == 0 {
+		return map[string]interface{}{"top": "top"}
+	}
+	prev := entries[index-1]
+	if prev.isSection() {
+		return map[string]interface{}{"top": prev.identifier()}
+	}
+	return map[string]interface{}{"below": prev.identifier()}
+}
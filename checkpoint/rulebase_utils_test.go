@@ -0,0 +1,104 @@
+package checkpoint
+
+import (
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testRulebaseEntries returns a flattened rulebase of the given names. Names that start with S are sections.
+func testRulebaseEntries(names ...string) []rulebaseEntry {
+	entries := make([]rulebaseEntry, len(names))
+	for i, name := range names {
+		entries[i] = rulebaseEntry{Type: "access-rule", Uid: "uid-" + name, Name: name}
+		if strings.HasPrefix(name, "S") {
+			entries[i].Type = "access-section"
+		}
+	}
+	return entries
+}
+
+func TestUnitRulebase_rulePositionMatches(t *testing.T) {
+	entries := testRulebaseEntries("r0", "S1", "r1", "r2", "r3", "S2", "r4", "r5", "S3")
+
+	tests := []struct {
+		rule     string
+		position map[string]interface{}
+		expected bool
+	}{
+		{"r0", map[string]interface{}{"top": "top"}, true},
+		{"r1", map[string]interface{}{"top": "top"}, false},
+		{"r1", map[string]interface{}{"top": "S1"}, true},
+		{"r3", map[string]interface{}{"top": "S1"}, true},
+		{"r4", map[string]interface{}{"top": "S1"}, false},
+		{"r2", map[string]interface{}{"below": "r1"}, true},
+		{"r3", map[string]interface{}{"below": "r1"}, true},
+		{"r3", map[string]interface{}{"below": "uid-r1"}, true},
+		{"r1", map[string]interface{}{"below": "r2"}, false},
+		{"r1", map[string]interface{}{"below": "S1"}, true},
+		{"r4", map[string]interface{}{"below": "r1"}, false},
+		{"r1", map[string]interface{}{"below": "r0"}, false},
+		{"r1", map[string]interface{}{"above": "r3"}, true},
+		{"r2", map[string]interface{}{"above": "r3"}, true},
+		{"r3", map[string]interface{}{"above": "r2"}, false},
+		{"r1", map[string]interface{}{"above": "S2"}, true},
+		{"r1", map[string]interface{}{"above": "r4"}, false},
+		{"r0", map[string]interface{}{"above": "S1"}, true},
+		{"r4", map[string]interface{}{"bottom": "S2"}, true},
+		{"r2", map[string]interface{}{"bottom": "S2"}, false},
+		{"r4", map[string]interface{}{"bottom": "bottom"}, true},
+		{"r5", map[string]interface{}{"bottom": "bottom"}, true},
+		{"r3", map[string]interface{}{"bottom": "bottom"}, false},
+		{"r1", map[string]interface{}{}, false},
+	}
+	for _, test := range tests {
+		index := -1
+		for i, entry := range entries {
+			if entry.Name == test.rule {
+				index = i
+			}
+		}
+		if result := rulePositionMatches(entries, index, test.position); result != test.expected {
+			t.Errorf("rulePositionMatches(%s, %v) = %t, expected %t", test.rule, test.position, result, test.expected)
+		}
+	}
+}
+
+func TestUnitRulebase_actualRulePosition(t *testing.T) {
+	tests := []struct {
+		entries  []rulebaseEntry
+		index    int
+		expected map[string]interface{}
+	}{
+		{testRulebaseEntries("r0", "r1"), 0, map[string]interface{}{"top": "top"}},
+		{testRulebaseEntries("r0", "r1"), 1, map[string]interface{}{"below": "r0"}},
+		{testRulebaseEntries("S1", "r1"), 1, map[string]interface{}{"top": "S1"}},
+		{testRulebaseEntries("r0", "S1", "r1", "r2"), 3, map[string]interface{}{"below": "r1"}},
+		{[]rulebaseEntry{{Type: "access-rule", Uid: "uid-r0"}, {Type: "access-rule", Uid: "uid-r1"}}, 1, map[string]interface{}{"below": "uid-r0"}},
+	}
+	for _, test := range tests {
+		if result := actualRulePosition(test.entries, test.index); !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("actualRulePosition(%v, %d) = %v, expected %v", test.entries, test.index, result, test.expected)
+		}
+	}
+}
+
+func TestUnitRulebase_rulebaseCacheKey(t *testing.T) {
+	client := &checkpoint.ApiClient{}
+	byName := rulebaseIdentifierPayload("tfTestLayer")
+	byUid := rulebaseIdentifierPayload("a1b2c3d4-0000-4000-8000-000000000001")
+	registerRulebaseUid(client, "show-access-rulebase", byName, map[string]interface{}{
+		"uid":  "a1b2c3d4-0000-4000-8000-000000000001",
+		"name": "tfTestLayer",
+	})
+
+	rulebaseCache.Lock()
+	defer rulebaseCache.Unlock()
+	if nameKey, uidKey := rulebaseCacheKey(client, "show-access-rulebase", byName), rulebaseCacheKey(client, "show-access-rulebase", byUid); nameKey != uidKey {
+		t.Errorf("the cache key of the layer by name %s is not the one by UID %s", nameKey, uidKey)
+	}
+	if rulebaseCacheKey(client, "show-threat-rulebase", byName) == rulebaseCacheKey(client, "show-access-rulebase", byName) {
+		t.Errorf("the cache key of the layer is the same for another command")
+	}
+}
@@ -0,0 +1,178 @@
+package checkpoint
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ServerCertificateConfig is the verification of the certificate of a server. Fingerprint pins the SHA-256 fingerprint
+// of the server certificate. CaFile and Ca are CA certificates in PEM format that the certificate chain of the server
+// is verified by. ServerName is the name that the certificate is issued to, when it is not the address of the server.
+type ServerCertificateConfig struct {
+	Fingerprint string
+	CaFile      string
+	Ca          string
+	ServerName  string
+}
+
+// IsSet returns true if the certificate of the server is verified by the provider.
+func (config ServerCertificateConfig) IsSet() bool {
+	return config.Fingerprint != "" || config.CaFile != "" || config.Ca != "" || config.ServerName != ""
+}
+
+type serverCertificate struct {
+	server      string
+	fingerprint string
+	roots       *x509.CertPool
+	serverName  string
+	verifyName  bool
+}
+
+var fingerprintRegex = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// newServerCertificate returns the verification of the certificate of server by config.
+func newServerCertificate(server string, config ServerCertificateConfig) (*serverCertificate, error) {
+	certificate := &serverCertificate{
+		server:     server,
+		serverName: server,
+	}
+
+	if config.Fingerprint != "" {
+		certificate.fingerprint = normalizeFingerprint(config.Fingerprint)
+		if !fingerprintRegex.MatchString(certificate.fingerprint) {
+			return nil, fmt.Errorf("invalid server fingerprint %s. The fingerprint must be the SHA-256 hash of the server certificate in hex, optionally separated by colons", config.Fingerprint)
+		}
+	}
+
+	if config.CaFile != "" || config.Ca != "" {
+		certificate.roots = x509.NewCertPool()
+		if config.CaFile != "" {
+			pem, err := ioutil.ReadFile(config.CaFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read server CA file: %s", err.Error())
+			}
+			if !certificate.roots.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("server CA file %s holds no PEM certificates", config.CaFile)
+			}
+		}
+		if config.Ca != "" && !certificate.roots.AppendCertsFromPEM([]byte(config.Ca)) {
+			return nil, fmt.Errorf("server CA holds no PEM certificates")
+		}
+	}
+
+	if config.ServerName != "" {
+		certificate.serverName = config.ServerName
+	}
+	// A pinned certificate is verified by its name only when the name is set explicitly
+	certificate.verifyName = certificate.fingerprint == "" || certificate.roots != nil || config.ServerName != ""
+
+	return certificate, nil
+}
+
+func (certificate *serverCertificate) verify(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("server %s presented no certificate", certificate.server)
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("failed to parse the certificate of server %s: %s", certificate.server, err.Error())
+		}
+		certs[i] = cert
+	}
+
+	if certificate.fingerprint != "" {
+		sum := sha256.Sum256(certs[0].Raw)
+		if fingerprint := hex.EncodeToString(sum[:]); fingerprint != certificate.fingerprint {
+			return fmt.Errorf("the SHA-256 fingerprint of the certificate of server %s is %s, expected %s", certificate.server, formatFingerprint(fingerprint), formatFingerprint(certificate.fingerprint))
+		}
+	}
+
+	if certificate.roots != nil || certificate.fingerprint == "" {
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		opts := x509.VerifyOptions{
+			Roots:         certificate.roots,
+			Intermediates: intermediates,
+			DNSName:       certificate.serverName,
+		}
+		if _, err := certs[0].Verify(opts); err != nil {
+			return fmt.Errorf("the certificate of server %s is not trusted: %s", certificate.server, err.Error())
+		}
+	} else if certificate.verifyName {
+		if err := certs[0].VerifyHostname(certificate.serverName); err != nil {
+			return fmt.Errorf("the certificate of server %s is not valid: %s", certificate.server, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(fingerprint), ":", "", -1))
+}
+
+// formatFingerprint formats a fingerprint as upper case hex bytes separated by colons, e.g. as shown by openssl.
+func formatFingerprint(fingerprint string) string {
+	var bytes []string
+	for i := 0; i+2 <= len(fingerprint); i += 2 {
+		bytes = append(bytes, strings.ToUpper(fingerprint[i:i+2]))
+	}
+	return strings.Join(bytes, ":")
+}
+
+// fingerprintFile serializes the reads and writes of the fingerprint file of the SDK by the provider.
+var fingerprintFile sync.Mutex
+
+// verifyKnownFingerprint is the fingerprint check of the SDK, for the connections to server that the SDK does not open
+// itself. The fingerprint of the certificate chain of the server is saved in the fingerprint file of the SDK on the
+// first connection, and a connection fails if the server presents a certificate chain with another fingerprint.
+func verifyKnownFingerprint(server string, rawCerts [][]byte) error {
+	fingerprint := ""
+	for _, raw := range rawCerts {
+		sum := sha1.Sum(raw)
+		fingerprint += hex.EncodeToString(sum[:])
+	}
+
+	fingerprintFile.Lock()
+	defer fingerprintFile.Unlock()
+
+	known := make(map[string]string)
+	data, err := ioutil.ReadFile(checkpoint.Filename)
+	if err == nil {
+		if err := json.Unmarshal(data, &known); err != nil {
+			return fmt.Errorf("failed to read fingerprint file %s: %s", checkpoint.Filename, err.Error())
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read fingerprint file %s: %s", checkpoint.Filename, err.Error())
+	}
+
+	if knownFingerprint, ok := known[server]; ok {
+		if strings.Replace(knownFingerprint, ":", "", -1) != fingerprint {
+			return fmt.Errorf("the fingerprint of server %s is different from the one in %s, someone might be trying to steal your information", server, checkpoint.Filename)
+		}
+		return nil
+	}
+
+	if known == nil {
+		known = make(map[string]string)
+	}
+	known[server] = fingerprint
+	if data, err = json.Marshal(known); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(checkpoint.Filename, data, 0644)
+}
@@ -0,0 +1,82 @@
+package checkpoint
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnitProvider_serverCertificate(t *testing.T) {
+	mock := newMockApiServer(t)
+	sum := sha256.Sum256(mock.server.Certificate().Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+	caPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: mock.server.Certificate().Raw}))
+
+	// The test servers share one certificate, so the CA of another server is a new one
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "tfTestOtherCA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	otherCa, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherCa}))
+
+	providerConfig := func(arguments ...string) string {
+		return mock.providerConfig(append([]string{hclArgument("ignore_server_certificate", false)}, arguments...)...)
+	}
+	caArguments := func(ca string, serverName string) []string {
+		return []string{hclArgument("server_ca", ca), hclArgument("server_name", serverName)}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig(caArguments(otherPem, "example.com")...) + testAccManagementHostConfig("tfTestServerCertificate", "192.0.2.1", "blue"),
+				ExpectError: regexp.MustCompile(`the certificate of server 127.0.0.1 is not trusted: x509: certificate signed by unknown authority`),
+			},
+			{
+				Config:      providerConfig(hclArgument("server_fingerprint", strings.Repeat("ab:", 31)+"ab")) + testAccManagementHostConfig("tfTestServerCertificate", "192.0.2.1", "blue"),
+				ExpectError: regexp.MustCompile(`the SHA-256 fingerprint of the certificate of server 127.0.0.1 is .*, expected AB:AB`),
+			},
+			{
+				Config:      providerConfig(hclArgument("ignore_server_certificate", true), hclArgument("server_fingerprint", fingerprint)) + testAccManagementHostConfig("tfTestServerCertificate", "192.0.2.1", "blue"),
+				ExpectError: regexp.MustCompile(`ignore_server_certificate cannot be used with server_fingerprint`),
+			},
+			{
+				Config:      providerConfig(caArguments(caPem, "mgmt.example.org")...) + testAccManagementHostConfig("tfTestServerCertificate", "192.0.2.1", "blue"),
+				ExpectError: regexp.MustCompile(`the certificate of server 127.0.0.1 is not trusted: .*mgmt.example.org`),
+			},
+			{
+				Config: providerConfig(hclArgument("server_fingerprint", formatFingerprint(fingerprint))) + testAccManagementHostConfig("tfTestServerCertificate", "192.0.2.1", "blue"),
+				Check:  testUnitCheckMockObject(mock, "host", "tfTestServerCertificate", "color", "blue"),
+			},
+			{
+				Config: providerConfig(caArguments(caPem, "example.com")...) + testAccManagementHostConfig("tfTestServerCertificate", "192.0.2.1", "red"),
+				Check:  testUnitCheckMockObject(mock, "host", "tfTestServerCertificate", "color", "red"),
+			},
+		},
+	})
+}
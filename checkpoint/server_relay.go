@@ -0,0 +1,255 @@
+package checkpoint
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The SDK opens the connections to the server by itself: every request first dials the server for its fingerprint, and
+// is then sent through http.DefaultTransport, which is shared by every HTTP client of the process. The proxy of the SDK
+// replaces http.DefaultTransport for the whole process, and only the request goes through it, not the fingerprint dial.
+// So when the connections to the server go through a proxy, or verify the certificate of the server, the API client is
+// pointed at a relay on the loopback interface instead. The relay forwards the requests of the client to the server
+// over connections of its own transport, which go through the proxy and verify the certificate of the server.
+var relays = struct {
+	sync.Mutex
+	byConfig map[string]*serverRelay
+}{
+	byConfig: make(map[string]*serverRelay),
+}
+
+// ConfigureConnection sets the proxy and the verification of the server certificate of the connections of the API
+// client of args. When the connections go through a proxy or verify the certificate, the server and port of args are
+// replaced with the ones of the relay of the server, and the relay does the fingerprint check of the SDK, unless
+// args.IgnoreServerCertificate is set.
+func ConfigureConnection(args *checkpoint.ApiClientArgs, proxy ProxyConfig, certificate ServerCertificateConfig) error {
+	proxyURL, err := proxyURLOf(proxy)
+	if err != nil {
+		return err
+	}
+	if proxyURL != nil && noProxy(args.Server, args.Port, os.Getenv("NO_PROXY")+","+os.Getenv("no_proxy")) {
+		proxyURL = nil
+	}
+	if proxyURL == nil && !certificate.IsSet() {
+		return nil
+	}
+
+	proxyAddr := ""
+	if proxyURL != nil {
+		proxyAddr = proxyURL.String()
+	}
+	key := strings.Join([]string{
+		args.Server,
+		strconv.Itoa(args.Port),
+		strconv.FormatBool(args.IgnoreServerCertificate),
+		proxyAddr,
+		proxy.CaFile,
+		certificate.Fingerprint,
+		certificate.CaFile,
+		certificate.Ca,
+		certificate.ServerName,
+	}, "\n")
+
+	relays.Lock()
+	defer relays.Unlock()
+
+	relay, ok := relays.byConfig[key]
+	if !ok {
+		relay = &serverRelay{
+			server:            args.Server,
+			addr:              net.JoinHostPort(args.Server, strconv.Itoa(args.Port)),
+			proxyURL:          proxyURL,
+			ignoreCertificate: args.IgnoreServerCertificate,
+		}
+		if proxyURL != nil && proxyURL.Scheme == "https" {
+			if relay.proxyRootCAs, err = proxyRootCAs(proxy.CaFile); err != nil {
+				return err
+			}
+		}
+		if certificate.IsSet() {
+			if relay.certificate, err = newServerCertificate(args.Server, certificate); err != nil {
+				return err
+			}
+		}
+		if err := relay.listen(); err != nil {
+			return err
+		}
+		relays.byConfig[key] = relay
+
+		if proxyURL != nil {
+			logProxy := *proxyURL
+			logProxy.User = nil
+			log.Printf("Connections to %s go through proxy %s", relay.addr, logProxy.String())
+		}
+	}
+
+	relayAddr := relay.listener.Addr().(*net.TCPAddr)
+	args.Server = relayAddr.IP.String()
+	args.Port = relayAddr.Port
+	args.IgnoreServerCertificate = true
+
+	return nil
+}
+
+// serverRelay forwards the requests that it receives on the loopback interface to the server at addr.
+type serverRelay struct {
+	server            string
+	addr              string
+	proxyURL          *url.URL
+	proxyRootCAs      *x509.CertPool
+	certificate       *serverCertificate
+	ignoreCertificate bool
+	listener          net.Listener
+}
+
+func (relay *serverRelay) listen() error {
+	cert, err := relayCertificate()
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("failed to open the relay of server %s: %s", relay.addr, err.Error())
+	}
+	relay.listener = listener
+
+	transport := &http.Transport{
+		DialTLSContext:        relay.dialServer,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   tlsHandshakeTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	server := &http.Server{
+		Handler: &httputil.ReverseProxy{
+			Director:     relay.direct,
+			Transport:    transport,
+			ErrorHandler: relay.fail,
+		},
+		ErrorLog: log.New(ioutil.Discard, "", 0),
+	}
+	tlsListener := tls.NewListener(listener, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"http/1.1"},
+	})
+	go func() {
+		_ = server.Serve(tlsListener)
+	}()
+	return nil
+}
+
+// direct sends a request of the API client to the server, as if the client sent it to the server directly.
+func (relay *serverRelay) direct(req *http.Request) {
+	req.URL.Scheme = "https"
+	req.URL.Host = relay.addr
+	req.Host = relay.addr
+	// The relay is not a proxy of the client, the server sees the requests of the client
+	req.Header["X-Forwarded-For"] = nil
+}
+
+// fail answers a request that could not be sent to the server with an error in the format of the API server.
+func (relay *serverRelay) fail(w http.ResponseWriter, req *http.Request, err error) {
+	log.Printf("Failed to send request %s to server %s: %s", req.URL.Path, relay.addr, err.Error())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadGateway)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"code":    "generic_error",
+		"message": fmt.Sprintf("Connection to %s failed: %s", relay.addr, err.Error()),
+	})
+}
+
+// dialServer opens a TLS connection to the server, through the proxy of the server if it has one.
+func (relay *serverRelay) dialServer(ctx context.Context, network string, addr string) (net.Conn, error) {
+	var conn net.Conn
+	var err error
+	if relay.proxyURL != nil {
+		conn, err = dialProxy(ctx, relay.proxyURL, relay.proxyRootCAs, addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, network, addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The certificate is verified by verify, since the certificate of a pinned fingerprint is not issued by a trusted CA
+	config := &tls.Config{InsecureSkipVerify: true, VerifyPeerCertificate: relay.verify}
+	serverName := relay.server
+	if relay.certificate != nil {
+		serverName = relay.certificate.serverName
+	}
+	if net.ParseIP(serverName) == nil {
+		config.ServerName = serverName
+	}
+
+	tlsConn := tls.Client(conn, config)
+	if err := handshake(tlsConn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+func (relay *serverRelay) verify(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	switch {
+	case relay.certificate != nil:
+		return relay.certificate.verify(rawCerts, verifiedChains)
+	case relay.ignoreCertificate:
+		return nil
+	default:
+		return verifyKnownFingerprint(relay.server, rawCerts)
+	}
+}
+
+var relayCertificateOnce sync.Once
+var relayCert tls.Certificate
+var relayCertErr error
+
+// relayCertificate returns the self-signed certificate of the relays. The API client does not verify it.
+func relayCertificate() (tls.Certificate, error) {
+	relayCertificateOnce.Do(func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			relayCertErr = err
+			return
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "terraform-provider-checkpoint relay"},
+			IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		if err != nil {
+			relayCertErr = err
+			return
+		}
+		relayCert = tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	})
+	if relayCertErr != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to create the certificate of the server relay: %s", relayCertErr.Error())
+	}
+	return relayCert, nil
+}
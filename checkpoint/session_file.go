@@ -0,0 +1,235 @@
+package checkpoint
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	DefaultSessionFilename = "sid.json"
+)
+
+// Time to wait for another process that saves the session file. A lock file that is older than that was left by a
+// process that stopped while holding it.
+const sessionFileLockTimeout = 30 * time.Second
+
+type Session struct {
+	Sid            string `json:"sid"`
+	Uid            string `json:"uid"`
+	PendingChanges bool   `json:"pending_changes,omitempty"`
+}
+
+// sessionFile is the content of the session file. It holds a session per server, domain and user, so that providers
+// and runs that share a working directory do not overwrite each other's session. Sid and Uid are the session of
+// previous versions, that stored a single session. Revisions are the revisions that the last plans were made on, by
+// the same keys as the sessions.
+type sessionFile struct {
+	Sid       string             `json:"sid,omitempty"`
+	Uid       string             `json:"uid,omitempty"`
+	Sessions  map[string]Session `json:"sessions,omitempty"`
+	Revisions map[string]string  `json:"revisions,omitempty"`
+}
+
+// SessionKey identifies the session of a provider in the session file. An API key is identified by its hash so that
+// it is not written to the file.
+func SessionKey(server string, port int, cloudMgmtId string, domain string, username string, apiKey string) string {
+	user := username
+	if apiKey != "" {
+		user = fmt.Sprintf("api-key-%x", sha256.Sum256([]byte(apiKey)))
+	}
+	key := fmt.Sprintf("%s@%s:%d", user, server, port)
+	if cloudMgmtId != "" {
+		key += "/" + cloudMgmtId
+	}
+	return key + "/" + domain
+}
+
+// Save stores the session under key in the session file. Other sessions in the file are kept. Nothing is stored if
+// sessionFileName is empty, i.e. the session is kept in memory only.
+func (s *Session) Save(sessionFileName string, key string) error {
+	if sessionFileName == "" {
+		return nil
+	}
+
+	unlock, err := lockSessionFile(sessionFileName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	content, err := readSessionFile(sessionFileName)
+	if err != nil {
+		return err
+	}
+	if content.Sessions == nil {
+		content.Sessions = make(map[string]Session)
+	}
+	content.Sid = ""
+	content.Uid = ""
+	content.Sessions[key] = *s
+
+	return writeSessionFile(sessionFileName, content)
+}
+
+// writeSessionFile replaces the session file with content. Must be called with the session file locked.
+func writeSessionFile(sessionFileName string, content sessionFile) error {
+	f, err := json.MarshalIndent(content, "", " ")
+	if err != nil {
+		return err
+	}
+
+	// Replace the file at once so that readers never see a partially written file
+	tmp, err := ioutil.TempFile(filepath.Dir(sessionFileName), filepath.Base(sessionFileName)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(f)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), sessionFileName)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// savePlannedRevision stores the revision that a plan was made on under key in the session file, or removes it if
+// revision is empty.
+func savePlannedRevision(sessionFileName string, key string, revision string) error {
+	unlock, err := lockSessionFile(sessionFileName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	content, err := readSessionFile(sessionFileName)
+	if err != nil {
+		return err
+	}
+	if revision == "" {
+		if _, ok := content.Revisions[key]; !ok {
+			return nil
+		}
+		delete(content.Revisions, key)
+	} else {
+		if content.Revisions == nil {
+			content.Revisions = make(map[string]string)
+		}
+		content.Revisions[key] = revision
+	}
+	return writeSessionFile(sessionFileName, content)
+}
+
+// getPlannedRevision returns the revision that the last plan was made on, stored under key in the session file.
+func getPlannedRevision(sessionFileName string, key string) (string, error) {
+	content, err := readSessionFile(sessionFileName)
+	if err != nil {
+		return "", err
+	}
+	return content.Revisions[key], nil
+}
+
+// GetSession returns the session stored under key in the session file. A session file of previous versions holds a
+// single session, which is returned if no session was stored by key yet.
+func GetSession(sessionFileName string, key string) (Session, error) {
+	if sessionFileName == "" {
+		return Session{}, nil
+	}
+
+	content, err := readSessionFile(sessionFileName)
+	if err != nil {
+		return Session{}, err
+	}
+	if s, ok := content.Sessions[key]; ok {
+		return s, nil
+	}
+	if len(content.Sessions) == 0 {
+		return Session{Sid: content.Sid, Uid: content.Uid}, nil
+	}
+	return Session{}, nil
+}
+
+func readSessionFile(sessionFileName string) (sessionFile, error) {
+	var content sessionFile
+	b, err := ioutil.ReadFile(sessionFileName)
+	if os.IsNotExist(err) || (err == nil && len(b) == 0) {
+		return content, nil
+	}
+	if err != nil {
+		return content, err
+	}
+	if err = json.Unmarshal(b, &content); err != nil {
+		return content, fmt.Errorf("failed to parse session file %s: %s", sessionFileName, err.Error())
+	}
+	return content, nil
+}
+
+// lockSessionFile takes a lock file next to the session file, that is shared by all the processes that use the same
+// session file. The lock file holds a token of its owner, so that only the owner releases it, and a stale lock file is
+// removed only if it still holds the token of the stale owner. Returns a function that releases the lock.
+func lockSessionFile(sessionFileName string) (func(), error) {
+	lockFileName := sessionFileName + ".lock"
+	deadline := time.Now().Add(sessionFileLockTimeout)
+
+	randomBytes := make([]byte, 8)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return nil, err
+	}
+	token := fmt.Sprintf("%d-%s", os.Getpid(), hex.EncodeToString(randomBytes))
+
+	for {
+		f, err := os.OpenFile(lockFileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_, err = f.WriteString(token)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				removeLockFile(lockFileName, token)
+				return nil, err
+			}
+			return func() { removeLockFile(lockFileName, token) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		owner, readErr := ioutil.ReadFile(lockFileName)
+		if info, err := os.Stat(lockFileName); readErr == nil && err == nil && time.Since(info.ModTime()) > sessionFileLockTimeout {
+			removeLockFile(lockFileName, string(owner))
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock file %s. Remove it if no other Terraform run is using session file %s", lockFileName, sessionFileName)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// removeLockFile removes the lock file if it holds the given token. The lock file is first moved aside, which only one
+// process can do, and checked there, so that a lock file that another process took in the meantime is put back
+// instead of removed.
+func removeLockFile(lockFileName string, token string) {
+	movedFileName := fmt.Sprintf("%s.%d.%d", lockFileName, os.Getpid(), time.Now().UnixNano())
+	if err := os.Rename(lockFileName, movedFileName); err != nil {
+		return
+	}
+	if content, err := ioutil.ReadFile(movedFileName); err != nil || string(content) != token {
+		// Link does not replace a lock file that was taken since it was moved
+		_ = os.Link(movedFileName, lockFileName)
+	}
+	_ = os.Remove(movedFileName)
+}
@@ -0,0 +1,57 @@
+package checkpoint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestUnitSessionFile_lockSessionFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tfTestSessionFile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sessionFileName := filepath.Join(dir, DefaultSessionFilename)
+	lockFileName := sessionFileName + ".lock"
+
+	// A stale lock file is taken over
+	if err := ioutil.WriteFile(lockFileName, []byte("stale-owner"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	stale := time.Now().Add(-2 * sessionFileLockTimeout)
+	if err := os.Chtimes(lockFileName, stale, stale); err != nil {
+		t.Fatal(err)
+	}
+	unlock, err := lockSessionFile(sessionFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if owner, _ := ioutil.ReadFile(lockFileName); string(owner) == "stale-owner" {
+		t.Fatalf("the stale lock file was not taken over")
+	}
+
+	// A lock file that another process took over is not removed by the previous owner
+	if err := ioutil.WriteFile(lockFileName, []byte("new-owner"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+	if owner, err := ioutil.ReadFile(lockFileName); err != nil || string(owner) != "new-owner" {
+		t.Fatalf("the lock file of another owner was removed: %v", err)
+	}
+
+	// A stale lock file is removed only if it still holds the token of the stale owner
+	removeLockFile(lockFileName, "stale-owner")
+	if owner, err := ioutil.ReadFile(lockFileName); err != nil || string(owner) != "new-owner" {
+		t.Fatalf("the lock file of another owner was removed: %v", err)
+	}
+	removeLockFile(lockFileName, "new-owner")
+	if _, err := os.Stat(lockFileName); !os.IsNotExist(err) {
+		t.Fatalf("the lock file was not removed by its owner")
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Fatalf("%d files were left next to the session file", len(files))
+	}
+}
@@ -0,0 +1,303 @@
+package checkpoint
+
+import (
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"math"
+	"sync"
+	"time"
+)
+
+// sessionLifecycle tracks the changes a provider made in its session, so that the checkpoint_management_session_publish
+// resource publishes them, or discards them when an operation failed, once at the end of the run. Terraform applies
+// the publish resource in parallel with the other resources, so it waits until the provider has no operation left:
+// no operation is running, and none started for sessionIdleTime, which is longer than Terraform takes to start the
+// resources that depend on an applied resource. The pending changes are also kept in the session file, so that changes
+// of a run that ended before they were published, e.g. deletions that Terraform does not plan for the provider, are
+// published by the next apply.
+type sessionLifecycle struct {
+	sync.Mutex
+	client           *checkpoint.ApiClient
+	session          Session
+	sessionFileName  string
+	sessionKey       string
+	publishOnSuccess bool
+	discardOnFailure bool
+	failed           bool
+	planned          bool
+	running          int
+	lastOperation    time.Time
+}
+
+// sessionIdleTime is the time without operations after which the run of a provider is considered done.
+var sessionIdleTime = 1 * time.Second
+
+var sessionLifecycles = struct {
+	sync.Mutex
+	byClient map[*checkpoint.ApiClient]*sessionLifecycle
+}{byClient: make(map[*checkpoint.ApiClient]*sessionLifecycle)}
+
+// registerSessionLifecycle enables the session lifecycle of a configured provider.
+func registerSessionLifecycle(client *checkpoint.ApiClient, s Session, sessionFileName string, sessionKey string, config map[string]interface{}) {
+	if s.PendingChanges {
+		log.Printf("Session uid [%s] has changes of a previous run that were not published yet", s.Uid)
+	}
+
+	sessionLifecycles.Lock()
+	sessionLifecycles.byClient[client] = &sessionLifecycle{
+		client:           client,
+		session:          s,
+		sessionFileName:  sessionFileName,
+		sessionKey:       sessionKey,
+		publishOnSuccess: config["publish_on_success"].(bool),
+		discardOnFailure: config["discard_on_failure"].(bool),
+		lastOperation:    time.Now(),
+	}
+	sessionLifecycles.Unlock()
+}
+
+func clientSessionLifecycle(m interface{}) *sessionLifecycle {
+	client, ok := m.(*checkpoint.ApiClient)
+	if !ok {
+		return nil
+	}
+	sessionLifecycles.Lock()
+	defer sessionLifecycles.Unlock()
+	return sessionLifecycles.byClient[client]
+}
+
+// trackSessionChanges wraps the operations of every resource that changes the session, to record whether the session
+// has pending changes and whether any operation failed. Command resources, e.g. install-policy or publish, run
+// commands that do not leave changes in the session, so they are not tracked.
+func trackSessionChanges(resources map[string]*schema.Resource) {
+	for name, r := range resources {
+		if name == "checkpoint_management_session_publish" || (r.Create != nil && r.Update == nil) {
+			continue
+		}
+		r.CustomizeDiff = customizeDiffTrackSession(r.CustomizeDiff)
+		r.Read = runSessionOperation(r.Read)
+		r.Create = trackSessionOperation(r.Create)
+		r.Update = trackSessionOperation(r.Update)
+		r.Delete = trackSessionOperation(r.Delete)
+	}
+}
+
+// customizeDiffTrackSession records that the plan changes a resource, so that the publish resource is planned.
+func customizeDiffTrackSession(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, m interface{}) error {
+		l := clientSessionLifecycle(m)
+		if l != nil {
+			l.begin()
+			defer l.end()
+		}
+		if customizeDiff != nil {
+			if err := customizeDiff(d, m); err != nil {
+				return err
+			}
+		}
+		if l != nil && (d.Id() == "" || len(d.GetChangedKeysPrefix("")) > 0) {
+			l.Lock()
+			l.planned = true
+			l.Unlock()
+		}
+		return nil
+	}
+}
+
+// runSessionOperation records that an operation of the provider runs, without changing the session.
+func runSessionOperation(op func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		if l := clientSessionLifecycle(m); l != nil {
+			l.begin()
+			defer l.end()
+		}
+		return op(d, m)
+	}
+}
+
+func trackSessionOperation(op func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if op == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, m interface{}) error {
+		l := clientSessionLifecycle(m)
+		if l == nil {
+			return op(d, m)
+		}
+		l.begin()
+		defer l.end()
+		err := op(d, m)
+		l.track(err)
+		return err
+	}
+}
+
+func (l *sessionLifecycle) begin() {
+	l.Lock()
+	l.running++
+	l.Unlock()
+}
+
+func (l *sessionLifecycle) end() {
+	l.Lock()
+	l.running--
+	l.lastOperation = time.Now()
+	l.Unlock()
+}
+
+// waitForRunEnd returns when no operation of the provider is running, and none started for sessionIdleTime.
+func (l *sessionLifecycle) waitForRunEnd() {
+	for {
+		l.Lock()
+		idle := sessionIdleTime - time.Since(l.lastOperation)
+		if l.running == 0 && idle <= 0 {
+			l.Unlock()
+			return
+		}
+		l.Unlock()
+		if idle < sessionIdleTime/10 {
+			idle = sessionIdleTime / 10
+		}
+		time.Sleep(idle)
+	}
+}
+
+// track records the result of an operation. The changes of a failed run are discarded by the publish resource at the
+// end of the run, so that the other operations of the run, that Terraform continues, are not applied on a session that
+// was discarded under them.
+func (l *sessionLifecycle) track(opErr error) {
+	l.Lock()
+	defer l.Unlock()
+
+	if opErr != nil {
+		l.failed = true
+	}
+	if err := l.savePendingChanges(true); err != nil {
+		log.Printf("Failed to save session file %s: %s", l.sessionFileName, err.Error())
+	}
+}
+
+// hasChanges returns true if the session has pending changes, or the plan changes resources of the session.
+func (l *sessionLifecycle) hasChanges() bool {
+	l.Lock()
+	defer l.Unlock()
+	return l.planned || l.session.PendingChanges
+}
+
+// hasChangesAtRunEnd returns true if the session has pending changes, or the plan changes resources of the session,
+// once the other resources of the provider were planned.
+func (l *sessionLifecycle) hasChangesAtRunEnd() bool {
+	l.waitForRunEnd()
+	return l.hasChanges()
+}
+
+// publish publishes the changes of the session once the other operations of the provider ended, if they all
+// succeeded, and waits for the publish task. If an operation failed, the changes are discarded instead. Returns the id
+// of the publish task and the number of published changes.
+func (l *sessionLifecycle) publish() (string, int, error) {
+	l.waitForRunEnd()
+
+	l.Lock()
+	defer l.Unlock()
+
+	if l.failed {
+		if !l.discardOnFailure {
+			return "", 0, fmt.Errorf("an operation of the provider failed, so the changes of session %s are not published", l.session.Uid)
+		}
+		log.Printf("Discard changes of session uid [%s]", l.session.Uid)
+		if err := l.discard(); err != nil {
+			return "", 0, fmt.Errorf("an operation of the provider failed, and the changes of session %s could not be discarded: %s", l.session.Uid, err.Error())
+		}
+		if err := l.savePendingChanges(false); err != nil {
+			log.Printf("Failed to save session file %s: %s", l.sessionFileName, err.Error())
+		}
+		return "", 0, fmt.Errorf("an operation of the provider failed, so the changes of session %s were discarded", l.session.Uid)
+	}
+	l.planned = false
+	if !l.publishOnSuccess {
+		return "", 0, nil
+	}
+
+	changes, err := l.sessionChanges()
+	if err != nil {
+		return "", 0, err
+	}
+	taskId := ""
+	if changes > 0 {
+		log.Printf("Publish %d changes of session uid [%s]", changes, l.session.Uid)
+		publishRes, err := l.client.ApiCall("publish", map[string]interface{}{}, l.client.GetSessionID(), true, l.client.IsProxyUsed())
+		if err != nil {
+			return "", 0, err
+		}
+		if !publishRes.Success {
+			return "", 0, fmt.Errorf("failed to publish the changes of session %s: %s", l.session.Uid, publishRes.ErrorMsg)
+		}
+		if v := resolveTaskId(publishRes.GetData()); v != nil {
+			taskId = fmt.Sprint(v)
+		}
+	}
+
+	return taskId, changes, l.savePendingChanges(false)
+}
+
+// savePendingChanges records in the session file whether the session has pending changes.
+func (l *sessionLifecycle) savePendingChanges(pending bool) error {
+	if l.session.PendingChanges == pending {
+		return nil
+	}
+	l.session.PendingChanges = pending
+	return l.session.Save(l.sessionFileName, l.sessionKey)
+}
+
+func (l *sessionLifecycle) discard() error {
+	discardRes, err := l.client.ApiCall("discard", map[string]interface{}{}, l.client.GetSessionID(), false, l.client.IsProxyUsed())
+	if err != nil {
+		return err
+	}
+	if !discardRes.Success {
+		return fmt.Errorf(discardRes.ErrorMsg)
+	}
+	return nil
+}
+
+// sessionChanges returns the number of changes in the session that are not published yet.
+func (l *sessionLifecycle) sessionChanges() (int, error) {
+	showSessionRes, err := l.client.ApiCall("show-session", map[string]interface{}{"uid": l.session.Uid}, l.client.GetSessionID(), true, l.client.IsProxyUsed())
+	if err != nil {
+		return 0, err
+	}
+	if !showSessionRes.Success {
+		return 0, fmt.Errorf(showSessionRes.ErrorMsg)
+	}
+	if v, ok := showSessionRes.GetData()["changes"].(float64); ok {
+		return int(math.Round(v)), nil
+	}
+	return 0, nil
+}
+
+// renewSessionLifecycle continues the session lifecycle of a provider that logged in again. The changes of the
+// expired session are lost, so an error is returned if it had pending changes, and the changes of the new session are
+// not published.
+func renewSessionLifecycle(client *checkpoint.ApiClient, s Session) error {
+	sessionLifecycles.Lock()
+	l := sessionLifecycles.byClient[client]
+	sessionLifecycles.Unlock()
+	if l == nil {
+		return nil
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	expired := l.session
+	l.session = s
+	if expired.PendingChanges {
+		l.failed = true
+		return fmt.Errorf("session %s expired with changes that were not published. Its changes are lost, run the apply again to make them", expired.Uid)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package checkpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestUnitProvider_sessionLifecycle(t *testing.T) {
+	sessionIdleTime = 100 * time.Millisecond
+	defer func() { sessionIdleTime = 1 * time.Second }()
+
+	mock := newMockApiServer(t)
+	providerConfig := mock.providerConfig("session_lifecycle {}")
+	publishConfig := `
+resource "checkpoint_management_session_publish" "publish" {}
+`
+	verifyConfig := `
+resource "checkpoint_management_verify_policy" "verify" {
+  policy_package = "standard"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: mock.providers(),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccManagementHostConfig("tfTestLifecycle", "192.0.2.1", "blue") + publishConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("checkpoint_management_session_publish.publish", "published_changes", "1"),
+					resource.TestCheckResourceAttrSet("checkpoint_management_session_publish.publish", "task_id"),
+					testUnitCheckCallCount(mock, "publish", 1),
+				),
+			},
+			{
+				Config: providerConfig + testAccManagementHostConfig("tfTestLifecycle", "192.0.2.1", "red") + publishConfig,
+				Check:  testUnitCheckCallCount(mock, "publish", 2),
+			},
+			{
+				// A failed command does not leave changes in the session, the changes of the run are published
+				Config:      providerConfig + testAccManagementHostConfig("tfTestLifecycle", "192.0.2.1", "green") + publishConfig + verifyConfig,
+				ExpectError: regexp.MustCompile(`Unknown command "verify-policy"`),
+			},
+			{
+				PreConfig: func() {
+					if count := mock.callCount("publish"); count != 3 {
+						t.Fatalf("publish was called %d times, expected 3", count)
+					}
+					mock.failNext("set-host", 400, "generic_err_invalid_parameter", "Invalid color")
+				},
+				Config:      providerConfig + testAccManagementHostConfig("tfTestLifecycle", "192.0.2.1", "yellow") + publishConfig,
+				ExpectError: regexp.MustCompile(`(?s)(Invalid color.*changes of session .* were discarded|changes of session .* were discarded.*Invalid color)`),
+			},
+			{
+				PreConfig: func() {
+					mock.failTaskOfNext("publish", "Policy verification failed")
+				},
+				Config:      providerConfig + testAccManagementHostConfig("tfTestLifecycle", "192.0.2.1", "yellow") + publishConfig,
+				ExpectError: regexp.MustCompile(`failed to publish the changes of session (.|\s)*Policy verification failed`),
+			},
+		},
+	})
+
+	if count := mock.callCount("discard"); count != 1 {
+		t.Fatalf("discard was called %d times, expected 1", count)
+	}
+}
@@ -1,55 +1,53 @@
 package checkpoint
 
 import (
-	"encoding/json"
 	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"io/ioutil"
-	"os"
 	"strings"
 	"time"
 )
 
 //var lock sync.Mutex
 
-const (
-	DefaultSessionFilename = "sid.json"
-)
-
-type Session struct {
-	Sid string `json:"sid"`
-	Uid string `json:"uid"`
+// importStateCompositeId returns an import function for objects that are read by more than their UID, e.g. a rule
+// that is read by its layer and UID. The import ID holds the values of the given fields followed by the object UID, separated by ';'.
+// format describes the expected import ID in the error message, e.g. "<LAYER_IDENTIFIER>;<RULE_UID>".
+func importStateCompositeId(format string, fields ...string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		values, uid, err := parseCompositeId(d.Id(), format, len(fields))
+		if err != nil {
+			return nil, err
+		}
+		for i, field := range fields {
+			_ = d.Set(field, values[i])
+		}
+		d.SetId(uid)
+		return []*schema.ResourceData{d}, nil
+	}
 }
 
-func (s *Session) Save(sessionFileName string) error {
-	f, err := json.MarshalIndent(s, "", " ")
-	if err != nil {
-		return err
+// parseCompositeId splits an import ID of the form "<VALUE_1>;...;<VALUE_N>;<UID>" into its n values and the UID.
+func parseCompositeId(id string, format string, n int) ([]string, string, error) {
+	arr := strings.Split(id, ";")
+	if len(arr) != n+1 {
+		return nil, "", fmt.Errorf("invalid unique identifier format. UID format: %s", format)
 	}
-	err = ioutil.WriteFile(sessionFileName, f, 0644)
-	if err != nil {
-		return err
+	for _, v := range arr {
+		if v == "" {
+			return nil, "", fmt.Errorf("invalid unique identifier format. UID format: %s", format)
+		}
 	}
-	return nil
+	return arr[:n], arr[n], nil
 }
 
-func GetSession(sessionFileName string) (Session, error) {
-	if _, err := os.Stat(sessionFileName); os.IsNotExist(err) {
-		_, err := os.Create(sessionFileName)
-		if err != nil {
-			return Session{}, err
-		}
-	}
-	b, err := ioutil.ReadFile(sessionFileName)
-	if err != nil || len(b) == 0 {
-		return Session{}, err
-	}
-	var s Session
-	if err = json.Unmarshal(b, &s); err != nil {
-		return Session{}, err
+// importStateByField returns an import function for objects that are read by a field other than their ID, e.g. by name.
+// The import ID is the value of that field.
+func importStateByField(field string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		_ = d.Set(field, d.Id())
+		return []*schema.ResourceData{d}, nil
 	}
-	return s, nil
 }
 
 func CheckSession(c *checkpoint.ApiClient, uid string) bool {
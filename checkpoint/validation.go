@@ -1,8 +1,10 @@
 package checkpoint
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"regexp"
 )
 
 func objectNotFound(code string) bool {
@@ -26,3 +28,20 @@ func validateStringValue(optionalValues ...string) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+func validateJsonObject(v interface{}, k string) (warns []string, errs []error) {
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &value); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a JSON object: %s", k, err.Error()))
+	}
+	return
+}
+
+var apiVersionRegex = regexp.MustCompile(`^v?[0-9]+(\.[0-9]+)*$`)
+
+func validateApiVersion(v interface{}, k string) (warns []string, errs []error) {
+	if value := v.(string); value != "" && !apiVersionRegex.MatchString(value) {
+		errs = append(errs, fmt.Errorf("%q must be an API version, e.g. 1.8: %q", k, value))
+	}
+	return
+}
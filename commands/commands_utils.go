@@ -1,42 +1,18 @@
 package commands
 
 import (
-	"encoding/json"
 	"fmt"
 	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
-	"io/ioutil"
+	provider "github.com/CheckPointSW/terraform-provider-checkpoint/checkpoint"
 	"os"
 	"strconv"
 	"time"
 )
 
 const (
-	DefaultFilename = "sid.json"
+	DefaultFilename = provider.DefaultSessionFilename
 )
 
-type Session struct {
-	Sid string `json:"sid"`
-	Uid string `json:"uid"`
-}
-
-func GetSession(sessionFileName string) (Session, error) {
-	if _, err := os.Stat(sessionFileName); os.IsNotExist(err) {
-		_, err := os.Create(sessionFileName)
-		if err != nil {
-			return Session{}, err
-		}
-	}
-	b, err := ioutil.ReadFile(sessionFileName)
-	if err != nil || len(b) == 0 {
-		return Session{}, err
-	}
-	var s Session
-	if err = json.Unmarshal(b, &s); err != nil {
-		return Session{}, err
-	}
-	return s, nil
-}
-
 func ResolveTaskId(data map[string]interface{}) interface{} {
 	if data != nil {
 		if v := data["tasks"]; v != nil {
@@ -54,6 +30,58 @@ func ResolveTaskId(data map[string]interface{}) interface{} {
 }
 
 func InitClient() (checkpoint.ApiClient, error) {
+	args, sessionFileName, key, err := initClientArgs()
+	if err != nil {
+		return checkpoint.ApiClient{}, err
+	}
+
+	s, err := provider.GetSession(sessionFileName, key)
+	if err != nil {
+		return checkpoint.ApiClient{}, err
+	}
+	if s.Sid != "" {
+		args.Sid = s.Sid
+	} else {
+		return checkpoint.ApiClient{}, fmt.Errorf("session id of %s not found. Verify %s file exists in working directory and that CHECKPOINT_SERVER, CHECKPOINT_PORT, CHECKPOINT_DOMAIN and the credentials are set as in the provider configuration", key, sessionFileName)
+	}
+
+	mgmt := checkpoint.APIClient(args)
+
+	return *mgmt, nil
+}
+
+// LoginClient opens a new session with the credentials from the environment variables instead of using the session
+// of Terraform. Used by commands that only read from the management server.
+func LoginClient(readOnly bool) (*checkpoint.ApiClient, error) {
+	args, _, _, err := initClientArgs()
+	if err != nil {
+		return nil, err
+	}
+
+	username := os.Getenv("CHECKPOINT_USERNAME")
+	password := os.Getenv("CHECKPOINT_PASSWORD")
+	apiKey := os.Getenv("CHECKPOINT_API_KEY")
+	domain := os.Getenv("CHECKPOINT_DOMAIN")
+
+	mgmt := checkpoint.APIClient(args)
+
+	var loginRes checkpoint.APIResponse
+	if apiKey != "" {
+		loginRes, err = mgmt.ApiLoginWithApiKey(apiKey, false, domain, readOnly, map[string]interface{}{})
+	} else {
+		loginRes, err = mgmt.ApiLogin(username, password, false, domain, readOnly, map[string]interface{}{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !loginRes.Success {
+		return nil, fmt.Errorf(loginRes.ErrorMsg)
+	}
+
+	return mgmt, nil
+}
+
+func initClientArgs() (checkpoint.ApiClientArgs, string, string, error) {
 	// Default values
 	port := checkpoint.DefaultPort
 	timeout := checkpoint.TimeOut
@@ -69,6 +97,15 @@ func InitClient() (checkpoint.ApiClient, error) {
 	sessionFileName := os.Getenv("CHECKPOINT_SESSION_FILE_NAME")
 	proxyHost := os.Getenv("CHECKPOINT_PROXY_HOST")
 	proxyPortStr := os.Getenv("CHECKPOINT_PROXY_PORT")
+	proxyUsername := os.Getenv("CHECKPOINT_PROXY_USERNAME")
+	proxyPassword := os.Getenv("CHECKPOINT_PROXY_PASSWORD")
+	proxyCaFile := os.Getenv("CHECKPOINT_PROXY_CA_FILE")
+	serverCertificate := provider.ServerCertificateConfig{
+		Fingerprint: os.Getenv("CHECKPOINT_SERVER_FINGERPRINT"),
+		CaFile:      os.Getenv("CHECKPOINT_SERVER_CA_FILE"),
+		Ca:          os.Getenv("CHECKPOINT_SERVER_CA"),
+		ServerName:  os.Getenv("CHECKPOINT_SERVER_NAME"),
+	}
 	apiKey := os.Getenv("CHECKPOINT_API_KEY")
 	cloudMgmtId := os.Getenv("CHECKPOINT_CLOUD_MGMT_ID")
 	autoPublishBatchSizeVal := os.Getenv("CHECKPOINT_AUTO_PUBLISH_BATCH_SIZE")
@@ -77,21 +114,21 @@ func InitClient() (checkpoint.ApiClient, error) {
 	if portVal != "" {
 		port, err = strconv.Atoi(portVal)
 		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_PORT to integer")
+			return checkpoint.ApiClientArgs{}, "", "", fmt.Errorf("failed to parse CHECKPOINT_PORT to integer")
 		}
 	}
 
 	if proxyPortStr != "" {
 		proxyPort, err = strconv.Atoi(proxyPortStr)
 		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_PROXY_PORT to integer")
+			return checkpoint.ApiClientArgs{}, "", "", fmt.Errorf("failed to parse CHECKPOINT_PROXY_PORT to integer")
 		}
 	}
 
 	if timeoutVal != "" {
 		timeoutInteger, err := strconv.Atoi(timeoutVal)
 		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_TIMEOUT to integer")
+			return checkpoint.ApiClientArgs{}, "", "", fmt.Errorf("failed to parse CHECKPOINT_TIMEOUT to integer")
 		}
 		timeout = time.Duration(timeoutInteger)
 	}
@@ -103,18 +140,18 @@ func InitClient() (checkpoint.ApiClient, error) {
 	if autoPublishBatchSizeVal != "" {
 		autoPublishBatchSize, err = strconv.Atoi(timeoutVal)
 		if err != nil {
-			return checkpoint.ApiClient{}, fmt.Errorf("failed to parse CHECKPOINT_AUTO_PUBLISH_BATCH_SIZE to integer")
+			return checkpoint.ApiClientArgs{}, "", "", fmt.Errorf("failed to parse CHECKPOINT_AUTO_PUBLISH_BATCH_SIZE to integer")
 		}
 	}
 
 	if server == "" || ((username == "" || password == "") && apiKey == "") {
-		return checkpoint.ApiClient{}, fmt.Errorf("missing at least one required parameter to initialize API client (CHECKPOINT_SERVER, (CHECKPOINT_USERNAME and CHECKPOINT_PASSWORD) OR CHECKPOINT_API_KEY)")
+		return checkpoint.ApiClientArgs{}, "", "", fmt.Errorf("missing at least one required parameter to initialize API client (CHECKPOINT_SERVER, (CHECKPOINT_USERNAME and CHECKPOINT_PASSWORD) OR CHECKPOINT_API_KEY)")
 	}
 
 	// install policy/publish - only on management api
 	if val, ok := os.LookupEnv("CHECKPOINT_CONTEXT"); ok {
 		if val == "gaia_api" {
-			return checkpoint.ApiClient{}, fmt.Errorf("post apply/destroy scripts are valid only on management api. Env var CHECKPOINT_CONTEXT is 'gaia_api'")
+			return checkpoint.ApiClientArgs{}, "", "", fmt.Errorf("post apply/destroy scripts are valid only on management api. Env var CHECKPOINT_CONTEXT is 'gaia_api'")
 		}
 	}
 
@@ -123,8 +160,8 @@ func InitClient() (checkpoint.ApiClient, error) {
 		Fingerprint:             "",
 		Sid:                     "",
 		Server:                  server,
-		ProxyHost:               proxyHost,
-		ProxyPort:               proxyPort,
+		ProxyHost:               checkpoint.DefaultProxyHost,
+		ProxyPort:               checkpoint.DefaultProxyPort,
 		ApiVersion:              "",
 		IgnoreServerCertificate: false,
 		AcceptServerCertificate: false,
@@ -137,17 +174,20 @@ func InitClient() (checkpoint.ApiClient, error) {
 		AutoPublishBatchSize:    autoPublishBatchSize,
 	}
 
-	s, err := GetSession(sessionFileName)
-	if err != nil {
-		return checkpoint.ApiClient{}, err
+	// The session is kept by the address of the server, before the client is pointed at the relay of the server
+	key := provider.SessionKey(args.Server, args.Port, args.CloudMgmtId, os.Getenv("CHECKPOINT_DOMAIN"), os.Getenv("CHECKPOINT_USERNAME"), os.Getenv("CHECKPOINT_API_KEY"))
+
+	// The proxy and the server certificate are set for the connections to the server, same as the provider does
+	proxy := provider.ProxyConfig{
+		Host:     proxyHost,
+		Port:     proxyPort,
+		Username: proxyUsername,
+		Password: proxyPassword,
+		CaFile:   proxyCaFile,
 	}
-	if s.Sid != "" {
-		args.Sid = s.Sid
-	} else {
-		return checkpoint.ApiClient{}, fmt.Errorf("session id not found. Verify %s file exists in working directory", sessionFileName)
+	if err := provider.ConfigureConnection(&args, proxy, serverCertificate); err != nil {
+		return checkpoint.ApiClientArgs{}, "", "", err
 	}
 
-	mgmt := checkpoint.APIClient(args)
-
-	return *mgmt, nil
+	return args, sessionFileName, key, nil
 }
@@ -0,0 +1,593 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	provider "github.com/CheckPointSW/terraform-provider-checkpoint/checkpoint"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/commands"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	pageLimit          = 500
+	defaultObjectTypes = "host,network,address-range,multicast-address-range,wildcard,group,group-with-exclusion,dns-domain,security-zone,dynamic-object,time,time-group,service-tcp,service-udp,service-icmp,service-icmp6,service-sctp,service-other,service-group,application-site,application-site-category,application-site-group"
+)
+
+// Arguments that are not part of the object on the server
+var skippedArguments = map[string]bool{
+	"uid":             true,
+	"ignore_warnings": true,
+	"ignore_errors":   true,
+	"auto_generated":  true,
+	"rule_number":     true,
+	// Objects are exported from the domain of the session
+	"domain": true,
+}
+
+var (
+	labelRegexp = regexp.MustCompile(`[^a-z0-9_]+`)
+	uidRegexp   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+type arrayFlags []string
+
+func (i *arrayFlags) String() string {
+	return strings.Join(*i, ",")
+}
+
+func (i *arrayFlags) Set(value string) error {
+	*i = append(*i, value)
+	return nil
+}
+
+type importEntry struct {
+	address string
+	id      string
+}
+
+type exporter struct {
+	client    *checkpoint.ApiClient
+	resources map[string]*schema.Resource
+	labels    map[string]bool
+	files     map[string]*strings.Builder
+	imports   []importEntry
+}
+
+// rulebaseEntry is a single row of a flattened rulebase, either a section title or a rule.
+type rulebaseEntry struct {
+	section bool
+	json    map[string]interface{}
+}
+
+func main() {
+	var objectTypes string
+	var accessLayers arrayFlags
+	var natPackages arrayFlags
+	var outDir string
+	var importFormat string
+
+	flag.StringVar(&objectTypes, "types", defaultObjectTypes, "Comma separated list of object types to export, as returned in the 'type' field of show-objects.")
+	flag.Var(&accessLayers, "access-layer", "Access layer to export the rules and sections of, identified by name or UID. Multiple layers can be added.")
+	flag.Var(&natPackages, "nat-package", "Policy package to export the NAT rules and sections of, identified by name. Multiple packages can be added.")
+	flag.StringVar(&outDir, "out", "export", "Directory to write the generated files to.")
+	flag.StringVar(&importFormat, "import-format", "block", "Either 'block' to generate import blocks (Terraform 1.5 and above) or 'script' to generate a shell script of terraform import commands.")
+	flag.Parse()
+
+	if importFormat != "block" && importFormat != "script" {
+		fmt.Println("Export error: -import-format must be 'block' or 'script'")
+		os.Exit(1)
+	}
+
+	apiClient, err := commands.LoginClient(true)
+	if err != nil {
+		fmt.Println("Export error: " + err.Error())
+		os.Exit(1)
+	}
+
+	e := &exporter{
+		client:    apiClient,
+		resources: provider.Provider().(*schema.Provider).ResourcesMap,
+		labels:    make(map[string]bool),
+		files:     make(map[string]*strings.Builder),
+	}
+
+	err = e.export(strings.Split(objectTypes, ","), accessLayers, natPackages)
+	if err == nil {
+		err = e.save(outDir, importFormat)
+	}
+
+	_, _ = apiClient.ApiCall("logout", map[string]interface{}{}, apiClient.GetSessionID(), false, apiClient.IsProxyUsed())
+
+	if err != nil {
+		fmt.Println("Export error: " + err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Println(fmt.Sprintf("Export finished successfully. %d objects were written to %s", len(e.imports), outDir))
+}
+
+func (e *exporter) export(objectTypes []string, accessLayers []string, natPackages []string) error {
+	for _, objectType := range objectTypes {
+		if objectType = strings.TrimSpace(objectType); objectType == "" {
+			continue
+		}
+		if err := e.exportObjects(objectType); err != nil {
+			return err
+		}
+	}
+
+	for _, layer := range accessLayers {
+		if err := e.exportRulebase("show-access-rulebase", rulebaseIdentifier(layer), "layer", layer, "access"); err != nil {
+			return err
+		}
+	}
+
+	for _, pkg := range natPackages {
+		if err := e.exportRulebase("show-nat-rulebase", map[string]interface{}{"package": pkg}, "package", pkg, "nat"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportObjects writes a resource for every object of the given type that was created by an administrator.
+func (e *exporter) exportObjects(objectType string) error {
+	resourceType := "checkpoint_management_" + strings.ReplaceAll(objectType, "-", "_")
+	resource, ok := e.resources[resourceType]
+	if !ok {
+		fmt.Println(fmt.Sprintf("Skip object type '%s'. There is no %s resource", objectType, resourceType))
+		return nil
+	}
+
+	objects, err := e.showAll("show-objects", map[string]interface{}{"type": objectType, "details-level": "full"}, "objects")
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		if isPredefined(obj) {
+			continue
+		}
+		name, _ := obj["name"].(string)
+		uid, _ := obj["uid"].(string)
+
+		d, err := e.readResource(resource, uid, nil)
+		if err != nil {
+			return fmt.Errorf("failed to read %s %s: %s", objectType, name, err)
+		}
+		if d == nil {
+			continue
+		}
+
+		b := &strings.Builder{}
+		e.writeAttributes(b, "  ", resourceDataValues(d, resource.Schema), resource.Schema)
+		e.writeResource(resourceType, name, b.String(), uid)
+	}
+
+	return nil
+}
+
+// exportRulebase writes the rules and sections of a rulebase in order. Every entry is positioned by UID relative to the
+// entry right before it, so the position that is read back after import matches the configuration also when names are
+// not unique.
+func (e *exporter) exportRulebase(command string, identifier map[string]interface{}, containerArg string, container string, prefix string) error {
+	ruleType := "checkpoint_management_" + prefix + "_rule"
+	sectionType := "checkpoint_management_" + prefix + "_section"
+
+	entries, err := e.showRulebase(command, identifier)
+	if err != nil {
+		return err
+	}
+
+	var prev *rulebaseEntry
+	for i := range entries {
+		entry := &entries[i]
+		if isAutoGenerated(entry) {
+			continue
+		}
+
+		name, _ := entry.json["name"].(string)
+		uid, _ := entry.json["uid"].(string)
+
+		resourceType := ruleType
+		if entry.section {
+			resourceType = sectionType
+		}
+		resource, ok := e.resources[resourceType]
+		if !ok {
+			return fmt.Errorf("there is no %s resource", resourceType)
+		}
+		d, err := e.readResource(resource, uid, map[string]interface{}{containerArg: container})
+		if err != nil {
+			return fmt.Errorf("failed to read %s %s of %s: %s", resourceType, uid, container, err)
+		}
+		if d == nil {
+			continue
+		}
+
+		b := &strings.Builder{}
+		b.WriteString(fmt.Sprintf("  %s = %s\n", containerArg, hclString(container)))
+		b.WriteString(fmt.Sprintf("  position = %s\n", hclPosition(prev)))
+
+		values := resourceDataValues(d, resource.Schema)
+		delete(values, containerArg)
+		delete(values, "position")
+		e.writeAttributes(b, "  ", values, resource.Schema)
+
+		label := name
+		if label == "" {
+			label = prefix + "_rule_" + strconv.Itoa(i+1)
+		}
+		e.writeResource(resourceType, label, b.String(), container+";"+uid)
+		prev = entry
+	}
+
+	return nil
+}
+
+// readResource reads an object with the Read function of its resource, so that the fields of the object are mapped
+// to arguments the same way the provider maps them. Arguments that identify the object besides its UID, e.g. the
+// layer of a rule, are given in identifiers. Returns nil if the object does not exist anymore.
+func (e *exporter) readResource(resource *schema.Resource, uid string, identifiers map[string]interface{}) (*schema.ResourceData, error) {
+	d := resource.Data(&terraform.InstanceState{ID: uid})
+	for k, v := range identifiers {
+		if err := d.Set(k, v); err != nil {
+			return nil, err
+		}
+	}
+	if err := resource.Read(d, e.client); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, nil
+	}
+	return d, nil
+}
+
+// resourceDataValues returns the values of the arguments of a resource that can be configured.
+func resourceDataValues(d *schema.ResourceData, s map[string]*schema.Schema) map[string]interface{} {
+	values := make(map[string]interface{})
+	for k, sch := range s {
+		if skippedArguments[k] || (sch.Computed && !sch.Optional && !sch.Required) {
+			continue
+		}
+		values[k] = d.Get(k)
+	}
+	return values
+}
+
+// showAll runs a show command page by page and returns the objects of all pages.
+func (e *exporter) showAll(command string, payload map[string]interface{}, containerKey string) ([]map[string]interface{}, error) {
+	var objects []map[string]interface{}
+	offset := 0
+
+	for {
+		payload["offset"] = offset
+		payload["limit"] = pageLimit
+
+		res, err := e.client.ApiCall(command, payload, e.client.GetSessionID(), true, e.client.IsProxyUsed())
+		if err != nil {
+			return nil, err
+		}
+		if !res.Success {
+			return nil, fmt.Errorf("%s failed: %s", command, res.ErrorMsg)
+		}
+		data := res.GetData()
+
+		if list, ok := data[containerKey].([]interface{}); ok {
+			for _, obj := range list {
+				objects = append(objects, obj.(map[string]interface{}))
+			}
+		}
+
+		to, total := pageNumber(data["to"]), pageNumber(data["total"])
+		if to >= total || to <= offset {
+			break
+		}
+		offset = to
+	}
+
+	return objects, nil
+}
+
+// showRulebase reads a whole rulebase page by page and flattens it in rulebase order.
+func (e *exporter) showRulebase(command string, identifier map[string]interface{}) ([]rulebaseEntry, error) {
+	var entries []rulebaseEntry
+	var sectionUid string
+	offset := 0
+
+	for {
+		payload := map[string]interface{}{
+			"offset":                offset,
+			"limit":                 pageLimit,
+			"details-level":         "full",
+			"use-object-dictionary": false,
+		}
+		for k, v := range identifier {
+			payload[k] = v
+		}
+
+		res, err := e.client.ApiCall(command, payload, e.client.GetSessionID(), true, e.client.IsProxyUsed())
+		if err != nil {
+			return nil, err
+		}
+		if !res.Success {
+			return nil, fmt.Errorf("%s failed: %s", command, res.ErrorMsg)
+		}
+		data := res.GetData()
+
+		if list, ok := data["rulebase"].([]interface{}); ok {
+			for _, item := range list {
+				itemMap := item.(map[string]interface{})
+				itemType, _ := itemMap["type"].(string)
+				if !strings.HasSuffix(itemType, "-section") {
+					entries = append(entries, rulebaseEntry{json: itemMap})
+					continue
+				}
+				// A section that is split between pages is returned again at the top of the next page
+				if uid, _ := itemMap["uid"].(string); uid != sectionUid {
+					sectionUid = uid
+					entries = append(entries, rulebaseEntry{section: true, json: itemMap})
+				}
+				if rules, ok := itemMap["rulebase"].([]interface{}); ok {
+					for _, rule := range rules {
+						entries = append(entries, rulebaseEntry{json: rule.(map[string]interface{})})
+					}
+				}
+			}
+		}
+
+		to, total := pageNumber(data["to"]), pageNumber(data["total"])
+		if to >= total || to <= offset {
+			break
+		}
+		offset = to
+	}
+
+	return entries, nil
+}
+
+// writeAttributes writes the arguments of a resource from their values, as read by the Read function of the
+// resource. Nested blocks are written with the schema of their block. Values that are not set or are the default of
+// their argument are left out.
+func (e *exporter) writeAttributes(b *strings.Builder, indent string, values map[string]interface{}, s map[string]*schema.Schema) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if s[k] != nil && !skippedArguments[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		sch := s[k]
+		if sch.Computed && !sch.Optional && !sch.Required {
+			continue
+		}
+		v := values[k]
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+
+		switch sch.Type {
+		case schema.TypeString, schema.TypeBool, schema.TypeInt, schema.TypeFloat:
+			if !isConfigured(v, sch) {
+				continue
+			}
+			b.WriteString(fmt.Sprintf("%s%s = %s\n", indent, k, hclValue(v, sch.Type)))
+		case schema.TypeList, schema.TypeSet:
+			items, _ := v.([]interface{})
+			if len(items) == 0 {
+				continue
+			}
+			if elem, ok := sch.Elem.(*schema.Resource); ok {
+				for _, item := range items {
+					if itemValues, ok := item.(map[string]interface{}); ok {
+						b.WriteString(fmt.Sprintf("%s%s {\n", indent, k))
+						e.writeAttributes(b, indent+"  ", itemValues, elem.Schema)
+						b.WriteString(indent + "}\n")
+					}
+				}
+				continue
+			}
+			elemType := schema.TypeString
+			if elem, ok := sch.Elem.(*schema.Schema); ok {
+				elemType = elem.Type
+			}
+			itemValues := make([]string, 0, len(items))
+			for _, item := range items {
+				itemValues = append(itemValues, hclValue(item, elemType))
+			}
+			if sch.Type == schema.TypeSet {
+				sort.Strings(itemValues)
+			}
+			b.WriteString(fmt.Sprintf("%s%s = [%s]\n", indent, k, strings.Join(itemValues, ", ")))
+		case schema.TypeMap:
+			m, _ := v.(map[string]interface{})
+			if len(m) == 0 {
+				continue
+			}
+			elemType := schema.TypeString
+			if elem, ok := sch.Elem.(*schema.Schema); ok {
+				elemType = elem.Type
+			}
+			mapKeys := make([]string, 0, len(m))
+			for key := range m {
+				mapKeys = append(mapKeys, key)
+			}
+			sort.Strings(mapKeys)
+			var mapValues []string
+			for _, key := range mapKeys {
+				if value := hclValue(m[key], elemType); value != "" {
+					mapValues = append(mapValues, fmt.Sprintf("%s  %s = %s\n", indent, key, value))
+				}
+			}
+			if len(mapValues) > 0 {
+				b.WriteString(fmt.Sprintf("%s%s = {\n%s%s}\n", indent, k, strings.Join(mapValues, ""), indent))
+			}
+		}
+	}
+}
+
+// isConfigured returns true if a scalar value differs from the value of an argument that is not set. Empty strings are
+// not set, and other values are compared with the default of the argument when it has one.
+func isConfigured(v interface{}, sch *schema.Schema) bool {
+	if v == nil || v == "" {
+		return false
+	}
+	if sch.Default != nil {
+		return fmt.Sprint(sch.Default) != fmt.Sprint(v)
+	}
+	switch value := v.(type) {
+	case bool:
+		return value
+	case int:
+		return value != 0
+	case float64:
+		return value != 0
+	}
+	return true
+}
+
+// writeResource adds a resource block and its import ID. Labels are made from the object name and are unique per
+// resource type.
+func (e *exporter) writeResource(resourceType string, name string, body string, id string) {
+	label := strings.Trim(labelRegexp.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "obj_" + label
+	}
+	unique := label
+	for i := 2; e.labels[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	address := resourceType + "." + unique
+	e.labels[address] = true
+
+	f, ok := e.files[resourceType]
+	if !ok {
+		f = &strings.Builder{}
+		e.files[resourceType] = f
+	}
+	f.WriteString(fmt.Sprintf("resource \"%s\" \"%s\" {\n%s}\n\n", resourceType, unique, body))
+
+	e.imports = append(e.imports, importEntry{address: address, id: id})
+}
+
+// save writes one .tf file per resource type, and the import blocks or import script.
+func (e *exporter) save(outDir string, importFormat string) error {
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return err
+	}
+
+	for resourceType, f := range e.files {
+		if err := ioutil.WriteFile(filepath.Join(outDir, resourceType+".tf"), []byte(f.String()), 0644); err != nil {
+			return err
+		}
+	}
+
+	b := &strings.Builder{}
+	if importFormat == "block" {
+		for _, entry := range e.imports {
+			b.WriteString(fmt.Sprintf("import {\n  to = %s\n  id = %s\n}\n\n", entry.address, hclString(entry.id)))
+		}
+		return ioutil.WriteFile(filepath.Join(outDir, "imports.tf"), []byte(b.String()), 0644)
+	}
+
+	b.WriteString("#!/bin/sh\nset -e\n\n")
+	for _, entry := range e.imports {
+		b.WriteString(fmt.Sprintf("terraform import %s %s\n", shellString(entry.address), shellString(entry.id)))
+	}
+	return ioutil.WriteFile(filepath.Join(outDir, "import.sh"), []byte(b.String()), 0755)
+}
+
+// isPredefined returns true for objects that come with the management server and cannot be managed.
+func isPredefined(obj map[string]interface{}) bool {
+	if readOnly, ok := obj["read-only"].(bool); ok && readOnly {
+		return true
+	}
+	if domain, ok := obj["domain"].(map[string]interface{}); ok {
+		return domain["domain-type"] == "data domain"
+	}
+	return false
+}
+
+// isAutoGenerated returns true for automatic NAT rules and their section, which are created from the objects' NAT
+// settings.
+func isAutoGenerated(entry *rulebaseEntry) bool {
+	if v, ok := entry.json["auto-generated"].(bool); ok && v {
+		return true
+	}
+	name, _ := entry.json["name"].(string)
+	return entry.section && strings.HasPrefix(name, "Automatic Generated Rules")
+}
+
+// hclPosition returns the position of an entry that comes right after prev.
+func hclPosition(prev *rulebaseEntry) string {
+	if prev == nil {
+		return `{ top = "top" }`
+	}
+	uid, _ := prev.json["uid"].(string)
+	if prev.section {
+		return fmt.Sprintf("{ top = %s }", hclString(uid))
+	}
+	return fmt.Sprintf("{ below = %s }", hclString(uid))
+}
+
+func rulebaseIdentifier(identifier string) map[string]interface{} {
+	if uidRegexp.MatchString(identifier) {
+		return map[string]interface{}{"uid": identifier}
+	}
+	return map[string]interface{}{"name": identifier}
+}
+
+func hclValue(v interface{}, t schema.ValueType) string {
+	var s string
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		s = strconv.FormatBool(value)
+	case int:
+		s = strconv.Itoa(value)
+	case float64:
+		s = strconv.FormatFloat(value, 'f', -1, 64)
+		if t == schema.TypeInt {
+			s = strconv.Itoa(int(math.Round(value)))
+		}
+	case string:
+		return hclString(value)
+	default:
+		return ""
+	}
+	if t == schema.TypeString {
+		return hclString(s)
+	}
+	return s
+}
+
+func hclString(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "${", "$${", "%{", "%%{").Replace(s)
+	return `"` + s + `"`
+}
+
+func shellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func pageNumber(v interface{}) int {
+	if n, ok := v.(float64); ok {
+		return int(math.Round(n))
+	}
+	return 0
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/json"
+	checkpoint "github.com/CheckPointSW/cp-mgmt-api-go-sdk/APIFiles"
+	provider "github.com/CheckPointSW/terraform-provider-checkpoint/checkpoint"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const (
+	testHostUid     = "3a5f1b2c-0000-4000-8000-000000000001"
+	testRuleUid     = "3a5f1b2c-0000-4000-8000-000000000002"
+	testSectionUid  = "3a5f1b2c-0000-4000-8000-000000000003"
+	testRule2Uid    = "3a5f1b2c-0000-4000-8000-000000000004"
+	testLayerName   = "Network"
+	testPredefUid   = "3a5f1b2c-0000-4000-8000-000000000005"
+	testSectionName = "Servers"
+)
+
+// testApiServer answers the show commands of the objects and the access rulebase that the tests export.
+func testApiServer(t *testing.T) *httptest.Server {
+	host := map[string]interface{}{
+		"uid":          testHostUid,
+		"name":         "web server",
+		"type":         "host",
+		"ipv4-address": "192.0.2.1",
+		"color":        "red",
+		"comments":     "exported",
+		"interfaces": []interface{}{
+			map[string]interface{}{"name": "eth1", "subnet4": "198.51.100.0", "mask-length4": float64(24), "color": "black"},
+		},
+		"nat-settings": map[string]interface{}{"auto-rule": true, "method": "static", "ipv4-address": "203.0.113.1"},
+		"domain":       map[string]interface{}{"domain-type": "domain"},
+	}
+	predefined := map[string]interface{}{"uid": testPredefUid, "name": "predefined", "type": "host", "read-only": true}
+
+	rule := func(uid string) map[string]interface{} {
+		return map[string]interface{}{
+			"uid":         uid,
+			"name":        "allow web",
+			"type":        "access-rule",
+			"layer":       testLayerName,
+			"action":      map[string]interface{}{"name": "Accept"},
+			"destination": []interface{}{map[string]interface{}{"name": "web server", "uid": testHostUid}},
+			"enabled":     true,
+		}
+	}
+	rules := map[string]map[string]interface{}{testRuleUid: rule(testRuleUid), testRule2Uid: rule(testRule2Uid)}
+	section := map[string]interface{}{"uid": testSectionUid, "name": testSectionName, "type": "access-section", "layer": testLayerName}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		command := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+
+		var res map[string]interface{}
+		switch command {
+		case "show-objects":
+			res = map[string]interface{}{"objects": []interface{}{host, predefined}, "from": 1, "to": 2, "total": 2}
+		case "show-host":
+			if payload["uid"] == testHostUid {
+				res = host
+			}
+		case "show-access-rulebase":
+			sectionEntry := make(map[string]interface{})
+			for k, v := range section {
+				sectionEntry[k] = v
+			}
+			sectionEntry["rulebase"] = []interface{}{rules[testRule2Uid]}
+			res = map[string]interface{}{
+				"uid":      "3a5f1b2c-0000-4000-8000-000000000006",
+				"name":     testLayerName,
+				"rulebase": []interface{}{rules[testRuleUid], sectionEntry},
+				"from":     1,
+				"to":       2,
+				"total":    2,
+			}
+		case "show-access-rule":
+			res = rules[payload["uid"].(string)]
+		case "show-access-section":
+			if payload["uid"] == testSectionUid {
+				res = section
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if res == nil {
+			w.WriteHeader(http.StatusNotFound)
+			res = map[string]interface{}{"code": "generic_err_object_not_found", "message": "Requested object not found"}
+			t.Logf("%s %v was not found", command, payload)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(handler))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func testExporter(t *testing.T) *exporter {
+	host, portVal, _ := net.SplitHostPort(testApiServer(t).Listener.Addr().String())
+	port, _ := strconv.Atoi(portVal)
+	args := checkpoint.APIClientArgs(port, "", "test-sid", host, "", -1, "", true, false, "", "web_api", checkpoint.TimeOut, checkpoint.SleepTime, "", "", -1)
+
+	return &exporter{
+		client:    checkpoint.APIClient(args),
+		resources: provider.Provider().(*schema.Provider).ResourcesMap,
+		labels:    make(map[string]bool),
+		files:     make(map[string]*strings.Builder),
+	}
+}
+
+func TestExportObjects(t *testing.T) {
+	e := testExporter(t)
+	if err := e.export([]string{"host"}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	got := e.files["checkpoint_management_host"].String()
+	// Nested blocks and map keys are named as in the schema, e.g. mask_length4 for mask-length4 and auto_rule for
+	// auto-rule, values are converted as the resource reads them, and the default color of the interface is left out
+	expected := `resource "checkpoint_management_host" "web_server" {
+  color = "red"
+  comments = "exported"
+  interfaces {
+    mask_length4 = 24
+    name = "eth1"
+    subnet4 = "198.51.100.0"
+  }
+  ipv4_address = "192.0.2.1"
+  name = "web server"
+  nat_settings = {
+    auto_rule = "true"
+    ipv4_address = "203.0.113.1"
+    method = "static"
+  }
+}
+
+`
+	if got != expected {
+		t.Fatalf("unexpected configuration:\n%s\nexpected:\n%s", got, expected)
+	}
+	if len(e.imports) != 1 || e.imports[0].id != testHostUid {
+		t.Fatalf("expected only the import of %s, got %v", testHostUid, e.imports)
+	}
+}
+
+func TestExportRulebase(t *testing.T) {
+	e := testExporter(t)
+	if err := e.export(nil, []string{testLayerName}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	rulesConfig := e.files["checkpoint_management_access_rule"].String()
+	// Rules of the same name are positioned by the UID of the entry before them
+	for _, position := range []string{
+		`position = { top = "top" }`,
+		`position = { top = "` + testSectionUid + `" }`,
+	} {
+		if !strings.Contains(rulesConfig, position) {
+			t.Fatalf("missing %s in:\n%s", position, rulesConfig)
+		}
+	}
+	if !strings.Contains(rulesConfig, `destination = ["web server"]`) || !strings.Contains(rulesConfig, `action = "Accept"`) {
+		t.Fatalf("missing rule arguments in:\n%s", rulesConfig)
+	}
+	if !strings.Contains(rulesConfig, `resource "checkpoint_management_access_rule" "allow_web_2"`) {
+		t.Fatalf("labels of rules of the same name are not unique:\n%s", rulesConfig)
+	}
+
+	sectionConfig := e.files["checkpoint_management_access_section"].String()
+	if !strings.Contains(sectionConfig, `position = { below = "`+testRuleUid+`" }`) {
+		t.Fatalf("section is not positioned below the rule by UID:\n%s", sectionConfig)
+	}
+
+	imports := make([]string, 0, len(e.imports))
+	for _, entry := range e.imports {
+		imports = append(imports, entry.id)
+	}
+	expected := []string{testLayerName + ";" + testRuleUid, testLayerName + ";" + testSectionUid, testLayerName + ";" + testRule2Uid}
+	if strings.Join(imports, ",") != strings.Join(expected, ",") {
+		t.Fatalf("imports %v, expected %v", imports, expected)
+	}
+}
+
+func TestExportSave(t *testing.T) {
+	e := testExporter(t)
+	if err := e.export([]string{"host"}, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	outDir := t.TempDir()
+	if err := e.save(outDir, "script"); err != nil {
+		t.Fatal(err)
+	}
+	script, err := ioutil.ReadFile(filepath.Join(outDir, "import.sh"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(script), "terraform import 'checkpoint_management_host.web_server' '"+testHostUid+"'") {
+		t.Fatalf("unexpected import script:\n%s", script)
+	}
+	if _, err := ioutil.ReadFile(filepath.Join(outDir, "checkpoint_management_host.tf")); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	provider "github.com/CheckPointSW/terraform-provider-checkpoint/checkpoint"
+	"github.com/CheckPointSW/terraform-provider-checkpoint/commands"
+)
+
+// Fields that change on every modification and are not shown in the diff of a modified object.
+var ignoredFields = map[string]bool{
+	"meta-info":         true,
+	"read-only":         true,
+	"available-actions": true,
+}
+
+func main() {
+
+	var fromSession, toSession, fromDate, toDate string
+
+	flag.StringVar(&fromSession, "from-session", "", "The session UID to show changes from. Default is the session of Terraform.")
+	flag.StringVar(&toSession, "to-session", "", "The session UID to show changes to.")
+	flag.StringVar(&fromDate, "from-date", "", "The date to show changes from, in ISO 8601 format.")
+	flag.StringVar(&toDate, "to-date", "", "The date to show changes to, in ISO 8601 format.")
+	flag.Parse()
+
+	apiClient, err := commands.InitClient()
+	if err != nil {
+		fmt.Println("Show changes error: " + err.Error())
+		os.Exit(1)
+	}
+
+	var payload = map[string]interface{}{}
+	{
+		if fromSession != "" {
+			payload["from-session"] = fromSession
+		}
+		if toSession != "" {
+			payload["to-session"] = toSession
+		}
+		if fromDate != "" {
+			payload["from-date"] = fromDate
+		}
+		if toDate != "" {
+			payload["to-date"] = toDate
+		}
+	}
+
+	if len(payload) == 0 {
+		showSessionRes, err := apiClient.ApiCall("show-session", map[string]interface{}{}, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+		if err != nil {
+			fmt.Println("Show changes error: " + err.Error())
+			os.Exit(1)
+		}
+		if !showSessionRes.Success {
+			fmt.Println(fmt.Sprintf("Show changes failed: %s.", showSessionRes.ErrorMsg))
+			os.Exit(1)
+		}
+		uid, _ := showSessionRes.GetData()["uid"].(string)
+		payload["from-session"] = uid
+		payload["to-session"] = uid
+		fmt.Println(fmt.Sprintf("Changes of session [%s]:", uid))
+	}
+
+	showChangesRes, err := apiClient.ApiCall("show-changes", payload, apiClient.GetSessionID(), true, apiClient.IsProxyUsed())
+	if err != nil {
+		fmt.Println("Show changes error: " + err.Error())
+		os.Exit(1)
+	}
+
+	if !showChangesRes.Success {
+		errMsg := fmt.Sprintf("Show changes failed: %s.", showChangesRes.ErrorMsg)
+		if taskId := commands.ResolveTaskId(showChangesRes.GetData()); taskId != nil {
+			errMsg += fmt.Sprintf(" task-id [%s]", taskId)
+		}
+		fmt.Println(errMsg)
+		os.Exit(1)
+	}
+
+	var added, modified, deleted []interface{}
+	for _, operations := range provider.ChangesOperations(showChangesRes.GetData()) {
+		added = append(added, list(operations["added-objects"])...)
+		modified = append(modified, list(operations["modified-objects"])...)
+		deleted = append(deleted, list(operations["deleted-objects"])...)
+	}
+
+	for _, obj := range added {
+		fmt.Println("+ " + describe(obj))
+	}
+	for _, change := range modified {
+		changeMap, _ := change.(map[string]interface{})
+		newObject, ok := changeMap["new-object"].(map[string]interface{})
+		if !ok {
+			newObject = changeMap
+		}
+		fmt.Println("~ " + describe(newObject))
+		oldObject, _ := changeMap["old-object"].(map[string]interface{})
+		for _, line := range diff(oldObject, newObject) {
+			fmt.Println("    " + line)
+		}
+	}
+	for _, obj := range deleted {
+		fmt.Println("- " + describe(obj))
+	}
+
+	fmt.Println(fmt.Sprintf("%d added, %d modified, %d deleted.", len(added), len(modified), len(deleted)))
+}
+
+func list(v interface{}) []interface{} {
+	l, _ := v.([]interface{})
+	return l
+}
+
+func describe(obj interface{}) string {
+	objMap, _ := obj.(map[string]interface{})
+	return fmt.Sprintf("%v %q [%v]", objMap["type"], objMap["name"], objMap["uid"])
+}
+
+// diff returns a line for every field that differs between the old and the new object.
+func diff(oldObject map[string]interface{}, newObject map[string]interface{}) []string {
+	fields := make(map[string]bool)
+	for k := range oldObject {
+		fields[k] = true
+	}
+	for k := range newObject {
+		fields[k] = true
+	}
+
+	var names []string
+	for k := range fields {
+		if !ignoredFields[k] {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	var lines []string
+	for _, k := range names {
+		oldValue := format(oldObject[k])
+		newValue := format(newObject[k])
+		if oldValue != newValue {
+			lines = append(lines, fmt.Sprintf("%s: %s -> %s", k, oldValue, newValue))
+		}
+	}
+	return lines
+}
+
+func format(v interface{}) string {
+	if v == nil {
+		return "(none)"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}